@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/containers/common/pkg/auth"
 	"github.com/containers/common/pkg/completion"
@@ -17,7 +18,8 @@ import (
 
 type cliAutoUpdateOptions struct {
 	entities.AutoUpdateOptions
-	format string
+	format            string
+	healthGracePeriod string
 }
 
 var (
@@ -54,6 +56,10 @@ func init() {
 	flags.BoolVar(&autoUpdateOptions.DryRun, "dry-run", false, "Check for pending updates")
 	flags.BoolVar(&autoUpdateOptions.Rollback, "rollback", true, "Rollback to previous image if update fails")
 
+	healthGracePeriodFlagName := "health-grace-period"
+	flags.StringVar(&autoUpdateOptions.healthGracePeriod, healthGracePeriodFlagName, "0s", "Wait for updated containers to become healthy for the specified duration and fail the update otherwise")
+	_ = autoUpdateCommand.RegisterFlagCompletionFunc(healthGracePeriodFlagName, completion.AutocompleteNone)
+
 	flags.StringVar(&autoUpdateOptions.format, "format", "", "Change the output format to JSON or a Go template")
 	_ = autoUpdateCommand.RegisterFlagCompletionFunc("format", common.AutocompleteFormat(&autoUpdateOutput{}))
 }
@@ -64,12 +70,21 @@ func autoUpdate(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("`%s` takes no arguments", cmd.CommandPath())
 	}
 
+	gracePeriod, err := time.ParseDuration(autoUpdateOptions.healthGracePeriod)
+	if err != nil {
+		return fmt.Errorf("invalid health grace period: %w", err)
+	}
+	if gracePeriod < 0 {
+		return fmt.Errorf("health grace period must not be negative: %s", autoUpdateOptions.healthGracePeriod)
+	}
+	autoUpdateOptions.HealthGracePeriod = gracePeriod
+
 	allReports, failures := registry.ContainerEngine().AutoUpdate(registry.GetContext(), autoUpdateOptions.AutoUpdateOptions)
 	if allReports == nil {
 		return errorhandling.JoinErrors(failures)
 	}
 
-	if err := writeTemplate(allReports, autoUpdateOptions.format); err != nil {
+	if err := writeTemplate(allReports, autoUpdateOptions.format, autoUpdateOptions.DryRun); err != nil {
 		failures = append(failures, err)
 	}
 
@@ -81,6 +96,7 @@ type autoUpdateOutput struct {
 	Container     string
 	ContainerName string
 	ContainerID   string
+	Digest        string
 	Image         string
 	Policy        string
 	Updated       string
@@ -94,6 +110,7 @@ func reportsToOutput(allReports []*entities.AutoUpdateReport) []autoUpdateOutput
 			Container:     fmt.Sprintf("%s (%s)", r.ContainerID[:12], r.ContainerName),
 			ContainerName: r.ContainerName,
 			ContainerID:   r.ContainerID,
+			Digest:        r.Digest,
 			Image:         r.ImageName,
 			Policy:        r.Policy,
 			Updated:       r.Updated,
@@ -102,7 +119,7 @@ func reportsToOutput(allReports []*entities.AutoUpdateReport) []autoUpdateOutput
 	return output
 }
 
-func writeTemplate(allReports []*entities.AutoUpdateReport, inputFormat string) error {
+func writeTemplate(allReports []*entities.AutoUpdateReport, inputFormat string, dryRun bool) error {
 	rpt := report.New(os.Stdout, "auto-update")
 	defer rpt.Flush()
 
@@ -111,6 +128,10 @@ func writeTemplate(allReports []*entities.AutoUpdateReport, inputFormat string)
 	switch inputFormat {
 	case "":
 		format := "{{range . }}\t{{.Unit}}\t{{.Container}}\t{{.Image}}\t{{.Policy}}\t{{.Updated}}\n{{end -}}"
+		if dryRun {
+			// Show the exact image each unit would be updated to.
+			format = "{{range . }}\t{{.Unit}}\t{{.Container}}\t{{.Image}}\t{{.Policy}}\t{{.Updated}}\t{{.Digest}}\n{{end -}}"
+		}
 		rpt, err = rpt.Parse(report.OriginPodman, format)
 	case "json":
 		prettyJSON, err := json.MarshalIndent(output, "", "    ")
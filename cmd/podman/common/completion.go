@@ -173,6 +173,50 @@ func getVolumes(cmd *cobra.Command, toComplete string) ([]string, cobra.ShellCom
 	return suggestions, cobra.ShellCompDirectiveNoFileComp
 }
 
+func getVolumePlugins(cmd *cobra.Command, toComplete string) ([]string, cobra.ShellCompDirective) {
+	suggestions := []string{}
+
+	engine, err := setupContainerEngine(cmd)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	plugins, err := engine.VolumePluginList(registry.GetContext())
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	for _, p := range plugins {
+		if strings.HasPrefix(p.Name, toComplete) {
+			suggestions = append(suggestions, p.Name)
+		}
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
+func getVolumeSnapshots(cmd *cobra.Command, volume string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	suggestions := []string{}
+
+	engine, err := setupContainerEngine(cmd)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	snapshots, err := engine.VolumeSnapshotList(registry.GetContext(), volume)
+	if err != nil {
+		cobra.CompErrorln(err.Error())
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	for _, s := range snapshots {
+		if strings.HasPrefix(s.Name, toComplete) {
+			suggestions = append(suggestions, s.Name)
+		}
+	}
+	return suggestions, cobra.ShellCompDirectiveNoFileComp
+}
+
 func getImages(cmd *cobra.Command, toComplete string) ([]string, cobra.ShellCompDirective) {
 	suggestions := []string{}
 	listOptions := entities.ImageListOptions{}
@@ -581,6 +625,25 @@ func AutocompleteVolumes(cmd *cobra.Command, args []string, toComplete string) (
 	return getVolumes(cmd, toComplete)
 }
 
+// AutocompleteVolumePlugins - Autocomplete volume plugins.
+func AutocompleteVolumePlugins(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if !validCurrentCmdLine(cmd, args, toComplete) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return getVolumePlugins(cmd, toComplete)
+}
+
+// AutocompleteVolumeSnapshots - Autocomplete a volume as first arg and its snapshots as further args.
+func AutocompleteVolumeSnapshots(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if !validCurrentCmdLine(cmd, args, toComplete) {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	if len(args) == 0 {
+		return getVolumes(cmd, toComplete)
+	}
+	return getVolumeSnapshots(cmd, args[0], toComplete)
+}
+
 // AutocompleteSecrets - Autocomplete secrets.
 func AutocompleteSecrets(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	if !validCurrentCmdLine(cmd, args, toComplete) {
@@ -596,6 +659,17 @@ func AutocompleteSecretCreate(cmd *cobra.Command, args []string, toComplete stri
 	return nil, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteSecretUpdate - Autocomplete secret update, the secret name followed by a file.
+func AutocompleteSecretUpdate(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return getSecrets(cmd, toComplete, completeDefault)
+	case 1:
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteImages - Autocomplete images.
 func AutocompleteImages(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	if !validCurrentCmdLine(cmd, args, toComplete) {
@@ -1368,18 +1442,19 @@ func AutocompleteEventFilter(cmd *cobra.Command, args []string, toComplete strin
 			events.Push.String(), events.Refresh.String(), events.Remove.String(), events.Rename.String(),
 			events.Renumber.String(), events.Restart.String(), events.Restore.String(), events.Save.String(),
 			events.Start.String(), events.Stop.String(), events.Sync.String(), events.Tag.String(), events.Unmount.String(),
-			events.Unpause.String(), events.Untag.String(),
+			events.Unpause.String(), events.Untag.String(), events.Update.String(),
 		}, cobra.ShellCompDirectiveNoFileComp
 	}
 	eventTypes := func(_ string) ([]string, cobra.ShellCompDirective) {
 		return []string{events.Container.String(), events.Image.String(), events.Network.String(),
-			events.Pod.String(), events.System.String(), events.Volume.String(),
+			events.Pod.String(), events.Secret.String(), events.System.String(), events.Volume.String(),
 		}, cobra.ShellCompDirectiveNoFileComp
 	}
 	kv := keyValueCompletion{
 		"container=": func(s string) ([]string, cobra.ShellCompDirective) { return getContainers(cmd, s, completeDefault) },
 		"image=":     func(s string) ([]string, cobra.ShellCompDirective) { return getImages(cmd, s) },
 		"pod=":       func(s string) ([]string, cobra.ShellCompDirective) { return getPods(cmd, s, completeDefault) },
+		"secret=":    func(s string) ([]string, cobra.ShellCompDirective) { return getSecrets(cmd, s, completeDefault) },
 		"volume=":    func(s string) ([]string, cobra.ShellCompDirective) { return getVolumes(cmd, s) },
 		"event=":     event,
 		"type=":      eventTypes,
@@ -1492,6 +1567,20 @@ func AutocompleteEventBackend(cmd *cobra.Command, args []string, toComplete stri
 	return types, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteDatabaseBackend - Autocomplete database backend options.
+// -> "boltdb", "sqlite"
+func AutocompleteDatabaseBackend(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	types := []string{config.DBBackendBoltDB.String(), config.DBBackendSQLite.String()}
+	return types, cobra.ShellCompDirectiveNoFileComp
+}
+
+// AutocompleteKubeGenerateType - Autocomplete kube generate type options.
+// -> "pod", "deployment", "daemonset", "job"
+func AutocompleteKubeGenerateType(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	types := []string{define.K8sKindPod, define.K8sKindDeployment, define.K8sKindDaemonSet, define.K8sKindJob}
+	return types, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteNetworkBackend - Autocomplete network backend options.
 // -> "cni", "netavark"
 func AutocompleteNetworkBackend(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -1654,6 +1743,17 @@ func AutocompleteClone(cmd *cobra.Command, args []string, toComplete string) ([]
 	return nil, cobra.ShellCompDirectiveNoFileComp
 }
 
+// AutocompleteContainerMigrate - Autocomplete running containers and system connections.
+func AutocompleteContainerMigrate(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	switch len(args) {
+	case 0:
+		return AutocompleteContainersRunning(cmd, args, toComplete)
+	case 1:
+		return AutocompleteSystemConnections(cmd, args, toComplete)
+	}
+	return nil, cobra.ShellCompDirectiveNoFileComp
+}
+
 // AutocompleteSSH - Autocomplete ssh modes
 func AutocompleteSSH(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	if !validCurrentCmdLine(cmd, args, toComplete) {
@@ -1666,3 +1766,10 @@ func AutocompleteSSH(cmd *cobra.Command, args []string, toComplete string) ([]st
 func AutocompleteHealthOnFailure(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 	return define.SupportedHealthCheckOnFailureActions, cobra.ShellCompDirectiveNoFileComp
 }
+
+// AutocompleteAutoUpdatePolicy - Autocomplete auto-update policies.
+// -> "disabled", "local", "registry", "semver"
+func AutocompleteAutoUpdatePolicy(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	policies := []string{"disabled", "local", "registry", "semver"}
+	return policies, cobra.ShellCompDirectiveNoFileComp
+}
@@ -635,6 +635,12 @@ func DefineCreateFlags(cmd *cobra.Command, cf *entities.ContainerCreateOptions,
 		)
 		_ = cmd.RegisterFlagCompletionFunc(restartFlagName, AutocompleteRestartOption)
 
+		createFlags.BoolVar(
+			&cf.RestartDependents,
+			"restart-dependents", false,
+			"Restart the containers depending on this container whenever it is restarted",
+		)
+
 		shmSizeFlagName := "shm-size"
 		createFlags.String(
 			shmSizeFlagName, shmSize(),
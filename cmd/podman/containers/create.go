@@ -197,7 +197,9 @@ func replaceContainer(name string) error {
 	return removeContainers([]string{name}, rmOptions, false)
 }
 
-func createOrUpdateFlags(cmd *cobra.Command, vals *entities.ContainerCreateOptions) error {
+// CreateOrUpdateFlags handles the flags shared by create and update which
+// cannot be parsed into the options directly.
+func CreateOrUpdateFlags(cmd *cobra.Command, vals *entities.ContainerCreateOptions) error {
 	if cmd.Flags().Changed("pids-limit") {
 		val := cmd.Flag("pids-limit").Value.String()
 		// Convert -1 to 0, so that -1 maps to unlimited pids limit
@@ -274,7 +276,7 @@ func CreateInit(c *cobra.Command, vals entities.ContainerCreateOptions, isInfra
 			vals.OOMScoreAdj = &val
 		}
 
-		if err := createOrUpdateFlags(c, &vals); err != nil {
+		if err := CreateOrUpdateFlags(c, &vals); err != nil {
 			return vals, err
 		}
 
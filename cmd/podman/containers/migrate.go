@@ -0,0 +1,70 @@
+package containers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/criu"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/containers/podman/v4/pkg/rootless"
+	"github.com/spf13/cobra"
+)
+
+var (
+	migrateDescription = `
+   podman container migrate
+
+   Migrates a running container to the host of a system connection. The memory of the
+   container is transferred while it keeps running, the container is then checkpointed,
+   restored on the destination and removed locally.
+`
+	migrateCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "migrate [options] CONTAINER DESTINATION",
+		Short:             "Migrate a running container to another host",
+		Long:              migrateDescription,
+		RunE:              migrate,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: common.AutocompleteContainerMigrate,
+		Example: `podman container migrate ctrID server2
+  podman container migrate --pre-checkpoints 3 --tcp-established mywebserver server2`,
+	}
+)
+
+var migrateOptions entities.ContainerMigrateOptions
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: migrateCommand,
+		Parent:  containerCmd,
+	})
+	flags := migrateCommand.Flags()
+
+	preCheckpointsFlagName := "pre-checkpoints"
+	flags.UintVar(&migrateOptions.PreCheckpoints, preCheckpointsFlagName, 1, "Number of pre-checkpoints transferred while the container keeps running")
+	_ = migrateCommand.RegisterFlagCompletionFunc(preCheckpointsFlagName, completion.AutocompleteNone)
+
+	flags.BoolVar(&migrateOptions.TCPEstablished, "tcp-established", false, "Migrate a container with established TCP connections")
+	flags.BoolVar(&migrateOptions.FileLocks, "file-locks", false, "Migrate a container with file locks")
+	flags.BoolVar(&migrateOptions.IgnoreRootFS, "ignore-rootfs", false, "Do not transfer root file-system changes")
+	flags.BoolVar(&migrateOptions.IgnoreVolumes, "ignore-volumes", false, "Do not transfer volumes associated with the container")
+}
+
+func migrate(cmd *cobra.Command, args []string) error {
+	if rootless.IsRootless() {
+		return errors.New("migrating a container requires root")
+	}
+	if migrateOptions.PreCheckpoints > 0 && !criu.MemTrack() {
+		return errors.New("system (architecture/kernel/CRIU) does not support memory tracking, use --pre-checkpoints=0")
+	}
+
+	report, err := registry.ContainerEngine().ContainerMigrate(registry.GetContext(), args[0], args[1], migrateOptions)
+	if err != nil {
+		return err
+	}
+	fmt.Println(report.Id)
+	return nil
+}
@@ -0,0 +1,109 @@
+package containers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	portAddDescription = `Publish ports of an existing container.
+
+  The ports are given in the format of --publish.  If the container is running, the ports are forwarded immediately.`
+	portAddCommand = &cobra.Command{
+		Use:               "add CONTAINER [[IP:][HOSTPORT]:]CONTAINERPORT[/PROTOCOL] [...]",
+		Short:             "Publish ports of a container",
+		Long:              portAddDescription,
+		RunE:              portAdd,
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: common.AutocompleteContainerOneArg,
+		Example: `podman port add ctrID 8080:80
+  podman port add ctrID 127.0.0.1:5353:53/udp 9000-9001:9000-9001`,
+	}
+
+	containerPortAddCommand = &cobra.Command{
+		Use:               portAddCommand.Use,
+		Short:             portAddCommand.Short,
+		Long:              portAddCommand.Long,
+		RunE:              portAddCommand.RunE,
+		Args:              portAddCommand.Args,
+		ValidArgsFunction: portAddCommand.ValidArgsFunction,
+		Example: `podman container port add ctrID 8080:80
+  podman container port add ctrID 127.0.0.1:5353:53/udp 9000-9001:9000-9001`,
+	}
+
+	portRmDescription = `Unpublish ports of an existing container.
+
+  The ports are given in the format of --publish, the host port is required.  If the container is running, the ports are no longer forwarded.`
+	portRmCommand = &cobra.Command{
+		Use:               "rm CONTAINER [IP:]HOSTPORT:CONTAINERPORT[/PROTOCOL] [...]",
+		Aliases:           []string{"remove"},
+		Short:             "Unpublish ports of a container",
+		Long:              portRmDescription,
+		RunE:              portRm,
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: common.AutocompleteContainerOneArg,
+		Example:           `podman port rm ctrID 8080:80`,
+	}
+
+	containerPortRmCommand = &cobra.Command{
+		Use:               portRmCommand.Use,
+		Aliases:           portRmCommand.Aliases,
+		Short:             portRmCommand.Short,
+		Long:              portRmCommand.Long,
+		RunE:              portRmCommand.RunE,
+		Args:              portRmCommand.Args,
+		ValidArgsFunction: portRmCommand.ValidArgsFunction,
+		Example:           `podman container port rm ctrID 8080:80`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: portAddCommand,
+		Parent:  portCommand,
+	})
+
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: containerPortAddCommand,
+		Parent:  containerPortCommand,
+	})
+
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: portRmCommand,
+		Parent:  portCommand,
+	})
+
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: containerPortRmCommand,
+		Parent:  containerPortCommand,
+	})
+}
+
+func portAdd(_ *cobra.Command, args []string) error {
+	options := entities.ContainerPortUpdateOptions{Ports: args[1:]}
+	report, err := registry.ContainerEngine().ContainerPortAdd(registry.GetContext(), strings.TrimPrefix(args[0], "/"), options)
+	if err != nil {
+		return err
+	}
+	for _, v := range report.Ports {
+		hostIP := v.HostIP
+		// Set host IP to 0.0.0.0 if blank
+		if hostIP == "" {
+			hostIP = "0.0.0.0"
+		}
+		for i := uint16(0); i < v.Range; i++ {
+			fmt.Printf("%d/%s -> %s:%d\n", v.ContainerPort+i, v.Protocol, hostIP, v.HostPort+i)
+		}
+	}
+	return nil
+}
+
+func portRm(_ *cobra.Command, args []string) error {
+	options := entities.ContainerPortUpdateOptions{Ports: args[1:]}
+	return registry.ContainerEngine().ContainerPortRemove(registry.GetContext(), strings.TrimPrefix(args[0], "/"), options)
+}
@@ -372,9 +372,15 @@ func (l psReporter) Status() string {
 		//nolint:staticcheck
 		state = strings.Title(l.ListContainer.State)
 	}
-	hc := l.ListContainer.Status
-	if hc != "" {
-		state += " (" + hc + ")"
+	var checks []string
+	if hc := l.ListContainer.Status; hc != "" {
+		checks = append(checks, hc)
+	}
+	if readiness := l.ListContainer.Readiness; readiness != "" {
+		checks = append(checks, readiness)
+	}
+	if len(checks) > 0 {
+		state += " (" + strings.Join(checks, ", ") + ")"
 	}
 	return state
 }
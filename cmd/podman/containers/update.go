@@ -2,20 +2,27 @@ package containers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/image/v5/manifest"
 	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/parse"
 	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/containers/podman/v4/pkg/specgen"
 	"github.com/containers/podman/v4/pkg/specgenutil"
+	"github.com/containers/podman/v4/pkg/util"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 var (
-	updateDescription = `Updates the cgroup configuration of a given container`
+	updateDescription = `Updates the cgroup configuration, restart policy, health check, labels and auto-update policy of a given container`
 
 	updateCommand = &cobra.Command{
 		Use:               "update [options] CONTAINER",
@@ -24,7 +31,8 @@ var (
 		RunE:              update,
 		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: common.AutocompleteContainers,
-		Example:           `podman update --cpus=5 foobar_container`,
+		Example: `podman update --cpus=5 foobar_container
+  podman update --restart=on-failure:3 --health-cmd="curl -f http://localhost/" foobar_container`,
 	}
 
 	containerUpdateCommand = &cobra.Command{
@@ -34,16 +42,73 @@ var (
 		Long:              updateCommand.Long,
 		RunE:              updateCommand.RunE,
 		ValidArgsFunction: updateCommand.ValidArgsFunction,
-		Example:           `podman container update --cpus=5 foobar_container`,
+		Example: `podman container update --cpus=5 foobar_container
+  podman container update --label app=web --auto-update=registry foobar_container`,
 	}
 )
 var (
-	updateOpts entities.ContainerCreateOptions
+	updateOpts       entities.ContainerCreateOptions
+	updateAutoUpdate string
 )
 
+// updateConfigFlags are the flags which change the container configuration
+// instead of its cgroup configuration.
+var updateConfigFlags = map[string]bool{
+	"auto-update":         true,
+	"health-cmd":          true,
+	"health-interval":     true,
+	"health-on-failure":   true,
+	"health-retries":      true,
+	"health-start-period": true,
+	"health-timeout":      true,
+	"label":               true,
+	"no-healthcheck":      true,
+	"restart":             true,
+}
+
 func updateFlags(cmd *cobra.Command) {
 	common.DefineCreateDefaults(&updateOpts)
 	common.DefineCreateFlags(cmd, &updateOpts, entities.UpdateMode)
+
+	flags := cmd.Flags()
+
+	restartFlagName := "restart"
+	flags.StringVar(&updateOpts.Restart, restartFlagName, "", `Restart policy to apply when a container exits ("always"|"no"|"never"|"on-failure"|"unless-stopped")`)
+	_ = cmd.RegisterFlagCompletionFunc(restartFlagName, common.AutocompleteRestartOption)
+
+	healthCmdFlagName := "health-cmd"
+	flags.StringVar(&updateOpts.HealthCmd, healthCmdFlagName, "", "set a healthcheck command for the container ('none' disables the existing healthcheck)")
+	_ = cmd.RegisterFlagCompletionFunc(healthCmdFlagName, completion.AutocompleteNone)
+
+	healthIntervalFlagName := "health-interval"
+	flags.StringVar(&updateOpts.HealthInterval, healthIntervalFlagName, define.DefaultHealthCheckInterval, "set an interval for the healthcheck (a value of disable results in no automatic timer setup)")
+	_ = cmd.RegisterFlagCompletionFunc(healthIntervalFlagName, completion.AutocompleteNone)
+
+	healthRetriesFlagName := "health-retries"
+	flags.UintVar(&updateOpts.HealthRetries, healthRetriesFlagName, define.DefaultHealthCheckRetries, "the number of retries allowed before a healthcheck is considered to be unhealthy")
+	_ = cmd.RegisterFlagCompletionFunc(healthRetriesFlagName, completion.AutocompleteNone)
+
+	healthStartPeriodFlagName := "health-start-period"
+	flags.StringVar(&updateOpts.HealthStartPeriod, healthStartPeriodFlagName, define.DefaultHealthCheckStartPeriod, "the initialization time needed for a container to bootstrap")
+	_ = cmd.RegisterFlagCompletionFunc(healthStartPeriodFlagName, completion.AutocompleteNone)
+
+	healthTimeoutFlagName := "health-timeout"
+	flags.StringVar(&updateOpts.HealthTimeout, healthTimeoutFlagName, define.DefaultHealthCheckTimeout, "the maximum time allowed to complete the healthcheck before an interval is considered failed")
+	_ = cmd.RegisterFlagCompletionFunc(healthTimeoutFlagName, completion.AutocompleteNone)
+
+	healthOnFailureFlagName := "health-on-failure"
+	flags.StringVar(&updateOpts.HealthOnFailure, healthOnFailureFlagName, "", "action to take once the container turns unhealthy")
+	_ = cmd.RegisterFlagCompletionFunc(healthOnFailureFlagName, common.AutocompleteHealthOnFailure)
+
+	flags.BoolVar(&updateOpts.NoHealthCheck, "no-healthcheck", false, "Disable healthchecks on container")
+
+	labelFlagName := "label"
+	flags.StringArrayVarP(&updateOpts.Label, labelFlagName, "l", []string{}, "Add or overwrite metadata on container")
+	_ = cmd.RegisterFlagCompletionFunc(labelFlagName, completion.AutocompleteNone)
+
+	autoUpdateFlagName := "auto-update"
+	flags.StringVar(&updateAutoUpdate, autoUpdateFlagName, "", `Auto-update policy of the container ("disabled"|"local"|"registry"|"semver")`)
+	_ = cmd.RegisterFlagCompletionFunc(autoUpdateFlagName, common.AutocompleteAutoUpdatePolicy)
 }
 
 func init() {
@@ -60,26 +125,77 @@ func init() {
 }
 
 func update(cmd *cobra.Command, args []string) error {
-	var err error
-	// use a specgen since this is the easiest way to hold resource info
-	s := &specgen.SpecGenerator{}
-	s.ResourceLimits = &specs.LinuxResources{}
+	flags := cmd.Flags()
+	opts := &entities.ContainerUpdateOptions{
+		NameOrID:         strings.TrimPrefix(args[0], "/"),
+		HealthOnFailure:  updateOpts.HealthOnFailure,
+		AutoUpdatePolicy: updateAutoUpdate,
+	}
 
-	err = createOrUpdateFlags(cmd, &updateOpts)
-	if err != nil {
-		return err
+	resourcesChanged := false
+	flags.Visit(func(f *pflag.Flag) {
+		if !updateConfigFlags[f.Name] {
+			resourcesChanged = true
+		}
+	})
+	if resourcesChanged {
+		err := CreateOrUpdateFlags(cmd, &updateOpts)
+		if err != nil {
+			return err
+		}
+		// use a specgen since this is the easiest way to hold resource info
+		s := &specgen.SpecGenerator{}
+		s.ResourceLimits = &specs.LinuxResources{}
+
+		// we need to pass the whole specgen since throttle devices are parsed later due to cross compat.
+		s.ResourceLimits, err = specgenutil.GetResources(s, &updateOpts)
+		if err != nil {
+			return err
+		}
+		opts.Specgen = s
 	}
 
-	// we need to pass the whole specgen since throttle devices are parsed later due to cross compat.
-	s.ResourceLimits, err = specgenutil.GetResources(s, &updateOpts)
-	if err != nil {
-		return err
+	if flags.Changed("restart") {
+		policy, retries, err := util.ParseRestartPolicy(updateOpts.Restart)
+		if err != nil {
+			return err
+		}
+		opts.RestartPolicy = policy
+		if retries > 0 {
+			opts.RestartRetries = &retries
+		}
 	}
 
-	opts := &entities.ContainerUpdateOptions{
-		NameOrID: strings.TrimPrefix(args[0], "/"),
-		Specgen:  s,
+	switch {
+	case updateOpts.NoHealthCheck:
+		if flags.Changed("health-cmd") {
+			return errors.New("cannot specify both --no-healthcheck and --health-cmd")
+		}
+		opts.HealthConfig = &manifest.Schema2HealthConfig{
+			Test: []string{define.HealthConfigTestNone},
+		}
+	case flags.Changed("health-cmd"):
+		hc, err := specgenutil.MakeHealthCheckFromCli(updateOpts.HealthCmd, updateOpts.HealthInterval, updateOpts.HealthRetries, updateOpts.HealthTimeout, updateOpts.HealthStartPeriod, false)
+		if err != nil {
+			return err
+		}
+		opts.HealthConfig = hc
+	default:
+		for _, name := range []string{"health-interval", "health-retries", "health-start-period", "health-timeout"} {
+			if flags.Changed(name) {
+				return fmt.Errorf("--%s requires --health-cmd to replace the health check", name)
+			}
+		}
 	}
+
+	if len(updateOpts.Label) > 0 {
+		labels, err := parse.GetAllLabels(nil, updateOpts.Label)
+		if err != nil {
+			return err
+		}
+		opts.Labels = labels
+	}
+
 	rep, err := registry.ContainerEngine().ContainerUpdate(context.Background(), opts)
 	if err != nil {
 		return err
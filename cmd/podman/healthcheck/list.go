@@ -0,0 +1,101 @@
+package healthcheck
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+var (
+	lsCmd = &cobra.Command{
+		Use:               "ls [options]",
+		Aliases:           []string{"list"},
+		Short:             "List the health checks of containers",
+		Long:              "List the health check status, failing streak and next run time of all containers with a health check.",
+		RunE:              ls,
+		Args:              validate.NoArgs,
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example: `podman healthcheck ls
+  podman healthcheck ls --format "{{.Name}} {{.Status}}"`,
+	}
+)
+
+var lsOptions struct {
+	format    string
+	noHeading bool
+}
+
+// healthCheckReporter adds the human readable next run time to the report.
+type healthCheckReporter struct {
+	entities.HealthCheckListReport
+}
+
+// NextRunIn returns the time until the next health check run.
+func (r healthCheckReporter) NextRunIn() string {
+	if r.NextRun.IsZero() {
+		return ""
+	}
+	until := time.Until(r.NextRun)
+	if until <= 0 {
+		return "now"
+	}
+	return "in " + units.HumanDuration(until)
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: lsCmd,
+		Parent:  healthCmd,
+	})
+
+	flags := lsCmd.Flags()
+	formatFlagName := "format"
+	flags.StringVar(&lsOptions.format, formatFlagName, "{{range .}}{{.ID | printf \"%.12s\"}}\t{{.Name}}\t{{.Status}}\t{{.FailingStreak}}\t{{.NextRunIn}}\n{{end -}}", "Format the output using a Go template")
+	_ = lsCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&healthCheckReporter{}))
+
+	flags.BoolVarP(&lsOptions.noHeading, "noheading", "n", false, "Do not print headers")
+}
+
+func ls(cmd *cobra.Command, args []string) error {
+	responses, err := registry.ContainerEngine().HealthCheckList(registry.GetContext())
+	if err != nil {
+		return err
+	}
+	reports := make([]healthCheckReporter, 0, len(responses))
+	for _, r := range responses {
+		reports = append(reports, healthCheckReporter{*r})
+	}
+
+	headers := report.Headers(healthCheckReporter{}, map[string]string{
+		"ID":        "CONTAINER ID",
+		"NextRunIn": "NEXT RUN",
+	})
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	if cmd.Flag("format").Changed {
+		rpt, err = rpt.Parse(report.OriginUser, lsOptions.format)
+	} else {
+		rpt, err = rpt.Parse(report.OriginPodman, lsOptions.format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rpt.RenderHeaders && !lsOptions.noHeading {
+		if err := rpt.Execute(headers); err != nil {
+			return fmt.Errorf("failed to write report column headers: %w", err)
+		}
+	}
+	return rpt.Execute(reports)
+}
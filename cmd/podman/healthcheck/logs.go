@@ -0,0 +1,100 @@
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	logsDescription = `Show the history of a container's health check.
+
+  Unlike the health check log shown by inspect, the history is kept across restarts of the container and holds the output of each attempt.`
+	logsCmd = &cobra.Command{
+		Use:               "logs [options] CONTAINER",
+		Short:             "Show the health check history of a container",
+		Long:              logsDescription,
+		RunE:              logs,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteContainers,
+		Example: `podman healthcheck logs mywebapp
+  podman healthcheck logs --since 10m --follow mywebapp`,
+	}
+)
+
+var (
+	logsOptions entities.HealthCheckLogsOptions
+	logsFormat  string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: logsCmd,
+		Parent:  healthCmd,
+	})
+
+	flags := logsCmd.Flags()
+	flags.BoolVarP(&logsOptions.Follow, "follow", "f", false, "Follow the health check history")
+
+	sinceFlagName := "since"
+	flags.StringVar(&logsOptions.Since, sinceFlagName, "", "Show attempts started since timestamp")
+	_ = logsCmd.RegisterFlagCompletionFunc(sinceFlagName, completion.AutocompleteNone)
+
+	formatFlagName := "format"
+	flags.StringVar(&logsFormat, formatFlagName, "", "Format the output using a Go template")
+	_ = logsCmd.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&define.HealthCheckLog{}))
+}
+
+func logs(cmd *cobra.Command, args []string) error {
+	logChan := make(chan *define.HealthCheckLog, 1)
+	logsOptions.LogChan = logChan
+	errChan := make(chan error, 1)
+
+	var (
+		rpt    *report.Formatter
+		doJSON bool
+	)
+	if cmd.Flags().Changed("format") {
+		doJSON = report.IsJSON(logsFormat)
+		if !doJSON {
+			var err error
+			// Use OriginUnknown so it does not add an extra range since it
+			// will only be called for each single element and not a slice.
+			rpt, err = report.New(os.Stdout, cmd.Name()).Parse(report.OriginUnknown, logsFormat)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	go func() {
+		errChan <- registry.ContainerEngine().HealthCheckLogs(context.Background(), args[0], logsOptions)
+	}()
+
+	for hcl := range logChan {
+		switch {
+		case doJSON:
+			b, err := json.Marshal(hcl)
+			if err != nil {
+				return err
+			}
+			fmt.Println(string(b))
+		case rpt != nil:
+			if err := rpt.Execute(hcl); err != nil {
+				return err
+			}
+		default:
+			fmt.Printf("%s %d %s\n", hcl.Start, hcl.ExitCode, hcl.Output)
+		}
+	}
+	return <-errChan
+}
@@ -13,29 +13,35 @@ import (
 
 var (
 	runCmd = &cobra.Command{
-		Use:               "run CONTAINER",
-		Short:             "Run the health check of a container",
-		Long:              "Run the health check of a container",
-		Example:           `podman healthcheck run mywebapp`,
+		Use:   "run [options] CONTAINER",
+		Short: "Run the health check of a container",
+		Long:  "Run the health check of a container",
+		Example: `podman healthcheck run mywebapp
+  podman healthcheck run --readiness mywebapp`,
 		RunE:              run,
 		Args:              cobra.ExactArgs(1),
 		ValidArgsFunction: common.AutocompleteContainersRunning,
 	}
 )
 
+var runOptions entities.HealthCheckOptions
+
 func init() {
 	registry.Commands = append(registry.Commands, registry.CliCommand{
 		Command: runCmd,
 		Parent:  healthCmd,
 	})
+
+	flags := runCmd.Flags()
+	flags.BoolVar(&runOptions.Readiness, "readiness", false, "Run the readiness check instead of the health check")
 }
 
 func run(cmd *cobra.Command, args []string) error {
-	response, err := registry.ContainerEngine().HealthCheckRun(context.Background(), args[0], entities.HealthCheckOptions{})
+	response, err := registry.ContainerEngine().HealthCheckRun(context.Background(), args[0], runOptions)
 	if err != nil {
 		return err
 	}
-	if response.Status == define.HealthCheckUnhealthy || response.Status == define.HealthCheckStarting {
+	if response.Status == define.HealthCheckUnhealthy || response.Status == define.HealthCheckStarting || response.Status == define.ReadinessCheckNotReady {
 		registry.SetExitCode(1)
 		fmt.Println(response.Status)
 	}
@@ -1,10 +1,9 @@
 package images
 
 import (
-	"os"
+	"fmt"
 	"strings"
 
-	"github.com/containers/common/pkg/ssh"
 	"github.com/containers/podman/v4/cmd/podman/common"
 	"github.com/containers/podman/v4/cmd/podman/registry"
 	"github.com/spf13/cobra"
@@ -27,8 +26,7 @@ var (
 )
 
 var (
-	parentFlags []string
-	quiet       bool
+	quiet bool
 )
 
 func init() {
@@ -44,39 +42,18 @@ func scpFlags(cmd *cobra.Command) {
 	flags.BoolVarP(&quiet, "quiet", "q", false, "Suppress the output")
 }
 
-func scp(cmd *cobra.Command, args []string) (finalErr error) {
-	var (
-		err error
-	)
-
-	containerConfig := registry.PodmanConfig()
-
-	sshType := containerConfig.SSHMode
-
-	for i, val := range os.Args {
-		if val == "image" {
-			break
-		}
-		if i == 0 {
-			continue
-		}
-		if strings.Contains(val, "CIRRUS") { // need to skip CIRRUS flags for testing suite purposes
-			continue
-		}
-		parentFlags = append(parentFlags, val)
-	}
-
+func scp(cmd *cobra.Command, args []string) error {
 	src := args[0]
 	dst := ""
 	if len(args) > 1 {
 		dst = args[1]
 	}
 
-	sshEngine := ssh.DefineMode(sshType)
-	err = registry.ImageEngine().Scp(registry.Context(), src, dst, parentFlags, quiet, sshEngine)
+	report, err := registry.ImageEngine().Scp(registry.Context(), src, dst, quiet)
 	if err != nil {
 		return err
 	}
 
+	fmt.Println("Loaded image: " + strings.Join(report.Names, "\nLoaded image: "))
 	return nil
 }
@@ -75,7 +75,7 @@ func generateFlags(cmd *cobra.Command, podmanConfig *entities.PodmanConfig) {
 		defaultGenerateType = podmanConfig.ContainersConfDefaultsRO.Engine.KubeGenerateType
 	}
 	flags.StringVarP(&generateOptions.Type, typeFlagName, "t", defaultGenerateType, "Generate YAML for the given Kubernetes kind")
-	_ = cmd.RegisterFlagCompletionFunc(typeFlagName, completion.AutocompleteNone)
+	_ = cmd.RegisterFlagCompletionFunc(typeFlagName, common.AutocompleteKubeGenerateType)
 
 	replicasFlagName := "replicas"
 	flags.Int32VarP(&generateOptions.Replicas, replicasFlagName, "r", 1, "Set the replicas number for Deployment kind")
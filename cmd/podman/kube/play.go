@@ -379,12 +379,29 @@ func teardown(body io.Reader, options entities.PlayKubeDownOptions) error {
 		podRmErrors   utils.OutputErrors
 		volRmErrors   utils.OutputErrors
 		secRmErrors   utils.OutputErrors
+		cronRmErrors  utils.OutputErrors
 	)
 	reports, err := registry.ContainerEngine().PlayKubeDown(registry.GetContext(), body, options)
 	if err != nil {
 		return err
 	}
 
+	// Output removed cronjobs
+	if len(reports.CronJobRmReport) > 0 {
+		fmt.Println("CronJobs removed:")
+		for _, removed := range reports.CronJobRmReport {
+			switch {
+			case removed.Err != nil:
+				cronRmErrors = append(cronRmErrors, removed.Err)
+			default:
+				fmt.Println(removed.Name)
+			}
+		}
+		if lastCronRmError := cronRmErrors.PrintErrors(); lastCronRmError != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", lastCronRmError)
+		}
+	}
+
 	// Output stopped pods
 	fmt.Println("Pods stopped:")
 	for _, stopped := range reports.StopReport {
@@ -487,6 +504,14 @@ func printPlayReport(report *entities.PlayKubeReport) error {
 		fmt.Println(secret.CreateReport.ID)
 	}
 
+	// Print cronjobs report
+	for i, cronJob := range report.CronJobs {
+		if i == 0 {
+			fmt.Println("CronJobs:")
+		}
+		fmt.Printf("%s (%s)\n", cronJob.Timer, strings.Join(cronJob.OnCalendar, "; "))
+	}
+
 	// Print pods report
 	for _, pod := range report.Pods {
 		for _, l := range pod.Logs {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/containers/common/pkg/auth"
 	"github.com/containers/common/pkg/completion"
@@ -20,9 +21,10 @@ import (
 type manifestAddOptsWrapper struct {
 	entities.ManifestAddOptions
 
-	TLSVerifyCLI   bool // CLI only
-	Insecure       bool // CLI only
-	CredentialsCLI string
+	TLSVerifyCLI           bool // CLI only
+	Insecure               bool // CLI only
+	CredentialsCLI         string
+	ArtifactAnnotationsCLI []string // CLI only
 }
 
 var (
@@ -30,12 +32,13 @@ var (
 	addCmd          = &cobra.Command{
 		Use:               "add [options] LIST IMAGE [IMAGE...]",
 		Short:             "Add images to a manifest list or image index",
-		Long:              "Adds an image to a manifest list or image index.",
+		Long:              "Adds an image to a manifest list or image index, or files as OCI artifacts with --artifact.",
 		RunE:              add,
 		Args:              cobra.MinimumNArgs(2),
-		ValidArgsFunction: common.AutocompleteImages,
+		ValidArgsFunction: addCompletion,
 		Example: `podman manifest add mylist:v1.11 image:v1.11-amd64
-		podman manifest add mylist:v1.11 transport:imageName`,
+		podman manifest add mylist:v1.11 transport:imageName
+		podman manifest add --artifact --artifact-type application/spdx+json --artifact-subject image:v1.11-amd64 mylist:v1.11 sbom.spdx.json`,
 	}
 )
 
@@ -55,6 +58,24 @@ func init() {
 	flags.StringVar(&manifestAddOpts.Arch, archFlagName, "", "override the `architecture` of the specified image")
 	_ = addCmd.RegisterFlagCompletionFunc(archFlagName, completion.AutocompleteArch)
 
+	flags.BoolVar(&manifestAddOpts.Artifact, "artifact", false, "add the specified files as OCI artifacts instead of images")
+
+	artifactAnnotationFlagName := "artifact-annotation"
+	flags.StringSliceVar(&manifestAddOpts.ArtifactAnnotationsCLI, artifactAnnotationFlagName, nil, "set an `annotation` on the manifests of the artifacts")
+	_ = addCmd.RegisterFlagCompletionFunc(artifactAnnotationFlagName, completion.AutocompleteNone)
+
+	artifactLayerTypeFlagName := "artifact-layer-type"
+	flags.StringVar(&manifestAddOpts.ArtifactLayerType, artifactLayerTypeFlagName, "", "set the media `type` of the files added as artifacts")
+	_ = addCmd.RegisterFlagCompletionFunc(artifactLayerTypeFlagName, completion.AutocompleteNone)
+
+	artifactSubjectFlagName := "artifact-subject"
+	flags.StringVar(&manifestAddOpts.ArtifactSubject, artifactSubjectFlagName, "", "set the `image` or instance digest the artifacts refer to")
+	_ = addCmd.RegisterFlagCompletionFunc(artifactSubjectFlagName, common.AutocompleteImages)
+
+	artifactTypeFlagName := "artifact-type"
+	flags.StringVar(&manifestAddOpts.ArtifactType, artifactTypeFlagName, "", "set the artifact `type` of the artifacts")
+	_ = addCmd.RegisterFlagCompletionFunc(artifactTypeFlagName, completion.AutocompleteNone)
+
 	authfileFlagName := "authfile"
 	flags.StringVar(&manifestAddOpts.Authfile, authfileFlagName, auth.GetDefaultAuthFile(), "path of the authentication file. Use REGISTRY_AUTH_FILE environment variable to override")
 	_ = addCmd.RegisterFlagCompletionFunc(authfileFlagName, completion.AutocompleteDefault)
@@ -120,6 +141,24 @@ func add(cmd *cobra.Command, args []string) error {
 		manifestAddOpts.SkipTLSVerify = types.NewOptionalBool(manifestAddOpts.Insecure)
 	}
 
+	if !manifestAddOpts.Artifact {
+		for _, name := range []string{"artifact-annotation", "artifact-layer-type", "artifact-subject", "artifact-type"} {
+			if cmd.Flags().Changed(name) {
+				return fmt.Errorf("--%s requires --artifact", name)
+			}
+		}
+	}
+	if len(manifestAddOpts.ArtifactAnnotationsCLI) > 0 {
+		manifestAddOpts.ArtifactAnnotations = make(map[string]string)
+		for _, annotationSpec := range manifestAddOpts.ArtifactAnnotationsCLI {
+			spec := strings.SplitN(annotationSpec, "=", 2)
+			if len(spec) != 2 {
+				return fmt.Errorf("no value given for artifact annotation %q", spec[0])
+			}
+			manifestAddOpts.ArtifactAnnotations[spec[0]] = spec[1]
+		}
+	}
+
 	listID, err := registry.ImageEngine().ManifestAdd(context.Background(), args[0], args[1:], manifestAddOpts.ManifestAddOptions)
 	if err != nil {
 		return err
@@ -127,3 +166,11 @@ func add(cmd *cobra.Command, args []string) error {
 	fmt.Println(listID)
 	return nil
 }
+
+// addCompletion completes the list and then images, or files with --artifact.
+func addCompletion(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 && manifestAddOpts.Artifact {
+		return nil, cobra.ShellCompDirectiveDefault
+	}
+	return common.AutocompleteImages(cmd, args, toComplete)
+}
@@ -0,0 +1,102 @@
+package pods
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/containers"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/containers/podman/v4/pkg/specgen"
+	"github.com/containers/podman/v4/pkg/specgenutil"
+	"github.com/containers/podman/v4/pkg/util"
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+var (
+	podUpdateDescription = `Updates the cgroup configuration and the restart policy of a given pod.
+
+  The resource limits are applied to the pod cgroup. The restart policy is applied to the pod and all of its containers.`
+
+	podUpdateCommand = &cobra.Command{
+		Use:               "update [options] POD",
+		Short:             "Update an existing pod",
+		Long:              podUpdateDescription,
+		RunE:              update,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompletePods,
+		Example: `podman pod update --cpus=2 --memory=1g mypod
+  podman pod update --restart=on-failure:3 mypod`,
+	}
+)
+
+var (
+	podUpdateOpts  entities.ContainerCreateOptions
+	podRestartFlag string
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: podUpdateCommand,
+		Parent:  podCmd,
+	})
+	common.DefineCreateDefaults(&podUpdateOpts)
+	common.DefineCreateFlags(podUpdateCommand, &podUpdateOpts, entities.UpdateMode)
+
+	flags := podUpdateCommand.Flags()
+	restartFlagName := "restart"
+	flags.StringVar(&podRestartFlag, restartFlagName, "", `Restart policy to apply to the pod and its containers ("always"|"no"|"never"|"on-failure"|"unless-stopped")`)
+	_ = podUpdateCommand.RegisterFlagCompletionFunc(restartFlagName, common.AutocompleteRestartOption)
+}
+
+func update(cmd *cobra.Command, args []string) error {
+	opts := &entities.PodUpdateOptions{
+		NameOrID: args[0],
+	}
+
+	resourcesChanged := false
+	cmd.Flags().Visit(func(f *pflag.Flag) {
+		if f.Name != "restart" {
+			resourcesChanged = true
+		}
+	})
+	if !resourcesChanged && !cmd.Flags().Changed("restart") {
+		return errors.New("must specify at least one resource limit or the restart policy to update")
+	}
+
+	if resourcesChanged {
+		if err := containers.CreateOrUpdateFlags(cmd, &podUpdateOpts); err != nil {
+			return err
+		}
+		// use a specgen since this is the easiest way to hold resource info
+		s := &specgen.SpecGenerator{}
+		s.ResourceLimits = &specs.LinuxResources{}
+		resources, err := specgenutil.GetResources(s, &podUpdateOpts)
+		if err != nil {
+			return err
+		}
+		s.ResourceLimits = resources
+		opts.Specgen = s
+	}
+
+	if cmd.Flags().Changed("restart") {
+		policy, retries, err := util.ParseRestartPolicy(podRestartFlag)
+		if err != nil {
+			return err
+		}
+		opts.RestartPolicy = policy
+		if retries > 0 {
+			opts.RestartRetries = &retries
+		}
+	}
+
+	report, err := registry.ContainerEngine().PodUpdate(registry.Context(), opts)
+	if err != nil {
+		return err
+	}
+	fmt.Println(report.Id)
+	return nil
+}
@@ -160,8 +160,9 @@ func persistentPreRunE(cmd *cobra.Command, args []string) error {
 	// Currently that does not work.
 	// To make it easier for users we will look into the checkpoint archive and
 	// set the runtime to the one used during checkpointing.
-	if !registry.IsRemote() && cmd.Name() == "restore" {
-		if cmd.Flag("import").Changed {
+	// Other restore commands, e.g. volume snapshot restore, have no --import flag.
+	if importFlag := cmd.Flag("import"); !registry.IsRemote() && cmd.Name() == "restore" && importFlag != nil {
+		if importFlag.Changed {
 			runtime, err := crutils.CRGetRuntimeFromArchive(cmd.Flag("import").Value.String())
 			if err != nil {
 				return fmt.Errorf(
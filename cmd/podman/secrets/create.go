@@ -62,35 +62,13 @@ func init() {
 
 func create(cmd *cobra.Command, args []string) error {
 	name := args[0]
-
-	var err error
 	path := args[1]
 
-	var reader io.Reader
-	switch {
-	case env:
-		envValue := os.Getenv(path)
-		if envValue == "" {
-			return fmt.Errorf("cannot create store secret data: environment variable %s is not set", path)
-		}
-		reader = strings.NewReader(envValue)
-	case path == "-" || path == "/dev/stdin":
-		stat, err := os.Stdin.Stat()
-		if err != nil {
-			return err
-		}
-		if (stat.Mode() & os.ModeNamedPipe) == 0 {
-			return errors.New("if `-` is used, data must be passed into stdin")
-		}
-		reader = os.Stdin
-	default:
-		file, err := os.Open(path)
-		if err != nil {
-			return err
-		}
-		defer file.Close()
-		reader = file
+	reader, err := secretDataReader(path, env)
+	if err != nil {
+		return err
 	}
+	defer reader.Close()
 
 	createOpts.Labels, err = parse.GetAllLabels([]string{}, labels)
 	if err != nil {
@@ -104,3 +82,27 @@ func create(cmd *cobra.Command, args []string) error {
 	fmt.Println(report.ID)
 	return nil
 }
+
+// secretDataReader returns a reader for the secret data passed via the given
+// file, stdin ("-") or environment variable.
+func secretDataReader(path string, fromEnv bool) (io.ReadCloser, error) {
+	switch {
+	case fromEnv:
+		envValue := os.Getenv(path)
+		if envValue == "" {
+			return nil, fmt.Errorf("cannot create store secret data: environment variable %s is not set", path)
+		}
+		return io.NopCloser(strings.NewReader(envValue)), nil
+	case path == "-" || path == "/dev/stdin":
+		stat, err := os.Stdin.Stat()
+		if err != nil {
+			return nil, err
+		}
+		if (stat.Mode() & os.ModeNamedPipe) == 0 {
+			return nil, errors.New("if `-` is used, data must be passed into stdin")
+		}
+		return io.NopCloser(os.Stdin), nil
+	default:
+		return os.Open(path)
+	}
+}
@@ -0,0 +1,73 @@
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	updateCmd = &cobra.Command{
+		Use:   "update [options] SECRET [FILE|-]",
+		Short: "Update the data of an existing secret",
+		Long:  "Replace the data of a secret while keeping its ID. Input can be a path to a file or \"-\" (read from stdin), which is the default.",
+		RunE:  update,
+		Args:  cobra.RangeArgs(1, 2),
+		Example: `podman secret update mysecret /path/to/secret
+  printf "secretdata" | podman secret update --restart-containers mysecret -`,
+		ValidArgsFunction: common.AutocompleteSecretUpdate,
+	}
+)
+
+var (
+	updateOpts = entities.SecretUpdateOptions{}
+	updateEnv  = false
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: updateCmd,
+		Parent:  secretCmd,
+	})
+
+	flags := updateCmd.Flags()
+
+	envFlagName := "env"
+	flags.BoolVar(&updateEnv, envFlagName, false, "Read secret data from environment variable")
+
+	restartFlagName := "restart-containers"
+	flags.BoolVar(&updateOpts.RestartContainers, restartFlagName, false, "Restart running containers using the secret")
+}
+
+func update(cmd *cobra.Command, args []string) error {
+	path := "-"
+	if len(args) > 1 {
+		path = args[1]
+	}
+
+	reader, err := secretDataReader(path, updateEnv)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	report, err := registry.ContainerEngine().SecretUpdate(context.Background(), args[0], reader, updateOpts)
+	if err != nil {
+		return err
+	}
+	fmt.Println(report.ID)
+
+	switch {
+	case updateOpts.RestartContainers && len(report.Restarted) > 0:
+		fmt.Fprintf(os.Stderr, "Restarted containers using the secret: %s\n", strings.Join(report.Restarted, ", "))
+	case !updateOpts.RestartContainers && len(report.Containers) > 0:
+		fmt.Fprintf(os.Stderr, "Containers using the secret: %s\n", strings.Join(report.Containers, ", "))
+	}
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/url"
 	"os"
+	"path/filepath"
 	"regexp"
 	"strings"
 
@@ -27,6 +28,7 @@ var (
     [user@]hostname (will default to ssh)
     ssh://[user@]hostname[:port][/path] (will obtain socket path from service, if not given.)
     tcp://hostname:port (not secured)
+    tcp+tls://hostname:port (secured with TLS, see --tls-ca, --tls-cert and --tls-key)
     unix://path (absolute path required)
 `,
 		RunE:              add,
@@ -35,6 +37,7 @@ var (
   podman system connection add --identity ~/.ssh/dev_rsa testing ssh://root@server.fubar.com:2222
   podman system connection add --identity ~/.ssh/dev_rsa --port 22 production root@server.fubar.com
   podman system connection add debug tcp://localhost:8080
+  podman system connection add --tls-ca ca.pem --tls-cert cert.pem --tls-key key.pem ci tcp+tls://server.fubar.com:8443
   `,
 	}
 
@@ -54,6 +57,9 @@ var (
 		Port     int
 		UDSPath  string
 		Default  bool
+		TLSCA    string
+		TLSCert  string
+		TLSKey   string
 	}{}
 )
 
@@ -78,6 +84,18 @@ func init() {
 
 	flags.BoolVarP(&cOpts.Default, "default", "d", false, "Set connection to be default")
 
+	tlsCAFlagName := "tls-ca"
+	flags.StringVar(&cOpts.TLSCA, tlsCAFlagName, "", "path to the CA bundle used to verify a tcp+tls destination")
+	_ = addCmd.RegisterFlagCompletionFunc(tlsCAFlagName, completion.AutocompleteDefault)
+
+	tlsCertFlagName := "tls-cert"
+	flags.StringVar(&cOpts.TLSCert, tlsCertFlagName, "", "path to the client certificate presented to a tcp+tls destination")
+	_ = addCmd.RegisterFlagCompletionFunc(tlsCertFlagName, completion.AutocompleteDefault)
+
+	tlsKeyFlagName := "tls-key"
+	flags.StringVar(&cOpts.TLSKey, tlsKeyFlagName, "", "path to the private key of the client certificate")
+	_ = addCmd.RegisterFlagCompletionFunc(tlsKeyFlagName, completion.AutocompleteDefault)
+
 	registry.Commands = append(registry.Commands, registry.CliCommand{
 		Command: createCmd,
 		Parent:  system.ContextCmd,
@@ -131,6 +149,10 @@ func add(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("invalid ssh mode")
 	}
 
+	if uri.Scheme != "tcp+tls" && (cOpts.TLSCA != "" || cOpts.TLSCert != "" || cOpts.TLSKey != "") {
+		return fmt.Errorf("--tls-ca, --tls-cert and --tls-key options are only supported for tcp+tls scheme")
+	}
+
 	switch uri.Scheme {
 	case "ssh":
 		return ssh.Create(entities, sshMode)
@@ -164,6 +186,35 @@ func add(cmd *cobra.Command, args []string) error {
 		if uri.Port() == "" {
 			return errors.New("tcp scheme requires a port either via --port or in destination URL")
 		}
+	case "tcp+tls":
+		if cmd.Flags().Changed("socket-path") {
+			return errors.New("--socket-path option not supported for tcp+tls scheme")
+		}
+		if cmd.Flags().Changed("identity") {
+			return errors.New("--identity option not supported for tcp+tls scheme")
+		}
+		if uri.Port() == "" {
+			return errors.New("tcp+tls scheme requires a port in destination URL")
+		}
+		if (cOpts.TLSCert == "") != (cOpts.TLSKey == "") {
+			return errors.New("--tls-cert and --tls-key must be set together")
+		}
+		// The TLS files are recorded as part of the URI, bindings read them from there
+		query := uri.Query()
+		for param, file := range map[string]string{"tlsca": cOpts.TLSCA, "tlscert": cOpts.TLSCert, "tlskey": cOpts.TLSKey} {
+			if file == "" {
+				continue
+			}
+			absFile, err := filepath.Abs(file)
+			if err != nil {
+				return err
+			}
+			if _, err := os.Stat(absFile); err != nil {
+				return err
+			}
+			query.Set(param, absFile)
+		}
+		uri.RawQuery = query.Encode()
 	default:
 		logrus.Warnf("%q unknown scheme, no validation provided", uri.Scheme)
 	}
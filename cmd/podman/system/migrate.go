@@ -8,6 +8,7 @@ import (
 	"os"
 
 	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/common"
 	"github.com/containers/podman/v4/cmd/podman/registry"
 	"github.com/containers/podman/v4/cmd/podman/validate"
 	"github.com/containers/podman/v4/libpod/define"
@@ -52,6 +53,10 @@ func init() {
 	newRuntimeFlagName := "new-runtime"
 	flags.StringVar(&migrateOptions.NewRuntime, newRuntimeFlagName, "", "Specify a new runtime for all containers")
 	_ = migrateCommand.RegisterFlagCompletionFunc(newRuntimeFlagName, completion.AutocompleteNone)
+
+	dbBackendFlagName := "database-backend"
+	flags.StringVar(&migrateOptions.DatabaseBackend, dbBackendFlagName, "", "Move all containers, pods and volumes to a new database backend (boltdb or sqlite)")
+	_ = migrateCommand.RegisterFlagCompletionFunc(dbBackendFlagName, common.AutocompleteDatabaseBackend)
 }
 
 func migrate(cmd *cobra.Command, args []string) {
@@ -66,7 +71,7 @@ func migrate(cmd *cobra.Command, args []string) {
 	}
 	defer engine.Shutdown(registry.Context())
 
-	err = engine.Migrate(registry.Context(), cmd.Flags(), registry.PodmanConfig(), migrateOptions)
+	report, err := engine.Migrate(registry.Context(), cmd.Flags(), registry.PodmanConfig(), migrateOptions)
 	if err != nil {
 		fmt.Println(err)
 
@@ -75,5 +80,9 @@ func migrate(cmd *cobra.Command, args []string) {
 		//nolint:gocritic
 		os.Exit(define.ExecErrorCodeGeneric)
 	}
+	if report.DatabaseBackup != "" {
+		fmt.Printf("migrated database from %s to %s, the old database was moved to %s\n", registry.PodmanConfig().ContainersConf.Engine.DBBackend, migrateOptions.DatabaseBackend, report.DatabaseBackup)
+		fmt.Printf("set database_backend=%q in containers.conf to use the new database\n", migrateOptions.DatabaseBackend)
+	}
 	os.Exit(0)
 }
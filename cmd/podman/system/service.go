@@ -4,6 +4,7 @@
 package system
 
 import (
+	"errors"
 	"net/url"
 	"os"
 	"path/filepath"
@@ -37,13 +38,18 @@ Enable a listening service for API access to Podman commands.
 		RunE:              service,
 		ValidArgsFunction: common.AutocompleteDefaultOneArg,
 		Example: `podman system service --time=0 unix:///tmp/podman.sock
-  podman system service --time=0 tcp://localhost:8888`,
+  podman system service --time=0 tcp://localhost:8888
+  podman system service --time=0 --tls-cert=server.pem --tls-key=server-key.pem --tls-client-ca=ca.pem tcp://0.0.0.0:8888`,
 	}
 
 	srvArgs = struct {
-		CorsHeaders string
-		PProfAddr   string
-		Timeout     uint
+		CorsHeaders     string
+		Metrics         bool
+		PProfAddr       string
+		Timeout         uint
+		TLSCertFile     string
+		TLSKeyFile      string
+		TLSClientCAFile string
 	}{}
 )
 
@@ -65,6 +71,20 @@ func init() {
 	flags.StringVarP(&srvArgs.CorsHeaders, "cors", "", "", "Set CORS Headers")
 	_ = srvCmd.RegisterFlagCompletionFunc("cors", completion.AutocompleteNone)
 
+	flags.BoolVar(&srvArgs.Metrics, "metrics", false, "Expose Prometheus metrics on the /metrics endpoint")
+
+	tlsCertFlagName := "tls-cert"
+	flags.StringVar(&srvArgs.TLSCertFile, tlsCertFlagName, "", "PEM file containing the TLS certificate served by a tcp service")
+	_ = srvCmd.RegisterFlagCompletionFunc(tlsCertFlagName, completion.AutocompleteDefault)
+
+	tlsKeyFlagName := "tls-key"
+	flags.StringVar(&srvArgs.TLSKeyFile, tlsKeyFlagName, "", "PEM file containing the private key of the TLS certificate")
+	_ = srvCmd.RegisterFlagCompletionFunc(tlsKeyFlagName, completion.AutocompleteDefault)
+
+	tlsClientCAFlagName := "tls-client-ca"
+	flags.StringVar(&srvArgs.TLSClientCAFile, tlsClientCAFlagName, "", "Only accept clients presenting a certificate signed by a CA in this PEM file")
+	_ = srvCmd.RegisterFlagCompletionFunc(tlsClientCAFlagName, completion.AutocompleteDefault)
+
 	flags.StringVarP(&srvArgs.PProfAddr, "pprof-address", "", "",
 		"Binding network address for pprof profile endpoints, default: do not expose endpoints")
 	_ = flags.MarkHidden("pprof-address")
@@ -83,6 +103,13 @@ func service(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if (srvArgs.TLSCertFile == "") != (srvArgs.TLSKeyFile == "") {
+		return errors.New("--tls-cert and --tls-key must be set together")
+	}
+	if srvArgs.TLSClientCAFile != "" && srvArgs.TLSCertFile == "" {
+		return errors.New("--tls-client-ca requires --tls-cert and --tls-key")
+	}
+
 	// Clean up any old existing unix domain socket
 	if len(apiURI) > 0 {
 		uri, err := url.Parse(apiURI)
@@ -90,6 +117,10 @@ func service(cmd *cobra.Command, args []string) error {
 			return err
 		}
 
+		if srvArgs.TLSCertFile != "" && uri.Scheme != "tcp" {
+			return errors.New("TLS is only supported for tcp:// endpoints")
+		}
+
 		// socket activation uses a unix:// socket in the shipped unit files but apiURI is coded as "" at this layer.
 		if uri.Scheme == "unix" && !registry.IsRemote() {
 			if err := syscall.Unlink(uri.Path); err != nil && !os.IsNotExist(err) {
@@ -101,10 +132,14 @@ func service(cmd *cobra.Command, args []string) error {
 	}
 
 	return restService(cmd.Flags(), registry.PodmanConfig(), entities.ServiceOptions{
-		CorsHeaders: srvArgs.CorsHeaders,
-		PProfAddr:   srvArgs.PProfAddr,
-		Timeout:     time.Duration(srvArgs.Timeout) * time.Second,
-		URI:         apiURI,
+		CorsHeaders:     srvArgs.CorsHeaders,
+		Metrics:         srvArgs.Metrics,
+		PProfAddr:       srvArgs.PProfAddr,
+		Timeout:         time.Duration(srvArgs.Timeout) * time.Second,
+		TLSCertFile:     srvArgs.TLSCertFile,
+		TLSKeyFile:      srvArgs.TLSKeyFile,
+		TLSClientCAFile: srvArgs.TLSClientCAFile,
+		URI:             apiURI,
 	})
 }
 
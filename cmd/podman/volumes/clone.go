@@ -0,0 +1,41 @@
+package volumes
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	cloneDescription = `Create a new volume with a copy of the data, labels and options of a volume.
+
+  Files are cloned with reflinks on filesystems supporting them, otherwise they are copied.`
+	cloneCommand = &cobra.Command{
+		Use:               "clone SOURCE DESTINATION",
+		Short:             "Clone a volume",
+		Long:              cloneDescription,
+		RunE:              clone,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: common.AutocompleteVolumes,
+		Example:           `podman volume clone db db-copy`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: cloneCommand,
+		Parent:  volumeCmd,
+	})
+}
+
+func clone(cmd *cobra.Command, args []string) error {
+	response, err := registry.ContainerEngine().VolumeClone(registry.Context(), args[0], entities.VolumeCloneOptions{Name: args[1]})
+	if err != nil {
+		return err
+	}
+	fmt.Println(response.IDOrName)
+	return nil
+}
@@ -0,0 +1,27 @@
+package volumes
+
+import (
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command: podman volume _plugin_
+	pluginCmd = &cobra.Command{
+		Annotations: map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:         "plugin",
+		Short:       "Manage volume plugins",
+		Long: `Manage volume plugins set in containers.conf or discovered in the Docker plugin directories.
+
+  Discovered plugins must be enabled before they can be used as volume driver.`,
+		RunE: validate.SubCommandExists,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: pluginCmd,
+		Parent:  volumeCmd,
+	})
+}
@@ -0,0 +1,40 @@
+package volumes
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pluginDisableDescription = `Disable a volume plugin discovered in the Docker plugin directories.
+
+  Plugins set in containers.conf and plugins used by volumes cannot be disabled.`
+	pluginDisableCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "disable PLUGIN",
+		Short:             "Disable a volume plugin",
+		Long:              pluginDisableDescription,
+		RunE:              pluginDisable,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteVolumePlugins,
+		Example:           `podman volume plugin disable myplugin`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: pluginDisableCommand,
+		Parent:  pluginCmd,
+	})
+}
+
+func pluginDisable(cmd *cobra.Command, args []string) error {
+	if err := registry.ContainerEngine().VolumePluginDisable(registry.Context(), args[0]); err != nil {
+		return err
+	}
+	fmt.Println(args[0])
+	return nil
+}
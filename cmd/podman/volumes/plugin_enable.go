@@ -0,0 +1,40 @@
+package volumes
+
+import (
+	"fmt"
+
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pluginEnableDescription = `Enable a volume plugin discovered in the Docker plugin directories.
+
+  The plugin must be running. Once enabled, it can be used as volume driver.`
+	pluginEnableCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "enable PLUGIN",
+		Short:             "Enable a volume plugin",
+		Long:              pluginEnableDescription,
+		RunE:              pluginEnable,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteVolumePlugins,
+		Example:           `podman volume plugin enable myplugin`,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: pluginEnableCommand,
+		Parent:  pluginCmd,
+	})
+}
+
+func pluginEnable(cmd *cobra.Command, args []string) error {
+	if err := registry.ContainerEngine().VolumePluginEnable(registry.Context(), args[0]); err != nil {
+		return err
+	}
+	fmt.Println(args[0])
+	return nil
+}
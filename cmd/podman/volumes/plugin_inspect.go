@@ -0,0 +1,78 @@
+package volumes
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pluginInspectDescription = `Display detailed information on one or more volume plugins.
+
+  The plugins are queried for their capabilities and must therefore be running.
+  Use a Go template to change the format from JSON.`
+	pluginInspectCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "inspect [options] PLUGIN [PLUGIN...]",
+		Short:             "Display detailed information on one or more volume plugins",
+		Long:              pluginInspectDescription,
+		RunE:              pluginInspect,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: common.AutocompleteVolumePlugins,
+		Example: `podman volume plugin inspect myplugin
+  podman volume plugin inspect --format "{{.Scope}}" myplugin`,
+	}
+)
+
+var pluginInspectFormat string
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: pluginInspectCommand,
+		Parent:  pluginCmd,
+	})
+	flags := pluginInspectCommand.Flags()
+
+	formatFlagName := "format"
+	flags.StringVarP(&pluginInspectFormat, formatFlagName, "f", "json", "Format volume plugin output using Go template")
+	_ = pluginInspectCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&entities.VolumePluginReport{}))
+}
+
+func pluginInspect(cmd *cobra.Command, args []string) error {
+	inspected, errs, err := registry.ContainerEngine().VolumePluginInspect(registry.Context(), args)
+	if err != nil {
+		return err
+	}
+
+	if report.IsJSON(pluginInspectFormat) {
+		buf, err := json.MarshalIndent(inspected, "", "     ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(buf))
+	} else {
+		rpt := report.New(os.Stdout, cmd.Name())
+		defer rpt.Flush()
+
+		rpt, err := rpt.Parse(report.OriginUser, pluginInspectFormat)
+		if err != nil {
+			return err
+		}
+		if err := rpt.Execute(inspected); err != nil {
+			return err
+		}
+	}
+
+	if len(errs) > 0 {
+		for _, err := range errs[1:] {
+			fmt.Fprintf(os.Stderr, "error inspecting volume plugin: %v\n", err)
+		}
+		return fmt.Errorf("inspecting volume plugin: %w", errs[0])
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+package volumes
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	pluginLsDescription = `
+podman volume plugin ls
+
+List the volume plugins set in containers.conf and the plugins discovered in the
+Docker plugin directories.`
+	pluginLsCommand = &cobra.Command{
+		Annotations:       map[string]string{registry.EngineMode: registry.ABIMode},
+		Use:               "ls [options]",
+		Aliases:           []string{"list"},
+		Args:              validate.NoArgs,
+		Short:             "List volume plugins",
+		Long:              pluginLsDescription,
+		RunE:              pluginList,
+		ValidArgsFunction: completion.AutocompleteNone,
+		Example: `podman volume plugin ls
+  podman volume plugin ls --format "{{.Name}} {{.Enabled}}"`,
+	}
+)
+
+var (
+	pluginLsOpts = struct {
+		Format string
+		Quiet  bool
+	}{}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: pluginLsCommand,
+		Parent:  pluginCmd,
+	})
+	flags := pluginLsCommand.Flags()
+
+	formatFlagName := "format"
+	flags.StringVar(&pluginLsOpts.Format, formatFlagName, "{{range .}}{{.Name}}\t{{.Source}}\t{{.Enabled}}\t{{.Socket}}\n{{end -}}", "Format volume plugin output using Go template")
+	_ = pluginLsCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&entities.VolumePluginReport{}))
+
+	flags.BoolP("noheading", "n", false, "Do not print headers")
+	flags.BoolVarP(&pluginLsOpts.Quiet, "quiet", "q", false, "Print volume plugin names only")
+}
+
+func pluginList(cmd *cobra.Command, args []string) error {
+	if pluginLsOpts.Quiet && cmd.Flag("format").Changed {
+		return errors.New("quiet and format flags cannot be used together")
+	}
+
+	responses, err := registry.ContainerEngine().VolumePluginList(registry.Context())
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case report.IsJSON(pluginLsOpts.Format):
+		b, err := json.MarshalIndent(responses, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	case len(responses) < 1:
+		return nil
+	}
+
+	noHeading, _ := cmd.Flags().GetBool("noheading")
+	headers := report.Headers(entities.VolumePluginReport{}, nil)
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	switch {
+	case cmd.Flag("format").Changed:
+		rpt, err = rpt.Parse(report.OriginUser, pluginLsOpts.Format)
+	case pluginLsOpts.Quiet:
+		rpt, err = rpt.Parse(report.OriginUser, "{{.Name}}\n")
+	default:
+		rpt, err = rpt.Parse(report.OriginPodman, pluginLsOpts.Format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rpt.RenderHeaders && !noHeading {
+		if err := rpt.Execute(headers); err != nil {
+			return fmt.Errorf("failed to write report column headers: %w", err)
+		}
+	}
+	return rpt.Execute(responses)
+}
@@ -0,0 +1,26 @@
+package volumes
+
+import (
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/cmd/podman/validate"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// Command: podman volume _snapshot_
+	snapshotCmd = &cobra.Command{
+		Use:   "snapshot",
+		Short: "Manage volume snapshots",
+		Long: `Create, list, restore and remove snapshots of the data of volumes.
+
+  Only volumes of the local driver without mount options can be snapshotted.`,
+		RunE: validate.SubCommandExists,
+	}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: snapshotCmd,
+		Parent:  volumeCmd,
+	})
+}
@@ -0,0 +1,52 @@
+package volumes
+
+import (
+	"fmt"
+
+	"github.com/containers/common/pkg/completion"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/spf13/cobra"
+)
+
+var (
+	snapshotCreateDescription = `Copy the data of a volume into a new snapshot.
+
+  Files are cloned with reflinks on filesystems supporting them, otherwise they are copied.`
+	snapshotCreateCommand = &cobra.Command{
+		Use:               "create [options] VOLUME",
+		Short:             "Create a snapshot of a volume",
+		Long:              snapshotCreateDescription,
+		RunE:              snapshotCreate,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: common.AutocompleteVolumes,
+		Example: `podman volume snapshot create db
+  podman volume snapshot create --name seeded db`,
+	}
+)
+
+var (
+	snapshotCreateOpts = entities.VolumeSnapshotCreateOptions{}
+)
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: snapshotCreateCommand,
+		Parent:  snapshotCmd,
+	})
+	flags := snapshotCreateCommand.Flags()
+
+	nameFlagName := "name"
+	flags.StringVar(&snapshotCreateOpts.Name, nameFlagName, "", "Name of the snapshot (default random)")
+	_ = snapshotCreateCommand.RegisterFlagCompletionFunc(nameFlagName, completion.AutocompleteNone)
+}
+
+func snapshotCreate(cmd *cobra.Command, args []string) error {
+	response, err := registry.ContainerEngine().VolumeSnapshotCreate(registry.Context(), args[0], snapshotCreateOpts)
+	if err != nil {
+		return err
+	}
+	fmt.Println(response.Name)
+	return nil
+}
@@ -0,0 +1,118 @@
+package volumes
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/containers/common/pkg/report"
+	"github.com/containers/podman/v4/cmd/podman/common"
+	"github.com/containers/podman/v4/cmd/podman/registry"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/docker/go-units"
+	"github.com/spf13/cobra"
+)
+
+var (
+	snapshotLsDescription = `
+podman volume snapshot ls
+
+List the snapshots of a volume, oldest first.`
+	snapshotLsCommand = &cobra.Command{
+		Use:               "ls [options] VOLUME",
+		Aliases:           []string{"list"},
+		Args:              cobra.ExactArgs(1),
+		Short:             "List the snapshots of a volume",
+		Long:              snapshotLsDescription,
+		RunE:              snapshotList,
+		ValidArgsFunction: common.AutocompleteVolumes,
+		Example: `podman volume snapshot ls db
+  podman volume snapshot ls --format "{{.Name}} {{.CreatedAt}}" db`,
+	}
+)
+
+var (
+	snapshotLsOpts = struct {
+		Format string
+		Quiet  bool
+	}{}
+)
+
+// snapshotReporter adds the human-readable creation time to the report.
+type snapshotReporter struct {
+	entities.VolumeSnapshotReport
+}
+
+// Created returns the time elapsed since the snapshot was created.
+func (s snapshotReporter) Created() string {
+	return units.HumanDuration(time.Since(s.CreatedAt)) + " ago"
+}
+
+func init() {
+	registry.Commands = append(registry.Commands, registry.CliCommand{
+		Command: snapshotLsCommand,
+		Parent:  snapshotCmd,
+	})
+	flags := snapshotLsCommand.Flags()
+
+	formatFlagName := "format"
+	flags.StringVar(&snapshotLsOpts.Format, formatFlagName, "{{range .}}{{.Name}}\t{{.Created}}\n{{end -}}", "Format snapshot output using Go template")
+	_ = snapshotLsCommand.RegisterFlagCompletionFunc(formatFlagName, common.AutocompleteFormat(&snapshotReporter{}))
+
+	flags.BoolP("noheading", "n", false, "Do not print headers")
+	flags.BoolVarP(&snapshotLsOpts.Quiet, "quiet", "q", false, "Print snapshot names only")
+}
+
+func snapshotList(cmd *cobra.Command, args []string) error {
+	if snapshotLsOpts.Quiet && cmd.Flag("format").Changed {
+		return errors.New("quiet and format flags cannot be used together")
+	}
+
+	responses, err := registry.ContainerEngine().VolumeSnapshotList(registry.Context(), args[0])
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case report.IsJSON(snapshotLsOpts.Format):
+		b, err := json.MarshalIndent(responses, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	case len(responses) < 1:
+		return nil
+	}
+
+	snapshots := make([]snapshotReporter, 0, len(responses))
+	for _, r := range responses {
+		snapshots = append(snapshots, snapshotReporter{*r})
+	}
+
+	noHeading, _ := cmd.Flags().GetBool("noheading")
+	headers := report.Headers(snapshotReporter{}, nil)
+
+	rpt := report.New(os.Stdout, cmd.Name())
+	defer rpt.Flush()
+
+	switch {
+	case cmd.Flag("format").Changed:
+		rpt, err = rpt.Parse(report.OriginUser, snapshotLsOpts.Format)
+	case snapshotLsOpts.Quiet:
+		rpt, err = rpt.Parse(report.OriginUser, "{{.Name}}\n")
+	default:
+		rpt, err = rpt.Parse(report.OriginPodman, snapshotLsOpts.Format)
+	}
+	if err != nil {
+		return err
+	}
+
+	if rpt.RenderHeaders && !noHeading {
+		if err := rpt.Execute(headers); err != nil {
+			return fmt.Errorf("failed to write report column headers: %w", err)
+		}
+	}
+	return rpt.Execute(snapshots)
+}
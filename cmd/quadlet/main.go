@@ -8,6 +8,7 @@ import (
 	"os/user"
 	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"unicode"
 
@@ -42,9 +43,11 @@ var (
 	void                struct{}
 	supportedExtensions = map[string]struct{}{
 		".container": void,
+		".image":     void,
 		".volume":    void,
 		".kube":      void,
 		".network":   void,
+		".pod":       void,
 	}
 )
 
@@ -292,13 +295,30 @@ func isUnambiguousName(imageName string) bool {
 //
 // We implement a simple version of this from scratch here to avoid
 // a huge dependency in the generator just for a warning.
-func warnIfAmbiguousName(container *parser.UnitFile) {
-	imageName, ok := container.Lookup(quadlet.ContainerGroup, quadlet.KeyImage)
+func warnIfAmbiguousName(unit *parser.UnitFile, group string) {
+	imageName, ok := unit.Lookup(group, quadlet.KeyImage)
 	if !ok {
 		return
 	}
+	// Image units are checked on their own
+	if strings.HasSuffix(imageName, ".image") {
+		return
+	}
 	if !isUnambiguousName(imageName) {
-		Logf("Warning: %s specifies the image \"%s\" which not a fully qualified image name. This is not ideal for performance and security reasons. See the podman-pull manpage discussion of short-name-aliases.conf for details.", container.Filename, imageName)
+		Logf("Warning: %s specifies the image \"%s\" which not a fully qualified image name. This is not ideal for performance and security reasons. See the podman-pull manpage discussion of short-name-aliases.conf for details.", unit.Filename, imageName)
+	}
+}
+
+// Images need to be converted before the containers using them and pods
+// after the containers joining them
+func conversionOrder(name string) int {
+	switch {
+	case strings.HasSuffix(name, ".image"):
+		return 0
+	case strings.HasSuffix(name, ".pod"):
+		return 2
+	default:
+		return 1
 	}
 }
 
@@ -357,20 +377,44 @@ func main() {
 		}
 	}
 
-	for name, unit := range units {
+	// Containers need to know about the images and pods they reference,
+	// so collect them first
+	imagesInfoMap := make(map[string]*quadlet.ImageInfo)
+	podsInfoMap := make(map[string]*quadlet.PodInfo)
+	names := make([]string, 0, len(units))
+	for name := range units {
+		switch {
+		case strings.HasSuffix(name, ".image"):
+			imagesInfoMap[name] = quadlet.NewImageInfo(name)
+		case strings.HasSuffix(name, ".pod"):
+			podsInfoMap[name] = quadlet.NewPodInfo(name)
+		}
+		names = append(names, name)
+	}
+	sort.SliceStable(names, func(i, j int) bool {
+		return conversionOrder(names[i]) < conversionOrder(names[j])
+	})
+
+	for _, name := range names {
+		unit := units[name]
 		var service *parser.UnitFile
 		var err error
 
 		switch {
 		case strings.HasSuffix(name, ".container"):
-			warnIfAmbiguousName(unit)
-			service, err = quadlet.ConvertContainer(unit, isUserFlag)
+			warnIfAmbiguousName(unit, quadlet.ContainerGroup)
+			service, err = quadlet.ConvertContainer(unit, isUserFlag, imagesInfoMap, podsInfoMap)
+		case strings.HasSuffix(name, ".image"):
+			warnIfAmbiguousName(unit, quadlet.ImageGroup)
+			service, err = quadlet.ConvertImage(unit, imagesInfoMap)
 		case strings.HasSuffix(name, ".volume"):
 			service, err = quadlet.ConvertVolume(unit, name)
 		case strings.HasSuffix(name, ".kube"):
 			service, err = quadlet.ConvertKube(unit, isUserFlag)
 		case strings.HasSuffix(name, ".network"):
 			service, err = quadlet.ConvertNetwork(unit, name)
+		case strings.HasSuffix(name, ".pod"):
+			service, err = quadlet.ConvertPod(unit, name, podsInfoMap, isUserFlag)
 		default:
 			Logf("Unsupported file type '%s'", name)
 			continue
@@ -195,30 +195,29 @@ outer:
 		return err
 	}
 
-	// we only need to have a socket to reload ports when we run under rootless cni
-	if cfg.RootlessCNI {
-		socketfile := filepath.Join(socketDir, cfg.ContainerID)
-		// make sure to remove the file if it exists to prevent EADDRINUSE
-		_ = os.Remove(socketfile)
-		// workaround to bypass the 108 char socket path limit
-		// open the fd and use the path to the fd as bind argument
-		fd, err := unix.Open(socketDir, unix.O_PATH, 0)
-		if err != nil {
-			return err
-		}
-		socket, err := net.ListenUnix("unixpacket", &net.UnixAddr{Name: fmt.Sprintf("/proc/self/fd/%d/%s", fd, cfg.ContainerID), Net: "unixpacket"})
-		if err != nil {
-			return err
-		}
-		err = unix.Close(fd)
-		// remove the socket file on exit
-		defer os.Remove(socketfile)
-		if err != nil {
-			logrus.Warnf("Failed to close the socketDir fd: %v", err)
-		}
-		defer socket.Close()
-		go serve(socket, driver)
+	// the socket is used to reload the ports under rootless cni and to add
+	// and remove ports of the running container
+	socketfile := filepath.Join(socketDir, cfg.ContainerID)
+	// make sure to remove the file if it exists to prevent EADDRINUSE
+	_ = os.Remove(socketfile)
+	// workaround to bypass the 108 char socket path limit
+	// open the fd and use the path to the fd as bind argument
+	fd, err := unix.Open(socketDir, unix.O_PATH, 0)
+	if err != nil {
+		return err
+	}
+	socket, err := net.ListenUnix("unixpacket", &net.UnixAddr{Name: fmt.Sprintf("/proc/self/fd/%d/%s", fd, cfg.ContainerID), Net: "unixpacket"})
+	if err != nil {
+		return err
 	}
+	err = unix.Close(fd)
+	// remove the socket file on exit
+	defer os.Remove(socketfile)
+	if err != nil {
+		logrus.Warnf("Failed to close the socketDir fd: %v", err)
+	}
+	defer socket.Close()
+	go serve(socket, driver)
 
 	logrus.Info("Ready")
 
@@ -264,12 +263,20 @@ func serve(listener net.Listener, pm rkport.Manager) {
 }
 
 func handler(ctx context.Context, conn io.Reader, pm rkport.Manager) error {
-	var childIP string
+	var request json.RawMessage
 	dec := json.NewDecoder(conn)
-	err := dec.Decode(&childIP)
+	err := dec.Decode(&request)
 	if err != nil {
 		return fmt.Errorf("rootless port failed to decode ports: %w", err)
 	}
+	var childIP string
+	if err := json.Unmarshal(request, &childIP); err != nil {
+		var update rootlessport.PortUpdate
+		if err := json.Unmarshal(request, &update); err != nil {
+			return fmt.Errorf("rootless port failed to decode ports: %w", err)
+		}
+		return updatePorts(ctx, pm, update)
+	}
 	portStatus, err := pm.ListPorts(ctx)
 	if err != nil {
 		return fmt.Errorf("rootless port failed to list ports: %w", err)
@@ -292,6 +299,44 @@ func handler(ctx context.Context, conn io.Reader, pm rkport.Manager) error {
 	return nil
 }
 
+// updatePorts removes and adds the ports of the update.
+func updatePorts(ctx context.Context, pm rkport.Manager, update rootlessport.PortUpdate) error {
+	portStatus, err := pm.ListPorts(ctx)
+	if err != nil {
+		return fmt.Errorf("rootless port failed to list ports: %w", err)
+	}
+	for _, port := range update.Remove {
+		protocols := strings.Split(port.Protocol, ",")
+		for _, protocol := range protocols {
+			hostIP := port.HostIP
+			if hostIP == "" {
+				hostIP = "0.0.0.0"
+			}
+			for i := uint16(0); i < port.Range; i++ {
+				spec := rkport.Spec{
+					Proto:      protocol,
+					ParentIP:   hostIP,
+					ParentPort: int(port.HostPort + i),
+				}
+				for _, spec = range splitDualStackSpecIfWsl(spec) {
+					for _, status := range portStatus {
+						if status.Spec.Proto != spec.Proto || status.Spec.ParentIP != spec.ParentIP || status.Spec.ParentPort != spec.ParentPort {
+							continue
+						}
+						if err := pm.RemovePort(ctx, status.ID); err != nil {
+							return fmt.Errorf("rootless port failed to remove port: %w", err)
+						}
+					}
+				}
+			}
+		}
+	}
+	if err := exposePorts(pm, update.Add, update.ChildIP); err != nil {
+		return fmt.Errorf("rootless port failed to add port: %w", err)
+	}
+	return nil
+}
+
 func exposePorts(pm rkport.Manager, portMappings []types.PortMapping, childIP string) error {
 	ctx := context.TODO()
 	for _, port := range portMappings {
@@ -106,6 +106,11 @@ type Container struct {
 	// Functions called on a batched container will not lock or sync
 	batched bool
 
+	// preStopHook allows exec sessions while the container is stopping.
+	// It is only set on the copy of the container that runs the pre-stop
+	// hook.
+	preStopHook bool
+
 	valid      bool
 	lock       lock.Locker
 	runtime    *Runtime
@@ -214,6 +219,14 @@ type ContainerState struct {
 	// healthcheck. The container will be restarted if this exceed a set
 	// number in the startup HC config.
 	StartupHCFailureCount int `json:"startupHCFailureCount,omitempty"`
+	// Ready indicates that the readiness check of the container passed.
+	Ready bool `json:"ready,omitempty"`
+	// ReadinessSuccessCount is the number of consecutive successes of the
+	// readiness check.
+	ReadinessSuccessCount int `json:"readinessSuccessCount,omitempty"`
+	// ReadinessFailureCount is the number of consecutive failures of the
+	// readiness check.
+	ReadinessFailureCount int `json:"readinessFailureCount,omitempty"`
 
 	// ExtensionStageHooks holds hooks which will be executed by libpod
 	// and not delegated to the OCI runtime.
@@ -981,6 +994,37 @@ func (c *Container) StartupHCPassed() (bool, error) {
 	return c.state.StartupHCPassed, nil
 }
 
+// ReadinessStatus returns the readiness of a container with a readiness check,
+// either define.ReadinessCheckReady or define.ReadinessCheckNotReady.
+// Returns an empty string if no readiness check is defined for the container.
+func (c *Container) ReadinessStatus() (string, error) {
+	if c.config.ReadinessCheckConfig == nil {
+		return "", nil
+	}
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return "", err
+		}
+	}
+
+	return c.readinessStatus(), nil
+}
+
+// Internal function to return the readiness of a container.
+// This function does not lock the container.
+func (c *Container) readinessStatus() string {
+	if c.config.ReadinessCheckConfig == nil {
+		return ""
+	}
+	if c.state.Ready && c.ensureState(define.ContainerStateRunning) {
+		return define.ReadinessCheckReady
+	}
+	return define.ReadinessCheckNotReady
+}
+
 // Misc Accessors
 // Most will require locking
 
@@ -10,7 +10,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/common/pkg/resize"
+	"github.com/containers/image/v5/manifest"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/libpod/events"
 	"github.com/containers/podman/v4/pkg/signal"
@@ -117,12 +119,20 @@ func (c *Container) Start(ctx context.Context, recursive bool) (finalErr error)
 }
 
 // Update updates the given container.
-// only the cgroup config can be updated and therefore only a linux resource spec is passed.
-func (c *Container) Update(res *spec.LinuxResources) error {
-	if err := c.syncContainer(); err != nil {
-		return err
+// The resource limits are applied to the running container through the OCI
+// runtime and are not persisted.  All other changes are written to the
+// container's configuration in the database and take effect immediately; a
+// changed health check is re-armed if the container is running.
+func (c *Container) Update(options *ContainerUpdateOptions) error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
 	}
-	return c.update(res)
+	return c.update(options)
 }
 
 // StartAndAttach starts a container and attaches to it.
@@ -197,7 +207,16 @@ func (c *Container) StartAndAttach(ctx context.Context, streams *define.AttachSt
 }
 
 // RestartWithTimeout restarts a running container and takes a given timeout in uint
-func (c *Container) RestartWithTimeout(ctx context.Context, timeout uint) error {
+func (c *Container) RestartWithTimeout(ctx context.Context, timeout uint) (retErr error) {
+	// As this is the first defer, it runs after the container is
+	// unlocked which is required as the dependents lock their
+	// dependencies.
+	defer func() {
+		if retErr == nil && c.config.RestartDependents && !c.batched {
+			retErr = c.restartDependents(ctx)
+		}
+	}()
+
 	if !c.batched {
 		c.lock.Lock()
 		defer c.lock.Unlock()
@@ -765,7 +784,17 @@ func (c *Container) WaitForConditionWithInterval(ctx context.Context, waitTimeou
 
 // Cleanup unmounts all mount points in container and cleans up container storage
 // It also cleans up the network stack
-func (c *Container) Cleanup(ctx context.Context) error {
+func (c *Container) Cleanup(ctx context.Context) (retErr error) {
+	didRestart := false
+	// As this is the first defer, it runs after the container is
+	// unlocked which is required as the dependents lock their
+	// dependencies.
+	defer func() {
+		if retErr == nil && didRestart && c.config.RestartDependents && !c.batched {
+			retErr = c.restartDependents(ctx)
+		}
+	}()
+
 	if !c.batched {
 		c.lock.Lock()
 		defer c.lock.Unlock()
@@ -796,7 +825,8 @@ func (c *Container) Cleanup(ctx context.Context) error {
 	// Handle restart policy.
 	// Returns a bool indicating whether we actually restarted.
 	// If we did, don't proceed to cleanup - just exit.
-	didRestart, err := c.handleRestartPolicy(ctx)
+	var err error
+	didRestart, err = c.handleRestartPolicy(ctx)
 	if err != nil {
 		return err
 	}
@@ -915,6 +945,48 @@ func (c *Container) ReloadNetwork() error {
 	return c.reloadNetwork()
 }
 
+// AddPortMappings publishes the given ports of the container.  The ports must
+// be normalized, with one protocol and a host port set for every mapping.
+// If the network of the container is configured, the ports are forwarded
+// immediately.  The new port mappings are kept in the container's
+// configuration.
+func (c *Container) AddPortMappings(ports []types.PortMapping) error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	newPorts, err := addPortMappings(c.config.PortMappings, ports)
+	if err != nil {
+		return err
+	}
+	return c.updatePortMappings(newPorts, ports, nil)
+}
+
+// RemovePortMappings unpublishes the given ports of the container.  The
+// ports must be normalized the same way as for AddPortMappings.  A port that
+// is part of a published range is removed from the range.
+func (c *Container) RemovePortMappings(ports []types.PortMapping) error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	newPorts, err := removePortMappings(c.config.PortMappings, ports)
+	if err != nil {
+		return err
+	}
+	return c.updatePortMappings(newPorts, nil, ports)
+}
+
 // Refresh is DEPRECATED and REMOVED.
 func (c *Container) Refresh(ctx context.Context) error {
 	// This has been deprecated for a long while, and is in the process of
@@ -922,6 +994,27 @@ func (c *Container) Refresh(ctx context.Context) error {
 	return define.ErrNotImplemented
 }
 
+// ContainerUpdateOptions is a struct used to pass the changes applied by
+// Update.  Nil fields are left untouched.
+type ContainerUpdateOptions struct {
+	// Resources are the new cgroup limits of the running container
+	Resources *spec.LinuxResources
+	// RestartPolicy is the new restart policy of the container
+	RestartPolicy *string
+	// RestartRetries is the number of restart attempts of the
+	// on-failure restart policy
+	RestartRetries *uint
+	// HealthCheckConfig replaces the health check of the container.
+	// A test of ["NONE"] disables the health check.
+	HealthCheckConfig *manifest.Schema2HealthConfig
+	// HealthCheckOnFailureAction is the action taken once the container
+	// turns unhealthy
+	HealthCheckOnFailureAction *define.HealthCheckOnFailureAction
+	// Labels are added to the labels of the container, existing labels
+	// with the same key are overwritten
+	Labels map[string]string
+}
+
 // ContainerCheckpointOptions is a struct used to pass the parameters
 // for checkpointing (and restoring) to the corresponding functions
 type ContainerCheckpointOptions struct {
@@ -961,11 +1054,15 @@ type ContainerCheckpointOptions struct {
 	IgnoreVolumes bool
 	// Pre Checkpoint container and leave container running
 	PreCheckPoint bool
-	// Dump container with Pre Checkpoint images
+	// Dump container with Pre Checkpoint images. Combined with
+	// PreCheckPoint, only the memory changed since the previous
+	// pre-checkpoint is dumped.
 	WithPrevious bool
-	// ImportPrevious tells the API to restore container with two
-	// images. One is TargetFile, the other is ImportPrevious.
-	ImportPrevious string
+	// ImportPrevious tells the API to restore container with the
+	// pre-checkpoint images in ImportPrevious and the checkpoint in
+	// TargetFile. The pre-checkpoint images must be listed in the
+	// order they were created.
+	ImportPrevious []string
 	// CreateImage tells Podman to create an OCI image from container
 	// checkpoint in the local image store.
 	CreateImage string
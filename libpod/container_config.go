@@ -383,6 +383,10 @@ type ContainerMiscConfig struct {
 	// restart the container. Used only if RestartPolicy is set to
 	// "on-failure".
 	RestartRetries uint `json:"restart_retries,omitempty"`
+	// RestartDependents indicates that the containers depending on this
+	// container are restarted, in dependency order, whenever this container
+	// is restarted.
+	RestartDependents bool `json:"restart_dependents,omitempty"`
 	// PostConfigureNetNS needed when a user namespace is created by an OCI runtime
 	// if the network namespace is created before the user namespace it will be
 	// owned by the wrong user namespace.
@@ -409,6 +413,16 @@ type ContainerMiscConfig struct {
 	// healthcheck for the container. This will run before the regular HC
 	// runs, and when it passes the regular HC will be activated.
 	StartupHealthCheckConfig *define.StartupHealthCheck `json:"startupHealthCheck,omitempty"`
+	// ReadinessCheckConfig is the configuration of the readiness check of
+	// the container. It runs independently of the healthcheck and only
+	// determines whether the container is ready.
+	ReadinessCheckConfig *define.ReadinessCheck `json:"readinessCheck,omitempty"`
+	// PostStartHook is a command executed inside the container right after
+	// it was started. The container is stopped if the command fails.
+	PostStartHook []string `json:"postStartHook,omitempty"`
+	// PreStopHook is a command executed inside the container before the
+	// stop signal is sent to it.
+	PreStopHook []string `json:"preStopHook,omitempty"`
 	// PreserveFDs is a number of additional file descriptors (in addition
 	// to 0, 1, 2) that will be passed to the executed process. The total FDs
 	// passed will be 3 + PreserveFDs.
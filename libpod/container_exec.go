@@ -152,6 +152,15 @@ type legacyExecSession struct {
 	PID     int      `json:"pid"`
 }
 
+// execStates returns the container states in which exec sessions can be
+// created and started.
+func (c *Container) execStates() []define.ContainerStatus {
+	if c.preStopHook {
+		return []define.ContainerStatus{define.ContainerStateRunning, define.ContainerStateStopping}
+	}
+	return []define.ContainerStatus{define.ContainerStateRunning}
+}
+
 // ExecCreate creates a new exec session for the container.
 // The session is not started. The ID of the new exec session will be returned.
 func (c *Container) ExecCreate(config *ExecConfig) (string, error) {
@@ -176,7 +185,7 @@ func (c *Container) ExecCreate(config *ExecConfig) (string, error) {
 	}
 
 	// Verify that we are in a good state to continue
-	if !c.ensureState(define.ContainerStateRunning) {
+	if !c.ensureState(c.execStates()...) {
 		return "", fmt.Errorf("can only create exec sessions on running containers: %w", define.ErrCtrStateInvalid)
 	}
 
@@ -243,7 +252,7 @@ func (c *Container) ExecStart(sessionID string) error {
 	}
 
 	// Verify that we are in a good state to continue
-	if !c.ensureState(define.ContainerStateRunning) {
+	if !c.ensureState(c.execStates()...) {
 		return fmt.Errorf("can only start exec sessions when their container is running: %w", define.ErrCtrStateInvalid)
 	}
 
@@ -295,7 +304,7 @@ func (c *Container) execStartAndAttach(sessionID string, streams *define.AttachS
 	}
 
 	// Verify that we are in a good state to continue
-	if !c.ensureState(define.ContainerStateRunning) {
+	if !c.ensureState(c.execStates()...) {
 		return fmt.Errorf("can only start exec sessions when their container is running: %w", define.ErrCtrStateInvalid)
 	}
 
@@ -765,6 +774,12 @@ func (c *Container) exec(config *ExecConfig, streams *define.AttachStreams, resi
 	if err != nil {
 		return -1, err
 	}
+	return c.execRunAndRemove(sessionID, streams, resizeChan, isHealthcheck)
+}
+
+// execRunAndRemove starts the created exec session, waits for it to exit and
+// removes it. Returns exit code and error as exec does.
+func (c *Container) execRunAndRemove(sessionID string, streams *define.AttachStreams, resizeChan <-chan resize.TerminalSize, isHealthcheck bool) (int, error) {
 
 	// Start resizing if we have a resize channel.
 	// This goroutine may likely leak, given that we cannot close it here.
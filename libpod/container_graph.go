@@ -378,3 +378,69 @@ func removeNode(ctx context.Context, node *containerNode, pod *Pod, force bool,
 		removeNode(ctx, successor, pod, force, timeout, ctrErrored, ctrErrors, ctrsVisited, ctrNamedVolumes)
 	}
 }
+
+// restartDependents restarts the running containers depending on the
+// container, directly or transitively, in dependency order: a dependent is
+// only restarted once all of its dependencies among them have been restarted.
+// The container itself is not restarted.
+// The container must *NOT* be locked, the dependents are locked as needed.
+func (c *Container) restartDependents(ctx context.Context) error {
+	// A reverse post-order of the depth-first traversal of the dependents
+	// is a topological order of the dependency graph.
+	var order []*Container
+	visited := make(map[string]bool)
+	var visit func(ctr *Container) error
+	visit = func(ctr *Container) error {
+		visited[ctr.ID()] = true
+		dependents, err := c.runtime.state.ContainerInUse(ctr)
+		if err != nil {
+			return err
+		}
+		for _, id := range dependents {
+			if visited[id] {
+				continue
+			}
+			dependent, err := c.runtime.state.Container(id)
+			if err != nil {
+				return err
+			}
+			if err := visit(dependent); err != nil {
+				return err
+			}
+		}
+		order = append(order, ctr)
+		return nil
+	}
+	if err := visit(c); err != nil {
+		return fmt.Errorf("looking up dependents of container %s: %w", c.ID(), err)
+	}
+
+	// The last element is the container itself.
+	for i := len(order) - 2; i >= 0; i-- {
+		if err := order[i].restartAsDependent(ctx); err != nil {
+			return fmt.Errorf("restarting dependent container %s of container %s: %w", order[i].ID(), c.ID(), err)
+		}
+	}
+	return nil
+}
+
+// restartAsDependent restarts the container if it is running after one of its
+// dependencies was restarted.
+func (c *Container) restartAsDependent(ctx context.Context) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.syncContainer(); err != nil {
+		return err
+	}
+	if !c.ensureState(define.ContainerStateRunning) {
+		logrus.Debugf("Not restarting dependent container %s in state %s", c.ID(), c.state.State)
+		return nil
+	}
+	if err := c.checkDependenciesAndHandleError(); err != nil {
+		return err
+	}
+
+	logrus.Debugf("Restarting dependent container %s", c.ID())
+	return c.restartWithTimeout(ctx, c.config.StopTimeout)
+}
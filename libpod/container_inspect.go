@@ -196,6 +196,7 @@ func (c *Container) getContainerInspectData(size bool, driverData *define.Driver
 			data.State.Health = healthCheckState
 		}
 	}
+	data.State.Readiness = c.readinessStatus()
 
 	networkConfig, err := c.getContainerNetworkInfo()
 	if err != nil {
@@ -363,6 +364,7 @@ func (c *Container) generateInspectContainerConfig(spec *spec.Spec) *define.Insp
 	}
 
 	ctrConfig.StopTimeout = c.config.StopTimeout
+	ctrConfig.RestartDependents = c.config.RestartDependents
 	ctrConfig.Timeout = c.config.Timeout
 	ctrConfig.OpenStdin = c.config.Stdin
 	ctrConfig.Image = c.config.RootfsImageName
@@ -400,6 +402,10 @@ func (c *Container) generateInspectContainerConfig(spec *spec.Spec) *define.Insp
 
 	ctrConfig.HealthcheckOnFailureAction = c.config.HealthCheckOnFailureAction.String()
 
+	ctrConfig.Readinesscheck = c.config.ReadinessCheckConfig
+	ctrConfig.PostStart = c.config.PostStartHook
+	ctrConfig.PreStop = c.config.PreStopHook
+
 	ctrConfig.CreateCommand = c.config.CreateCommand
 
 	ctrConfig.Timezone = c.config.Timezone
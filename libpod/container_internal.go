@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"net"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -19,6 +20,7 @@ import (
 	butil "github.com/containers/buildah/util"
 	"github.com/containers/common/libnetwork/etchosts"
 	"github.com/containers/common/libnetwork/resolvconf"
+	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/common/pkg/cgroups"
 	"github.com/containers/common/pkg/chown"
 	"github.com/containers/common/pkg/config"
@@ -133,6 +135,47 @@ func (c *Container) PreCheckPointPath() string {
 	return filepath.Join(c.bundlePath(), preCheckpointDir)
 }
 
+// previousPreCheckPointDirs returns the directories of the pre-checkpoints
+// the current pre-checkpoint is based on, relative to the bundle path.
+func (c *Container) previousPreCheckPointDirs() ([]string, error) {
+	dirs, err := filepath.Glob(c.PreCheckPointPath() + ".*")
+	if err != nil {
+		return nil, err
+	}
+	for i := range dirs {
+		dirs[i] = filepath.Base(dirs[i])
+	}
+	return dirs, nil
+}
+
+// rotatePreCheckPoint moves the current pre-checkpoint out of the way so
+// that a new pre-checkpoint can be based on it. The new location relative to
+// the bundle path is returned.
+func (c *Container) rotatePreCheckPoint() (string, error) {
+	previous, err := c.previousPreCheckPointDirs()
+	if err != nil {
+		return "", err
+	}
+	dir := fmt.Sprintf("%s.%d", preCheckpointDir, len(previous)+1)
+	if err := os.Rename(c.PreCheckPointPath(), filepath.Join(c.bundlePath(), dir)); err != nil {
+		return "", fmt.Errorf("moving previous pre-checkpoint: %w", err)
+	}
+	return dir, nil
+}
+
+// parentPreCheckPointDir returns the directory of the pre-checkpoint the
+// current pre-checkpoint is based on, relative to the bundle path.
+func (c *Container) parentPreCheckPointDir() (string, error) {
+	previous, err := c.previousPreCheckPointDirs()
+	if err != nil {
+		return "", err
+	}
+	if len(previous) == 0 {
+		return "", fmt.Errorf("no previous pre-checkpoint found for container %s: %w", c.ID(), define.ErrInternal)
+	}
+	return fmt.Sprintf("%s.%d", preCheckpointDir, len(previous)), nil
+}
+
 // AttachSocketPath retrieves the path of the container's attach socket
 func (c *Container) AttachSocketPath() (string, error) {
 	return c.ociRuntime.AttachSocketPath(c)
@@ -264,6 +307,11 @@ func (c *Container) handleRestartPolicy(ctx context.Context) (_ bool, retErr err
 			return false, err
 		}
 	}
+	if c.config.ReadinessCheckConfig != nil {
+		if err := c.removeReadinessTransientFiles(ctx); err != nil {
+			return false, err
+		}
+	}
 
 	// Is the container running again?
 	// If so, we don't have to do anything
@@ -623,6 +671,9 @@ func resetContainerState(state *ContainerState) {
 	state.StartupHCPassed = false
 	state.StartupHCSuccessCount = 0
 	state.StartupHCFailureCount = 0
+	state.Ready = false
+	state.ReadinessSuccessCount = 0
+	state.ReadinessFailureCount = 0
 	state.NetNS = ""
 	state.NetworkStatus = nil
 	state.NetworkStatusOld = nil
@@ -1076,6 +1127,9 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 	c.state.StartupHCFailureCount = 0
 	c.state.StartupHCSuccessCount = 0
 	c.state.StartupHCPassed = false
+	c.state.Ready = false
+	c.state.ReadinessSuccessCount = 0
+	c.state.ReadinessFailureCount = 0
 
 	if !retainRetries {
 		c.state.RestartCount = 0
@@ -1103,6 +1157,11 @@ func (c *Container) init(ctx context.Context, retainRetries bool) error {
 			logrus.Error(err)
 		}
 	}
+	if c.config.ReadinessCheckConfig != nil {
+		if err := c.createReadinessTimer(); err != nil {
+			logrus.Error(err)
+		}
+	}
 
 	defer c.newContainerEvent(events.Init)
 	return c.completeNetworkSetup()
@@ -1256,10 +1315,19 @@ func (c *Container) start() error {
 			logrus.Error(err)
 		}
 	}
+	if c.config.ReadinessCheckConfig != nil {
+		if err := c.startReadinessTimer(); err != nil {
+			logrus.Error(err)
+		}
+	}
 
-	defer c.newContainerEvent(events.Start)
+	c.newContainerEvent(events.Start)
 
-	return c.save()
+	if err := c.save(); err != nil {
+		return err
+	}
+
+	return c.runPostStartHook()
 }
 
 // Internal, non-locking function to stop container
@@ -1302,6 +1370,7 @@ func (c *Container) stop(timeout uint) error {
 		cannotStopErr = fmt.Errorf("can only stop created or running containers. %s is in state %s: %w", c.ID(), c.state.State.String(), define.ErrCtrStateInvalid)
 	}
 
+	wasRunning := c.ensureState(define.ContainerStateRunning)
 	c.state.StoppedByUser = true
 	if cannotStopErr == nil {
 		// Set the container state to "stopping" and unlock the container
@@ -1326,6 +1395,17 @@ func (c *Container) stop(timeout uint) error {
 		c.lock.Unlock()
 	}
 
+	// The pre-stop hook only runs in running containers.  The time it
+	// takes is deducted from the timeout of the stop signal.
+	if wasRunning {
+		elapsed := uint(c.runPreStopHook(timeout) / time.Second)
+		if elapsed >= timeout {
+			timeout = 0
+		} else {
+			timeout -= elapsed
+		}
+	}
+
 	stopErr := c.ociRuntime.StopContainer(c, timeout, all)
 
 	if !c.batched {
@@ -1458,6 +1538,11 @@ func (c *Container) restartWithTimeout(ctx context.Context, timeout uint) (retEr
 				logrus.Error(err.Error())
 			}
 		}
+		if c.config.ReadinessCheckConfig != nil {
+			if err := c.removeReadinessTransientFiles(context.Background()); err != nil {
+				logrus.Error(err.Error())
+			}
+		}
 		// Old versions of conmon have a bug where they create the exit file before
 		// closing open file descriptors causing a race condition when restarting
 		// containers with open ports since we cannot bind the ports as they're not
@@ -1931,13 +2016,16 @@ func (c *Container) cleanupStorage() error {
 			continue
 		}
 
+		vol.lock.Lock()
 		if vol.needsMount() {
-			vol.lock.Lock()
 			if err := vol.unmount(false); err != nil {
 				reportErrorf("unmounting volume %s for container %s: %w", vol.Name(), c.ID(), err)
 			}
-			vol.lock.Unlock()
 		}
+		if err := vol.checkQuotaWarning(); err != nil {
+			logrus.Errorf("Checking quota of volume %s for container %s: %v", vol.Name(), c.ID(), err)
+		}
+		vol.lock.Unlock()
 	}
 
 	markUnmounted()
@@ -1956,6 +2044,11 @@ func (c *Container) cleanup(ctx context.Context) error {
 			logrus.Errorf("Removing timer for container %s healthcheck: %v", c.ID(), err)
 		}
 	}
+	if c.config.ReadinessCheckConfig != nil {
+		if err := c.removeReadinessTransientFiles(ctx); err != nil {
+			logrus.Errorf("Removing timer for container %s readiness check: %v", c.ID(), err)
+		}
+	}
 
 	// Clean up network namespace, if present
 	if err := c.cleanupNetwork(); err != nil {
@@ -2462,11 +2555,232 @@ func (c *Container) extractSecretToCtrStorage(secr *ContainerSecret) error {
 	return nil
 }
 
-// update calls the ociRuntime update function to modify a cgroup config after container creation
-func (c *Container) update(resources *spec.LinuxResources) error {
-	if err := c.ociRuntime.UpdateContainer(c, resources); err != nil {
-		return err
+// update applies the changes of options to the container.  The resource
+// limits are applied through the OCI runtime, all other changes are written to
+// the container config in the database.
+func (c *Container) update(options *ContainerUpdateOptions) error {
+	if options.RestartPolicy != nil {
+		if err := define.ValidateRestartPolicy(*options.RestartPolicy); err != nil {
+			return err
+		}
 	}
+	if options.RestartRetries != nil {
+		policy := c.config.RestartPolicy
+		if options.RestartPolicy != nil {
+			policy = *options.RestartPolicy
+		}
+		if policy != define.RestartPolicyOnFailure {
+			return fmt.Errorf("restart retries can only be used with the %s restart policy: %w", define.RestartPolicyOnFailure, define.ErrInvalidArg)
+		}
+	}
+	if options.HealthCheckConfig != nil && len(options.HealthCheckConfig.Test) == 0 {
+		return fmt.Errorf("must define a healthcheck command for all healthchecks: %w", define.ErrInvalidArg)
+	}
+
+	if options.Resources != nil {
+		if err := c.ociRuntime.UpdateContainer(c, options.Resources); err != nil {
+			return err
+		}
+	}
+
+	if options.RestartPolicy != nil || options.RestartRetries != nil || options.HealthCheckConfig != nil ||
+		options.HealthCheckOnFailureAction != nil || len(options.Labels) > 0 {
+		if err := c.updateConfig(options); err != nil {
+			return err
+		}
+	}
+
 	logrus.Debugf("updated container %s", c.ID())
+	c.newContainerEvent(events.Update)
 	return nil
 }
+
+// updateConfig writes the configuration changes of options to the database.
+// A changed health check is re-armed if the container is running.
+func (c *Container) updateConfig(options *ContainerUpdateOptions) error {
+	newConfig := new(ContainerConfig)
+	if err := JSONDeepCopy(c.config, newConfig); err != nil {
+		return err
+	}
+
+	if options.RestartPolicy != nil {
+		// As with podman create, a policy given without a retry count
+		// retries indefinitely.
+		newConfig.RestartPolicy = *options.RestartPolicy
+		newConfig.RestartRetries = 0
+	}
+	if options.RestartRetries != nil {
+		newConfig.RestartRetries = *options.RestartRetries
+	}
+	if options.HealthCheckConfig != nil {
+		newConfig.HealthCheckConfig = options.HealthCheckConfig
+	}
+	if options.HealthCheckOnFailureAction != nil {
+		newConfig.HealthCheckOnFailureAction = *options.HealthCheckOnFailureAction
+	}
+	if len(options.Labels) > 0 {
+		if newConfig.Labels == nil {
+			newConfig.Labels = make(map[string]string)
+		}
+		for k, v := range options.Labels {
+			newConfig.Labels[k] = v
+		}
+	}
+
+	if err := c.runtime.state.RewriteContainerConfig(c, newConfig); err != nil {
+		return err
+	}
+
+	// The timer of the regular health check is only armed once the startup
+	// health check passed, it picks up the new config by then.
+	rearm := options.HealthCheckConfig != nil && c.ensureState(define.ContainerStateRunning, define.ContainerStatePaused) &&
+		(c.config.StartupHealthCheckConfig == nil || c.state.StartupHCPassed)
+	if rearm && c.config.HealthCheckConfig != nil {
+		// The old config determines whether a timer was created.
+		if err := c.removeTransientFiles(context.Background(), false); err != nil {
+			c.config = newConfig
+			return fmt.Errorf("removing health check timer of container %s: %w", c.ID(), err)
+		}
+	}
+	c.config = newConfig
+
+	if rearm && !(len(c.config.HealthCheckConfig.Test) == 1 && c.config.HealthCheckConfig.Test[0] == define.HealthConfigTestNone) {
+		if err := c.updateHealthStatus(define.HealthCheckStarting); err != nil {
+			return err
+		}
+		if err := c.createTimer(c.config.HealthCheckConfig.Interval.String(), false); err != nil {
+			return fmt.Errorf("creating health check timer of container %s: %w", c.ID(), err)
+		}
+		if err := c.startTimer(false); err != nil {
+			return fmt.Errorf("starting health check timer of container %s: %w", c.ID(), err)
+		}
+	}
+	return nil
+}
+
+// updatePortMappings replaces the port mappings of the container with
+// newPorts.  If the network of the container is configured, the added ports
+// are forwarded and the removed ports are closed before the configuration is
+// written to the database.  The forwarded ports are restored if writing the
+// configuration fails.
+func (c *Container) updatePortMappings(newPorts, added, removed []types.PortMapping) (retErr error) {
+	if c.config.NetNsCtr != "" {
+		return fmt.Errorf("container %s joins the network namespace of container %s, its ports must be changed there: %w", c.ID(), c.config.NetNsCtr, define.ErrNetworkModeInvalid)
+	}
+	if !c.config.NetMode.IsBridge() && !c.config.NetMode.IsSlirp4netns() && !c.config.NetMode.IsPasta() {
+		return fmt.Errorf("ports cannot be published with network mode %q: %w", c.config.NetMode, define.ErrNetworkModeInvalid)
+	}
+
+	if c.state.NetNS != "" && c.ensureState(define.ContainerStateCreated, define.ContainerStateRunning, define.ContainerStatePaused) {
+		if err := c.runtime.updateContainerPorts(c, newPorts, added, removed); err != nil {
+			return err
+		}
+		defer func() {
+			if retErr == nil {
+				return
+			}
+			if err := c.runtime.updateContainerPorts(c, c.config.PortMappings, removed, added); err != nil {
+				logrus.Errorf("Restoring the ports of container %s: %v", c.ID(), err)
+				return
+			}
+			if err := c.save(); err != nil {
+				logrus.Errorf("Saving container %s state after restoring its ports: %v", c.ID(), err)
+			}
+		}()
+		if err := c.save(); err != nil {
+			return err
+		}
+	}
+
+	newConfig := new(ContainerConfig)
+	if err := JSONDeepCopy(c.config, newConfig); err != nil {
+		return err
+	}
+	newConfig.PortMappings = newPorts
+	if err := c.runtime.state.RewriteContainerConfig(c, newConfig); err != nil {
+		return err
+	}
+	c.config = newConfig
+
+	logrus.Debugf("updated port mappings of container %s", c.ID())
+	c.newContainerEvent(events.Update)
+	return nil
+}
+
+// portMappingString formats the port mapping for error messages.
+func portMappingString(port types.PortMapping) string {
+	hostPort := strconv.Itoa(int(port.HostPort))
+	ctrPort := strconv.Itoa(int(port.ContainerPort))
+	if port.Range > 1 {
+		hostPort += "-" + strconv.Itoa(int(port.HostPort+port.Range-1))
+		ctrPort += "-" + strconv.Itoa(int(port.ContainerPort+port.Range-1))
+	}
+	if port.HostIP != "" {
+		hostPort = net.JoinHostPort(port.HostIP, hostPort)
+	}
+	return fmt.Sprintf("%s:%s/%s", hostPort, ctrPort, port.Protocol)
+}
+
+// addPortMappings returns the port mappings with the new ports added.  A new
+// port must not use a host port which is already published for the same
+// protocol and host IP.
+func addPortMappings(ports, add []types.PortMapping) ([]types.PortMapping, error) {
+	result := make([]types.PortMapping, 0, len(ports)+len(add))
+	result = append(result, ports...)
+	for _, a := range add {
+		for _, p := range result {
+			if p.Protocol != a.Protocol || (p.HostIP != a.HostIP && p.HostIP != "" && a.HostIP != "") {
+				continue
+			}
+			if uint32(a.HostPort) < uint32(p.HostPort)+uint32(p.Range) && uint32(p.HostPort) < uint32(a.HostPort)+uint32(a.Range) {
+				return nil, fmt.Errorf("port %s conflicts with published port %s: %w", portMappingString(a), portMappingString(p), define.ErrInvalidArg)
+			}
+		}
+		result = append(result, a)
+	}
+	return result, nil
+}
+
+// removePortMappings returns the port mappings with the given ports removed.
+// A range is split if only a part of it is removed.  Every removed port must
+// be published.
+func removePortMappings(ports, remove []types.PortMapping) ([]types.PortMapping, error) {
+	result := make([]types.PortMapping, 0, len(ports))
+	result = append(result, ports...)
+	for _, r := range remove {
+		found := false
+		for i, p := range result {
+			if p.Protocol != r.Protocol || p.HostIP != r.HostIP {
+				continue
+			}
+			if r.HostPort < p.HostPort || uint32(r.HostPort)+uint32(r.Range) > uint32(p.HostPort)+uint32(p.Range) {
+				continue
+			}
+			offset := r.HostPort - p.HostPort
+			if p.ContainerPort+offset != r.ContainerPort {
+				continue
+			}
+
+			split := make([]types.PortMapping, 0, 2)
+			if offset > 0 {
+				before := p
+				before.Range = offset
+				split = append(split, before)
+			}
+			if after := p.Range - offset - r.Range; after > 0 {
+				rest := p
+				rest.HostPort = r.HostPort + r.Range
+				rest.ContainerPort = r.ContainerPort + r.Range
+				rest.Range = after
+				split = append(split, rest)
+			}
+			result = append(result[:i:i], append(split, result[i+1:]...)...)
+			found = true
+			break
+		}
+		if !found {
+			return nil, fmt.Errorf("port %s is not published: %w", portMappingString(r), define.ErrInvalidArg)
+		}
+	}
+	return result, nil
+}
@@ -1169,8 +1169,26 @@ func (c *Container) checkpoint(ctx context.Context, options ContainerCheckpointO
 	c.state.CheckpointLog = path.Join(c.bundlePath(), "dump.log")
 	c.state.CheckpointPath = c.CheckpointPath()
 
+	// A pre-checkpoint based on a previous one only holds the memory
+	// changed since then, keep the previous one for the restore.
+	var parentPreCheckPoint string
+	if options.PreCheckPoint && options.WithPrevious {
+		dir, err := c.rotatePreCheckPoint()
+		if err != nil {
+			return nil, 0, err
+		}
+		parentPreCheckPoint = dir
+	}
+
 	runtimeCheckpointDuration, err := c.ociRuntime.CheckpointContainer(c, options)
 	if err != nil {
+		if parentPreCheckPoint != "" {
+			if err := os.RemoveAll(c.PreCheckPointPath()); err != nil {
+				logrus.Errorf("Removing incomplete pre-checkpoint of container %s: %v", c.ID(), err)
+			} else if err := os.Rename(filepath.Join(c.bundlePath(), parentPreCheckPoint), c.PreCheckPointPath()); err != nil {
+				logrus.Errorf("Restoring previous pre-checkpoint of container %s: %v", c.ID(), err)
+			}
+		}
 		return nil, 0, err
 	}
 
@@ -1210,8 +1228,12 @@ func (c *Container) checkpoint(ctx context.Context, options ContainerCheckpointO
 	// There is a bug from criu: https://github.com/checkpoint-restore/criu/issues/116
 	// We have to change the symbolic link from absolute path to relative path
 	if options.WithPrevious {
-		os.Remove(path.Join(c.CheckpointPath(), "parent"))
-		if err := os.Symlink("../pre-checkpoint", path.Join(c.CheckpointPath(), "parent")); err != nil {
+		imagePath, parent := c.CheckpointPath(), preCheckpointDir
+		if options.PreCheckPoint {
+			imagePath, parent = c.PreCheckPointPath(), parentPreCheckPoint
+		}
+		os.Remove(path.Join(imagePath, "parent"))
+		if err := os.Symlink(path.Join("..", parent), path.Join(imagePath, "parent")); err != nil {
 			return nil, 0, err
 		}
 	}
@@ -1353,6 +1375,13 @@ func (c *Container) importCheckpointTar(input string) error {
 }
 
 func (c *Container) importPreCheckpoint(input string) error {
+	// Pre-checkpoints based on a previous one expect it next to them.
+	if _, err := os.Stat(c.PreCheckPointPath()); err == nil {
+		if _, err := c.rotatePreCheckPoint(); err != nil {
+			return err
+		}
+	}
+
 	archiveFile, err := os.Open(input)
 	if err != nil {
 		return fmt.Errorf("failed to open pre-checkpoint archive for import: %w", err)
@@ -1386,8 +1415,8 @@ func (c *Container) restore(ctx context.Context, options ContainerCheckpointOpti
 		return nil, 0, fmt.Errorf("container %s is running or paused, cannot restore: %w", c.ID(), define.ErrCtrStateInvalid)
 	}
 
-	if options.ImportPrevious != "" {
-		if err := c.importPreCheckpoint(options.ImportPrevious); err != nil {
+	for _, input := range options.ImportPrevious {
+		if err := c.importPreCheckpoint(input); err != nil {
 			return nil, 0, err
 		}
 	}
@@ -1733,6 +1762,15 @@ func (c *Container) restore(ctx context.Context, options ContainerCheckpointOpti
 		if err != nil {
 			logrus.Debugf("Non-fatal: removal of pre-checkpoint directory (%s) failed: %v", c.PreCheckPointPath(), err)
 		}
+		previous, err := c.previousPreCheckPointDirs()
+		if err != nil {
+			logrus.Debugf("Non-fatal: looking up previous pre-checkpoint directories failed: %v", err)
+		}
+		for _, dir := range previous {
+			if err := os.RemoveAll(filepath.Join(c.bundlePath(), dir)); err != nil {
+				logrus.Debugf("Non-fatal: removal of pre-checkpoint directory (%s) failed: %v", dir, err)
+			}
+		}
 		err = os.RemoveAll(c.CheckpointVolumesPath())
 		if err != nil {
 			logrus.Debugf("Non-fatal: removal of checkpoint volumes directory (%s) failed: %v", c.CheckpointVolumesPath(), err)
@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"testing"
 
+	"github.com/containers/common/libnetwork/types"
 	"github.com/containers/storage/pkg/idtools"
 	stypes "github.com/containers/storage/types"
 	rspec "github.com/opencontainers/runtime-spec/specs-go"
@@ -197,3 +198,66 @@ func init() {
 		panic("we need a reliable executable path on Windows")
 	}
 }
+
+func TestAddPortMappings(t *testing.T) {
+	ports := []types.PortMapping{
+		{HostPort: 8080, ContainerPort: 80, Range: 2, Protocol: "tcp"},
+		{HostIP: "127.0.0.1", HostPort: 5353, ContainerPort: 53, Range: 1, Protocol: "udp"},
+	}
+
+	newPorts, err := addPortMappings(ports, []types.PortMapping{
+		{HostPort: 8081, ContainerPort: 81, Range: 1, Protocol: "udp"},
+		{HostIP: "127.0.0.2", HostPort: 5353, ContainerPort: 53, Range: 1, Protocol: "udp"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, newPorts, 4)
+	assert.Len(t, ports, 2)
+
+	// host port in a published range
+	_, err = addPortMappings(ports, []types.PortMapping{{HostPort: 8081, ContainerPort: 90, Range: 1, Protocol: "tcp"}})
+	assert.Error(t, err)
+	// published for all host IPs
+	_, err = addPortMappings(ports, []types.PortMapping{{HostIP: "10.0.0.1", HostPort: 8079, ContainerPort: 79, Range: 2, Protocol: "tcp"}})
+	assert.Error(t, err)
+	// conflicting new ports
+	_, err = addPortMappings(nil, []types.PortMapping{
+		{HostPort: 9000, ContainerPort: 90, Range: 1, Protocol: "tcp"},
+		{HostPort: 9000, ContainerPort: 91, Range: 1, Protocol: "tcp"},
+	})
+	assert.Error(t, err)
+}
+
+func TestRemovePortMappings(t *testing.T) {
+	ports := []types.PortMapping{
+		{HostPort: 8080, ContainerPort: 80, Range: 5, Protocol: "tcp"},
+		{HostPort: 8080, ContainerPort: 80, Range: 1, Protocol: "udp"},
+	}
+
+	// remove a port in the middle of a range
+	newPorts, err := removePortMappings(ports, []types.PortMapping{{HostPort: 8082, ContainerPort: 82, Range: 1, Protocol: "tcp"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []types.PortMapping{
+		{HostPort: 8080, ContainerPort: 80, Range: 2, Protocol: "tcp"},
+		{HostPort: 8083, ContainerPort: 83, Range: 2, Protocol: "tcp"},
+		{HostPort: 8080, ContainerPort: 80, Range: 1, Protocol: "udp"},
+	}, newPorts)
+
+	// remove the start of a range and a full mapping
+	newPorts, err = removePortMappings(ports, []types.PortMapping{
+		{HostPort: 8080, ContainerPort: 80, Range: 2, Protocol: "tcp"},
+		{HostPort: 8080, ContainerPort: 80, Range: 1, Protocol: "udp"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []types.PortMapping{{HostPort: 8082, ContainerPort: 82, Range: 3, Protocol: "tcp"}}, newPorts)
+
+	// not published
+	for _, port := range []types.PortMapping{
+		{HostPort: 8080, ContainerPort: 81, Range: 1, Protocol: "tcp"},
+		{HostPort: 8084, ContainerPort: 84, Range: 2, Protocol: "tcp"},
+		{HostIP: "127.0.0.1", HostPort: 8080, ContainerPort: 80, Range: 1, Protocol: "tcp"},
+		{HostPort: 8081, ContainerPort: 81, Range: 1, Protocol: "udp"},
+	} {
+		_, err = removePortMappings(ports, []types.PortMapping{port})
+		assert.Error(t, err)
+	}
+}
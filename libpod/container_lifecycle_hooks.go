@@ -0,0 +1,201 @@
+package libpod
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// execCaptureOutput executes command inside the container and returns its exit
+// code along with its combined stdout and stderr, truncated to
+// MaxHealthCheckLogLength characters. Health and readiness checks set
+// isHealthcheck to not emit exec events. If timeout is not zero, the command
+// is killed once it runs longer.
+func (c *Container) execCaptureOutput(command []string, isHealthcheck bool, timeout time.Duration) (int, string, error) {
+	rPipe, wPipe, err := os.Pipe()
+	if err != nil {
+		return -1, "", fmt.Errorf("unable to create pipe for exec session: %w", err)
+	}
+	defer rPipe.Close()
+
+	streams := new(define.AttachStreams)
+	streams.OutputStream = wPipe
+	streams.ErrorStream = wPipe
+	streams.AttachOutput = true
+	streams.AttachError = true
+
+	output := []string{}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		scanner := bufio.NewScanner(rPipe)
+		for scanner.Scan() {
+			output = append(output, scanner.Text())
+		}
+	}()
+
+	config := new(ExecConfig)
+	config.Command = command
+	sessionID, execErr := c.ExecCreate(config)
+	exitCode := -1
+	var timedOut int32
+	if execErr == nil {
+		if timeout > 0 {
+			timer := time.AfterFunc(timeout, func() {
+				atomic.StoreInt32(&timedOut, 1)
+				c.killExecSession(sessionID)
+			})
+			defer timer.Stop()
+		}
+		exitCode, execErr = c.execRunAndRemove(sessionID, streams, nil, isHealthcheck)
+	}
+	wPipe.Close()
+	<-done
+
+	if atomic.LoadInt32(&timedOut) == 1 {
+		return exitCode, "", fmt.Errorf("command timed out after %s", timeout)
+	}
+	log := strings.Join(output, "\n")
+	if len(log) > MaxHealthCheckLogLength {
+		log = log[:MaxHealthCheckLogLength]
+	}
+	return exitCode, log, execErr
+}
+
+// killExecSession kills the process of a running exec session. The session
+// is looked up in the database, as the container itself is in use by the
+// caller waiting for the session.
+func (c *Container) killExecSession(sessionID string) {
+	ctr := &Container{
+		config:  c.config,
+		state:   new(ContainerState),
+		runtime: c.runtime,
+		valid:   true,
+	}
+	if err := c.runtime.state.UpdateContainer(ctr); err != nil {
+		logrus.Errorf("Retrieving state of container %s to kill exec session %s: %v", c.ID(), sessionID, err)
+		return
+	}
+	session, ok := ctr.state.ExecSessions[sessionID]
+	if !ok || session.PID <= 0 {
+		return
+	}
+	logrus.Debugf("Killing exec session %s (PID %d) of container %s", sessionID, session.PID, c.ID())
+	if err := unix.Kill(session.PID, unix.SIGKILL); err != nil && err != unix.ESRCH {
+		logrus.Errorf("Killing exec session %s of container %s: %v", sessionID, c.ID(), err)
+	}
+}
+
+// postStartHookTimeout is the time after which a postStart hook is killed.
+const postStartHookTimeout = 2 * time.Minute
+
+// runLifecycleHook executes a lifecycle hook inside the container, killing it
+// after timeout.
+// Must be called with the container locked and running. Other management of
+// the container blocks until the hook completes.
+func (c *Container) runLifecycleHook(name string, command []string, timeout time.Duration) error {
+	hookCtr := c
+	if !c.batched {
+		// The container is already locked by us, so run the exec
+		// session in batched mode to not deadlock on the lock.
+		hookCtr = &Container{
+			config:     c.config,
+			state:      c.state,
+			runtime:    c.runtime,
+			ociRuntime: c.ociRuntime,
+			lock:       c.lock,
+			valid:      true,
+			batched:    true,
+		}
+		// The exec session syncs and replaces the state of the copy,
+		// which must not leave c with a stale state.
+		defer func() {
+			c.state = hookCtr.state
+		}()
+	}
+
+	logrus.Debugf("Running %s hook %v of container %s", name, command, c.ID())
+	exitCode, output, err := hookCtr.execCaptureOutput(command, false, timeout)
+	if err != nil {
+		return fmt.Errorf("running %s hook of container %s: %w", name, c.ID(), err)
+	}
+	if exitCode != 0 {
+		return fmt.Errorf("%s hook of container %s exited with code %d: %s", name, c.ID(), exitCode, output)
+	}
+	return nil
+}
+
+// runPostStartHook runs the post-start hook of the container, if any.
+// As in Kubernetes, the container is killed if the hook fails, the restart
+// policy then decides whether it is started again.
+// Must be called with the container locked.
+func (c *Container) runPostStartHook() error {
+	if len(c.config.PostStartHook) == 0 {
+		return nil
+	}
+	hookErr := c.runLifecycleHook("postStart", c.config.PostStartHook, postStartHookTimeout)
+	if hookErr == nil {
+		return nil
+	}
+	if c.ensureState(define.ContainerStateRunning) {
+		if err := c.ociRuntime.KillContainer(c, uint(unix.SIGKILL), false); err != nil {
+			logrus.Errorf("Killing container %s after failed postStart hook: %v", c.ID(), err)
+		}
+	}
+	return hookErr
+}
+
+// runPreStopHook runs the pre-stop hook of the container, if any, and returns
+// how long it ran.  As in Kubernetes, the hook may take as long as the
+// container is given to stop, timeout seconds, and is killed afterwards.  A
+// failing hook does not prevent the container from being stopped.
+// Must be called with the container in stopping state.  Unless the container
+// is batched it must not be locked, so that other commands are not blocked
+// while the hook runs.
+func (c *Container) runPreStopHook(timeout uint) time.Duration {
+	if len(c.config.PreStopHook) == 0 {
+		return 0
+	}
+	if timeout == 0 {
+		logrus.Debugf("Skipping preStop hook of container %s, stop timeout is 0", c.ID())
+		return 0
+	}
+
+	hookCtr := &Container{
+		config:      c.config,
+		state:       new(ContainerState),
+		runtime:     c.runtime,
+		ociRuntime:  c.ociRuntime,
+		lock:        c.lock,
+		valid:       true,
+		batched:     c.batched,
+		preStopHook: true,
+	}
+	if c.batched {
+		// The container is locked by the batch, the exec session
+		// syncs and replaces the state of the copy.
+		hookCtr.state = c.state
+		defer func() {
+			c.state = hookCtr.state
+		}()
+	}
+
+	logrus.Debugf("Running preStop hook %v of container %s", c.config.PreStopHook, c.ID())
+	start := time.Now()
+	exitCode, output, err := hookCtr.execCaptureOutput(c.config.PreStopHook, false, time.Duration(timeout)*time.Second)
+	elapsed := time.Since(start)
+	switch {
+	case err != nil:
+		logrus.Warnf("Running preStop hook of container %s: %v", c.ID(), err)
+	case exitCode != 0:
+		logrus.Warnf("preStop hook of container %s exited with code %d: %s", c.ID(), exitCode, output)
+	}
+	return elapsed
+}
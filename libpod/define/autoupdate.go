@@ -7,3 +7,9 @@ const AutoUpdateLabel = "io.containers.autoupdate"
 // AutoUpdateAuthfileLabel denotes the container label key to specify authfile
 // in container labels.
 const AutoUpdateAuthfileLabel = "io.containers.autoupdate.authfile"
+
+// AutoUpdateOrderLabel denotes the container label key to specify the order
+// in which systemd units are updated.  Units with a lower order are updated
+// first, one at a time, and the remaining ordered units are skipped once an
+// update fails.
+const AutoUpdateOrderLabel = "io.containers.autoupdate.order"
@@ -57,6 +57,12 @@ type InspectContainerConfig struct {
 	Healthcheck *manifest.Schema2HealthConfig `json:"Healthcheck,omitempty"`
 	// HealthcheckOnFailureAction defines an action to take once the container turns unhealthy.
 	HealthcheckOnFailureAction string `json:"HealthcheckOnFailureAction,omitempty"`
+	// Configured readiness check for the container
+	Readinesscheck *ReadinessCheck `json:"Readinesscheck,omitempty"`
+	// PostStart is the command executed inside the container after it started
+	PostStart []string `json:"PostStart,omitempty"`
+	// PreStop is the command executed inside the container before it is stopped
+	PreStop []string `json:"PreStop,omitempty"`
 	// CreateCommand is the full command plus arguments of the process the
 	// container has been created with.
 	CreateCommand []string `json:"CreateCommand,omitempty"`
@@ -85,6 +91,9 @@ type InspectContainerConfig struct {
 	SdNotifyMode string `json:"sdNotifyMode,omitempty"`
 	// SdNotifySocket is the NOTIFY_SOCKET in use by/configured for the container.
 	SdNotifySocket string `json:"sdNotifySocket,omitempty"`
+	// RestartDependents is whether the containers depending on the
+	// container are restarted whenever it is restarted.
+	RestartDependents bool `json:"RestartDependents,omitempty"`
 }
 
 // InspectRestartPolicy holds information about the container's restart policy.
@@ -220,6 +229,7 @@ type InspectContainerState struct {
 	StartedAt      time.Time          `json:"StartedAt"`
 	FinishedAt     time.Time          `json:"FinishedAt"`
 	Health         HealthCheckResults `json:"Health,omitempty"`
+	Readiness      string             `json:"Readiness,omitempty"`
 	Checkpointed   bool               `json:"Checkpointed,omitempty"`
 	CgroupPath     string             `json:"CgroupPath,omitempty"`
 	CheckpointedAt time.Time          `json:"CheckpointedAt,omitempty"`
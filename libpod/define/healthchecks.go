@@ -16,6 +16,11 @@ const (
 	// and the start-period (time allowed for the container to start and application
 	// to be running) expires.
 	HealthCheckStarting string = "starting"
+	// ReadinessCheckReady describes a container whose readiness check passed
+	ReadinessCheckReady string = "ready"
+	// ReadinessCheckNotReady describes a container whose readiness check has
+	// not passed yet or failed
+	ReadinessCheckNotReady string = "not ready"
 )
 
 // HealthCheckStatus represents the current state of a container
@@ -145,3 +150,14 @@ type StartupHealthCheck struct {
 	// If set to 0, a single success will mark the HC as passed.
 	Successes int `json:",omitempty"`
 }
+
+// ReadinessCheck is the configuration of a readiness check. Unlike a
+// healthcheck, a failing readiness check never acts on the container, it only
+// marks it as not ready.
+type ReadinessCheck struct {
+	manifest.Schema2HealthConfig
+	// Successes are the number of consecutive successes required to mark
+	// the container as ready.
+	// If set to 0, a single success will mark the container as ready.
+	Successes int `json:",omitempty"`
+}
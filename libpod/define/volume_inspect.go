@@ -63,6 +63,30 @@ type InspectVolumeData struct {
 	StorageID string `json:"StorageID,omitempty"`
 	// LockNumber is the number of the volume's Libpod lock.
 	LockNumber uint32
+	// SizeLimit is the maximum size of the volume in bytes, if limited.
+	SizeLimit uint64 `json:"SizeLimit,omitempty"`
+	// InodesLimit is the maximum number of inodes of the volume, if
+	// limited.
+	InodesLimit uint64 `json:"InodesLimit,omitempty"`
+	// UsedBytes is the number of bytes used by the volume. It is only
+	// known for volumes with a size limit.
+	UsedBytes uint64 `json:"UsedBytes,omitempty"`
+	// UsedInodes is the number of inodes used by the volume. It is only
+	// known for volumes with an inodes limit or backed by a loopback image.
+	UsedInodes uint64 `json:"UsedInodes,omitempty"`
+	// QuotaWarning is the percentage of SizeLimit at which a quota_warning
+	// event is emitted.
+	QuotaWarning uint `json:"QuotaWarning,omitempty"`
+	// Snapshots are the snapshots of the volume's data.
+	Snapshots []InspectVolumeSnapshot `json:"Snapshots,omitempty"`
+}
+
+// InspectVolumeSnapshot describes a snapshot of a volume.
+type InspectVolumeSnapshot struct {
+	// Name is the name of the snapshot.
+	Name string `json:"Name"`
+	// CreatedAt is the date and time the snapshot was created at.
+	CreatedAt time.Time `json:"CreatedAt"`
 }
 
 type VolumeReload struct {
@@ -0,0 +1,27 @@
+package define
+
+const (
+	// VolumePluginSourceConfig is the source of volume plugins set in
+	// containers.conf.
+	VolumePluginSourceConfig = "config"
+	// VolumePluginSourceDiscovered is the source of volume plugins found in
+	// the plugin directories.
+	VolumePluginSourceDiscovered = "discovered"
+)
+
+// VolumePlugin describes a volume plugin known to Podman.
+type VolumePlugin struct {
+	// Name is the name of the plugin. It is used as the volume driver.
+	Name string `json:"Name"`
+	// Socket is the unix socket the plugin is accessed at.
+	Socket string `json:"Socket"`
+	// Source is where the plugin was found, either "config" or
+	// "discovered".
+	Source string `json:"Source"`
+	// Enabled indicates whether the plugin can be used as a volume driver.
+	// Plugins set in containers.conf are always enabled.
+	Enabled bool `json:"Enabled"`
+	// Scope is the scope reported by the capabilities of the plugin. It is
+	// only set when inspecting a plugin.
+	Scope string `json:"Scope,omitempty"`
+}
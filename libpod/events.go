@@ -7,6 +7,8 @@ import (
 	"sync"
 
 	"github.com/containers/podman/v4/libpod/events"
+	"github.com/containers/podman/v4/pkg/rootless"
+	"github.com/containers/storage/pkg/homedir"
 	"github.com/sirupsen/logrus"
 )
 
@@ -16,11 +18,27 @@ func (r *Runtime) newEventer() (events.Eventer, error) {
 		// default, use path under tmpdir when none was explicitly set by the user
 		r.config.Engine.EventsLogFilePath = filepath.Join(r.config.Engine.TmpDir, "events", "events.log")
 	}
+	maxAge, err := eventsLogFileMaxAge()
+	if err != nil {
+		return nil, err
+	}
 	options := events.EventerOptions{
 		EventerType:    r.config.Engine.EventsLogger,
 		LogFilePath:    r.config.Engine.EventsLogFilePath,
 		LogFileMaxSize: r.config.Engine.EventsLogMaxSize(),
+		LogFileMaxAge:  maxAge,
+	}
+	// Copy the directories, appending must not modify events.SinkDirs.
+	sinkDirs := append([]string{}, events.SinkDirs...)
+	if rootless.IsRootless() {
+		configHome, err := homedir.GetConfigHome()
+		if err != nil {
+			return nil, err
+		}
+		sinkDirs = append(sinkDirs, filepath.Join(configHome, "containers", "events.d"))
 	}
+	options.Sinks = events.LoadSinkConfigs(sinkDirs)
+	options.SinkSpoolDir = filepath.Join(r.config.Engine.TmpDir, "events", "sinks")
 	return events.NewEventer(options)
 }
 
@@ -151,6 +169,18 @@ func (r *Runtime) NewSystemEvent(status events.Status) {
 	}
 }
 
+// NewSecretEvent creates a new event for a secret.  Secrets are managed
+// outside of libpod, so callers must provide the secret's ID and name.
+func (r *Runtime) NewSecretEvent(status events.Status, id, name string) {
+	e := events.NewEvent(status)
+	e.ID = id
+	e.Name = name
+	e.Type = events.Secret
+	if err := r.eventer.Write(e); err != nil {
+		logrus.Errorf("Unable to write secret event: %q", err)
+	}
+}
+
 // newVolumeEvent creates a new event for a libpod volume
 func (v *Volume) newVolumeEvent(status events.Status) {
 	e := events.NewEvent(status)
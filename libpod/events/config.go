@@ -71,6 +71,54 @@ type EventerOptions struct {
 	LogFilePath string
 	// LogFileMaxSize is the default limit used for rotating the log file
 	LogFileMaxSize uint64
+	// LogFileMaxAge is the maximum age of archived log files.  Older
+	// archives are removed when the log file is rotated.
+	LogFileMaxAge time.Duration
+	// Sinks are delivered all written events in addition to the
+	// eventer.
+	Sinks []SinkConfig
+	// SinkSpoolDir holds the events which were not delivered to a sink
+	// when the eventer was closed.  They are delivered by the next
+	// eventer.  The events are dropped if it is empty.
+	SinkSpoolDir string
+}
+
+// SinkType is the type of an event sink.
+type SinkType string
+
+const (
+	// WebhookSink POSTs events as JSON to an HTTP(S) URL.
+	WebhookSink SinkType = "webhook"
+	// UnixgramSink sends events as JSON datagrams to a Unix socket.
+	UnixgramSink SinkType = "unixgram"
+	// ExecSink runs a command with the JSON event on its stdin.
+	ExecSink SinkType = "exec"
+)
+
+// SinkConfig describes an event sink.  Every event written by the eventer
+// that passes the filters is delivered to the sink.
+type SinkConfig struct {
+	// Name of the sink, the name of its configuration file without the
+	// extension.
+	Name string `json:"-"`
+	// Type of the sink.
+	Type SinkType `json:"type"`
+	// URL of a webhook sink.
+	URL string `json:"url,omitempty"`
+	// Path of the socket of a unixgram sink.
+	Path string `json:"path,omitempty"`
+	// Command of an exec sink.
+	Command []string `json:"command,omitempty"`
+	// Filters use the syntax of `podman events --filter`.
+	Filters []string `json:"filters,omitempty"`
+	// Retries is the number of times a webhook sink retries to deliver
+	// an event.  Defaults to 3.
+	Retries *uint `json:"retries,omitempty"`
+	// Timeout for delivering an event, e.g. "5s".  Defaults to 5s.
+	Timeout string `json:"timeout,omitempty"`
+	// CloseTimeout is the time to wait for queued events to be delivered
+	// when the eventer is closed, e.g. "10s".  Defaults to 1s.
+	CloseTimeout string `json:"closeTimeout,omitempty"`
 }
 
 // Eventer is the interface for journald or file event logging
@@ -116,6 +164,8 @@ const (
 	Network Type = "network"
 	// Pod - event is related to pods
 	Pod Type = "pod"
+	// Secret - event is related to secrets
+	Secret Type = "secret"
 	// System - event is related to Podman whole and not to any specific
 	// container/pod/image/volume
 	System Type = "system"
@@ -174,6 +224,9 @@ const (
 	Pull Status = "pull"
 	// Push ...
 	Push Status = "push"
+	// QuotaWarning indicates that the usage of a volume reached the
+	// configured share of its size limit.
+	QuotaWarning Status = "quota_warning"
 	// Refresh indicates that the system refreshed the state after a
 	// reboot.
 	Refresh Status = "refresh"
@@ -206,6 +259,8 @@ const (
 	Unpause Status = "unpause"
 	// Untag ...
 	Untag Status = "untag"
+	// Update indicates that the target was modified in place
+	Update Status = "update"
 )
 
 // EventFilter for filtering events
@@ -102,6 +102,8 @@ func (e *Event) ToHumanReadable(truncate bool) string {
 		}
 	case Volume, Machine:
 		humanFormat = fmt.Sprintf("%s %s %s %s", e.Time, e.Type, e.Status, e.Name)
+	case Secret:
+		humanFormat = fmt.Sprintf("%s %s %s %s (name=%s)", e.Time, e.Type, e.Status, id, e.Name)
 	}
 	return humanFormat
 }
@@ -139,6 +141,8 @@ func StringToType(name string) (Type, error) {
 		return Network, nil
 	case Pod.String():
 		return Pod, nil
+	case Secret.String():
+		return Secret, nil
 	case System.String():
 		return System, nil
 	case Volume.String():
@@ -200,6 +204,8 @@ func StringToStatus(name string) (Status, error) {
 		return Pull, nil
 	case Push.String():
 		return Push, nil
+	case QuotaWarning.String():
+		return QuotaWarning, nil
 	case Refresh.String():
 		return Refresh, nil
 	case Remove.String():
@@ -230,6 +236,8 @@ func StringToStatus(name string) (Status, error) {
 		return Unpause, nil
 	case Untag.String():
 		return Untag, nil
+	case Update.String():
+		return Update, nil
 	}
 	return "", fmt.Errorf("unknown event status %q", name)
 }
@@ -7,23 +7,30 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
-// NewEventer creates an eventer based on the eventer type
+// NewEventer creates an eventer based on the eventer type.  Events written to
+// it are also delivered to the configured sinks.
 func NewEventer(options EventerOptions) (Eventer, error) {
 	logrus.Debugf("Initializing event backend %s", options.EventerType)
+	var eventer Eventer
 	switch strings.ToUpper(options.EventerType) {
 	case strings.ToUpper(Journald.String()):
-		eventer, err := newEventJournalD(options)
+		journald, err := newEventJournalD(options)
 		if err != nil {
 			return nil, fmt.Errorf("eventer creation: %w", err)
 		}
-		return eventer, nil
+		eventer = journald
 	case strings.ToUpper(LogFile.String()):
-		return newLogFileEventer(options)
+		logfile, err := newLogFileEventer(options)
+		if err != nil {
+			return nil, err
+		}
+		eventer = logfile
 	case strings.ToUpper(Null.String()):
-		return newNullEventer(), nil
+		eventer = newNullEventer()
 	case strings.ToUpper(Memory.String()):
-		return NewMemoryEventer(), nil
+		eventer = NewMemoryEventer()
 	default:
 		return nil, fmt.Errorf("unknown event logger type: %s", strings.ToUpper(options.EventerType))
 	}
+	return newSinkEventer(eventer, options.Sinks, options.SinkSpoolDir), nil
 }
@@ -41,7 +41,7 @@ func (e EventJournalD) Write(ee Event) error {
 
 	// Add specialized information based on the podman type
 	switch ee.Type {
-	case Image:
+	case Image, Secret:
 		m["PODMAN_NAME"] = ee.Name
 		m["PODMAN_ID"] = ee.ID
 	case Container, Pod:
@@ -215,7 +215,7 @@ func newEventFromJournalEntry(entry *sdjournal.JournalEntry) (*Event, error) {
 	case Network:
 		newEvent.ID = entry.Fields["PODMAN_ID"]
 		newEvent.Network = entry.Fields["PODMAN_NETWORK_NAME"]
-	case Image:
+	case Image, Secret:
 		newEvent.ID = entry.Fields["PODMAN_ID"]
 	}
 	return &newEvent, nil
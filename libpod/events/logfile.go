@@ -4,13 +4,11 @@
 package events
 
 import (
-	"bufio"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
-	"path"
 	"path/filepath"
 	"time"
 
@@ -57,7 +55,7 @@ func (e EventLogFile) Write(ee Event) error {
 		return err
 	}
 
-	if _, err := rotateLog(e.options.LogFilePath, eventJSONString, e.options.LogFileMaxSize); err != nil {
+	if _, err := rotateLog(e.options.LogFilePath, eventJSONString, e.options.LogFileMaxSize, e.options.LogFileMaxAge); err != nil {
 		return err
 	}
 
@@ -83,7 +81,9 @@ func (e EventLogFile) getTail(options ReadOptions) (*tail.Tail, error) {
 		seek.Whence = 0
 	}
 	stream := options.Stream
-	return tail.TailFile(e.options.LogFilePath, tail.Config{ReOpen: stream, Follow: stream, Location: &seek, Logger: tail.DiscardingLogger, Poll: true})
+	// Open the file right away, the caller holds the events lock to make
+	// sure it is not rotated in between reading the archive index.
+	return tail.TailFile(e.options.LogFilePath, tail.Config{ReOpen: stream, Follow: stream, MustExist: true, Location: &seek, Logger: tail.DiscardingLogger, Poll: true})
 }
 
 func (e EventLogFile) readRotateEvent(event *Event) (begin bool, end bool, err error) {
@@ -101,6 +101,8 @@ func (e EventLogFile) readRotateEvent(event *Event) (begin bool, end bool, err e
 	case rotateEventEnd:
 		end = true
 		return
+	case rotateEventArchive:
+		return
 	default:
 		err = fmt.Errorf("unknown rotate-event attribute %q", event.Details.Attributes[rotateEventAttribute])
 		return
@@ -114,27 +116,23 @@ func (e EventLogFile) Read(ctx context.Context, options ReadOptions) error {
 	if err != nil {
 		return fmt.Errorf("failed to parse event filters: %w", err)
 	}
-	t, err := e.getTail(options)
-	if err != nil {
-		return err
+	var since, until time.Time
+	if len(options.Since) > 0 {
+		if since, err = util.ParseInputTime(options.Since, true); err != nil {
+			return err
+		}
 	}
 	if len(options.Until) > 0 {
-		untilTime, err := util.ParseInputTime(options.Until, false)
-		if err != nil {
+		if until, err = util.ParseInputTime(options.Until, false); err != nil {
 			return err
 		}
-		go func() {
-			time.Sleep(time.Until(untilTime))
-			if err := t.Stop(); err != nil {
-				logrus.Errorf("Stopping logger: %v", err)
-			}
-		}()
 	}
-	logrus.Debugf("Reading events from file %q", e.options.LogFilePath)
 
 	// Get the time *before* starting to read.  Comparing the timestamps
 	// with events avoids returning events more than once after a log-file
 	// rotation.
+	var t *tail.Tail
+	var segments []logSegment
 	readTime, err := func() (time.Time, error) {
 		// We need to lock events file
 		lock, err := lockfile.GetLockFile(e.options.LogFilePath + ".lock")
@@ -143,11 +141,40 @@ func (e EventLogFile) Read(ctx context.Context, options ReadOptions) error {
 		}
 		lock.Lock()
 		defer lock.Unlock()
+		if options.FromStart || !options.Stream {
+			index, err := readLogIndex(e.options.LogFilePath)
+			if err != nil {
+				return time.Time{}, err
+			}
+			// Only read the archives which may hold events in the
+			// requested time range.
+			segments = index.selectSegments(since, until)
+		}
+		t, err = e.getTail(options)
+		if err != nil {
+			return time.Time{}, err
+		}
 		return time.Now(), nil
 	}()
 	if err != nil {
 		return err
 	}
+	if !until.IsZero() {
+		go func() {
+			time.Sleep(time.Until(until))
+			if err := t.Stop(); err != nil {
+				logrus.Errorf("Stopping logger: %v", err)
+			}
+		}()
+	}
+
+	for _, segment := range segments {
+		if err := readArchive(ctx, e.options.LogFilePath, segment, filterMap, options.EventChannel); err != nil {
+			t.Kill(err)
+			return err
+		}
+	}
+	logrus.Debugf("Reading events from file %q", e.options.LogFilePath)
 
 	var line *tail.Line
 	var ok bool
@@ -210,17 +237,21 @@ const (
 	rotateEventAttribute = "io.podman.event.rotate"
 	rotateEventBegin     = "begin"
 	rotateEventEnd       = "end"
+	// rotateEventArchive marks the start of a new log file after the
+	// previous one has been archived.
+	rotateEventArchive = "archive"
+	// rotateEventArchivePath is the path to the archive of the
+	// previous log file.
+	rotateEventArchivePath = "io.podman.event.rotate.archive"
 )
 
-func writeRotateEvent(f *os.File, logFilePath string, begin bool) error {
+func writeRotateEvent(f *os.File, logFilePath string, archive string) error {
 	rEvent := NewEvent(Rotate)
 	rEvent.Type = System
 	rEvent.Name = logFilePath
-	rEvent.Attributes = make(map[string]string)
-	if begin {
-		rEvent.Attributes[rotateEventAttribute] = rotateEventBegin
-	} else {
-		rEvent.Attributes[rotateEventAttribute] = rotateEventEnd
+	rEvent.Attributes = map[string]string{
+		rotateEventAttribute:   rotateEventArchive,
+		rotateEventArchivePath: archive,
 	}
 	rotateJSONString, err := rEvent.ToJSONString()
 	if err != nil {
@@ -229,13 +260,15 @@ func writeRotateEvent(f *os.File, logFilePath string, begin bool) error {
 	return writeToFile(rotateJSONString, f)
 }
 
-// Rotates the log file if the log file size and content exceeds limit
-func rotateLog(logfile string, content string, limit uint64) (bool, error) {
+// Rotates the log file if the log file size and content exceeds limit.  The
+// log file is moved into a compressed archive and archives older than maxAge
+// are removed.
+func rotateLog(logfile string, content string, limit uint64, maxAge time.Duration) (bool, error) {
 	needsRotation, err := logNeedsRotation(logfile, content, limit)
 	if err != nil || !needsRotation {
 		return false, err
 	}
-	if err := truncate(logfile); err != nil {
+	if err := archiveLog(logfile, maxAge); err != nil {
 		return false, err
 	}
 	return true, nil
@@ -263,61 +296,6 @@ func logNeedsRotation(logfile string, content string, limit uint64) (bool, error
 	return true, nil
 }
 
-// Truncates the log file and saves 50% of content to new log file
-func truncate(filePath string) error {
-	orig, err := os.Open(filePath)
-	if err != nil {
-		return err
-	}
-	defer orig.Close()
-
-	origFinfo, err := orig.Stat()
-	if err != nil {
-		return err
-	}
-
-	size := origFinfo.Size()
-	threshold := size / 2
-
-	tmp, err := os.CreateTemp(path.Dir(filePath), "")
-	if err != nil {
-		// Retry in /tmp in case creating a tmp file in the same
-		// directory has failed.
-		tmp, err = os.CreateTemp("", "")
-		if err != nil {
-			return err
-		}
-	}
-	defer tmp.Close()
-
-	// Jump directly to the threshold, drop the first line and copy the remainder
-	if _, err := orig.Seek(threshold, 0); err != nil {
-		return err
-	}
-	reader := bufio.NewReader(orig)
-	if _, err := reader.ReadString('\n'); err != nil {
-		if !errors.Is(err, io.EOF) {
-			return err
-		}
-	}
-
-	if err := writeRotateEvent(tmp, filePath, true); err != nil {
-		return fmt.Errorf("writing rotation event begin marker: %w", err)
-	}
-	if _, err := reader.WriteTo(tmp); err != nil {
-		return fmt.Errorf("writing truncated contents: %w", err)
-	}
-	if err := writeRotateEvent(tmp, filePath, false); err != nil {
-		return fmt.Errorf("writing rotation event end marker: %w", err)
-	}
-
-	if err := renameLog(tmp.Name(), filePath); err != nil {
-		return fmt.Errorf("writing back %s to %s: %w", tmp.Name(), filePath, err)
-	}
-
-	return nil
-}
-
 // Renames from, to
 func renameLog(from, to string) error {
 	err := os.Rename(from, to)
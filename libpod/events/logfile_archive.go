@@ -0,0 +1,269 @@
+//go:build linux || freebsd
+// +build linux freebsd
+
+package events
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// logFileMaxArchives is the number of archives kept when no maximum age is
+// configured.
+const logFileMaxArchives = 10
+
+// logSegment describes a compressed archive of the events log file.
+type logSegment struct {
+	// Number of the archive, archives are numbered in ascending order.
+	Number uint64
+	// First is the time of the first event in the archive.
+	First time.Time
+	// Last is the time of the last event in the archive.
+	Last time.Time
+}
+
+// logIndex is the time index of all archives of an events log file.
+type logIndex struct {
+	Segments []logSegment
+}
+
+// archivePath returns the path of the archive with the given number.
+func archivePath(logfile string, number uint64) string {
+	return fmt.Sprintf("%s.%d.gz", logfile, number)
+}
+
+// indexPath returns the path of the index of the log file's archives.
+func indexPath(logfile string) string {
+	return logfile + ".index"
+}
+
+// readLogIndex reads the index of the log file's archives.  A non-existing
+// index is treated as empty.
+func readLogIndex(logfile string) (*logIndex, error) {
+	index := new(logIndex)
+	content, err := os.ReadFile(indexPath(logfile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return index, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(content, index); err != nil {
+		return nil, fmt.Errorf("unmarshalling events log index %s: %w", indexPath(logfile), err)
+	}
+	return index, nil
+}
+
+// writeLogIndex atomically replaces the index of the log file's archives.
+func writeLogIndex(logfile string, index *logIndex) error {
+	content, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(logfile), ".index")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), indexPath(logfile))
+}
+
+// nextNumber returns the number of the next archive.
+func (i *logIndex) nextNumber() uint64 {
+	if len(i.Segments) == 0 {
+		return 1
+	}
+	return i.Segments[len(i.Segments)-1].Number + 1
+}
+
+// selectSegments returns the archives that may contain events between since
+// and until.  A zero time does not limit the selection.
+func (i *logIndex) selectSegments(since, until time.Time) []logSegment {
+	var segments []logSegment
+	for _, segment := range i.Segments {
+		if !since.IsZero() && segment.Last.Before(since) {
+			continue
+		}
+		if !until.IsZero() && segment.First.After(until) {
+			continue
+		}
+		segments = append(segments, segment)
+	}
+	return segments
+}
+
+// prune removes the archives from the index whose last event is older than
+// maxAge.  If maxAge is zero, only the newest logFileMaxArchives archives are
+// kept.  The removed archives are returned.
+func (i *logIndex) prune(maxAge time.Duration, now time.Time) []logSegment {
+	var keep, removed []logSegment
+	if maxAge > 0 {
+		for _, segment := range i.Segments {
+			if now.Sub(segment.Last) > maxAge {
+				removed = append(removed, segment)
+			} else {
+				keep = append(keep, segment)
+			}
+		}
+	} else {
+		keep = i.Segments
+		if len(keep) > logFileMaxArchives {
+			removed = keep[:len(keep)-logFileMaxArchives]
+			keep = keep[len(keep)-logFileMaxArchives:]
+		}
+	}
+	i.Segments = keep
+	return removed
+}
+
+// archiveLog compresses the log file into the next archive, replaces it with
+// an empty log file and prunes the archives according to maxAge.  The caller
+// must hold the events lock.
+func archiveLog(logfile string, maxAge time.Duration) error {
+	index, err := readLogIndex(logfile)
+	if err != nil {
+		return err
+	}
+
+	segment, err := compressLog(logfile, index.nextNumber())
+	if err != nil {
+		return fmt.Errorf("archiving events log file: %w", err)
+	}
+	index.Segments = append(index.Segments, *segment)
+	removed := index.prune(maxAge, time.Now())
+	if err := writeLogIndex(logfile, index); err != nil {
+		return fmt.Errorf("writing events log index: %w", err)
+	}
+	for _, s := range removed {
+		if err := os.Remove(archivePath(logfile, s.Number)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			logrus.Errorf("Removing events log archive: %v", err)
+		}
+	}
+
+	// Replace the log file instead of truncating it, readers who have
+	// already opened it will continue reading the archived events.
+	tmp, err := os.CreateTemp(filepath.Dir(logfile), "")
+	if err != nil {
+		return err
+	}
+	defer tmp.Close()
+	if err := writeRotateEvent(tmp, logfile, archivePath(logfile, segment.Number)); err != nil {
+		return fmt.Errorf("writing rotation event: %w", err)
+	}
+	if err := renameLog(tmp.Name(), logfile); err != nil {
+		return fmt.Errorf("writing back %s to %s: %w", tmp.Name(), logfile, err)
+	}
+	return nil
+}
+
+// compressLog writes the gzip-compressed content of the log file to the
+// archive with the given number and returns its segment.
+func compressLog(logfile string, number uint64) (*logSegment, error) {
+	orig, err := os.Open(logfile)
+	if err != nil {
+		return nil, err
+	}
+	defer orig.Close()
+
+	tmp, err := os.CreateTemp(filepath.Dir(logfile), "")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	segment := &logSegment{Number: number}
+	writer := gzip.NewWriter(tmp)
+	reader := bufio.NewReader(orig)
+	for {
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			if _, err := writer.Write([]byte(line)); err != nil {
+				return nil, err
+			}
+			if event, err := newEventFromJSONString(line); err == nil {
+				if segment.First.IsZero() {
+					segment.First = event.Time
+				}
+				segment.Last = event.Time
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	if err := tmp.Close(); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp.Name(), archivePath(logfile, number)); err != nil {
+		return nil, err
+	}
+	return segment, nil
+}
+
+// readArchive sends the events of the archived segment that pass the filters
+// to the event channel.
+func readArchive(ctx context.Context, logfile string, segment logSegment, filterMap map[string][]EventFilter, eventChannel chan *Event) error {
+	path := archivePath(logfile, segment.Number)
+	logrus.Debugf("Reading events from archive %q", path)
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// The archive has been pruned in the meantime.
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return fmt.Errorf("reading events log archive %s: %w", path, err)
+	}
+	defer gz.Close()
+
+	reader := bufio.NewReader(gz)
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+		line, err := reader.ReadString('\n')
+		if len(line) > 0 {
+			event, err := newEventFromJSONString(line)
+			if err != nil {
+				return err
+			}
+			if applyFilters(event, filterMap) {
+				eventChannel <- event
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("reading events log archive %s: %w", path, err)
+		}
+	}
+}
@@ -1,9 +1,13 @@
 package events
 
 import (
+	"compress/gzip"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -45,7 +49,7 @@ func TestRotateLog(t *testing.T) {
 		// Now rotate
 		fInfoBeforeRotate, err := tmp.Stat()
 		require.NoError(t, err)
-		isRotated, err := rotateLog(tmp.Name(), string(logContent), test.sizeLimit)
+		isRotated, err := rotateLog(tmp.Name(), string(logContent), test.sizeLimit, 0)
 		require.NoError(t, err)
 
 		fInfoAfterRotate, err := os.Stat(tmp.Name())
@@ -64,44 +68,93 @@ func TestRotateLog(t *testing.T) {
 	}
 }
 
-func TestTruncationOutput(t *testing.T) {
-	contentBefore := `0
-1
-2
-3
-4
-5
-6
-7
-8
-9
-10
-`
-	// Create dummy file
-	tmp, err := os.CreateTemp("", "log-rotation")
+func TestArchiveLog(t *testing.T) {
+	dir := t.TempDir()
+	logfile := filepath.Join(dir, "events.log")
+
+	first := time.Date(2022, 4, 6, 11, 26, 42, 0, time.UTC)
+	var content strings.Builder
+	for i := 0; i < 10; i++ {
+		e := NewEvent(Pull)
+		e.Type = Image
+		e.Name = "busybox"
+		e.Time = first.Add(time.Duration(i) * time.Minute)
+		s, err := e.ToJSONString()
+		require.NoError(t, err)
+		content.WriteString(s + "\n")
+	}
+	err := os.WriteFile(logfile, []byte(content.String()), 0600)
+	require.NoError(t, err)
+
+	err = archiveLog(logfile, 0)
+	require.NoError(t, err)
+
+	// The log file only holds the rotation event.
+	afterArchive, err := os.ReadFile(logfile)
 	require.NoError(t, err)
-	defer os.Remove(tmp.Name())
-	defer tmp.Close()
+	split := strings.Split(string(afterArchive), "\n")
+	require.Len(t, split, 2)
+	require.Contains(t, split[0], "\"io.podman.event.rotate\":\"archive\"")
+	require.Contains(t, split[0], archivePath(logfile, 1))
 
-	// Write content before truncation to dummy file
-	_, err = tmp.WriteString(contentBefore)
+	// The archive holds the previous content.
+	f, err := os.Open(archivePath(logfile, 1))
+	require.NoError(t, err)
+	defer f.Close()
+	gz, err := gzip.NewReader(f)
+	require.NoError(t, err)
+	archived, err := io.ReadAll(gz)
 	require.NoError(t, err)
+	require.Equal(t, content.String(), string(archived))
 
-	// Truncate the file
-	beforeTruncation, err := os.ReadFile(tmp.Name())
+	index, err := readLogIndex(logfile)
 	require.NoError(t, err)
-	err = truncate(tmp.Name())
+	require.Len(t, index.Segments, 1)
+	require.Equal(t, uint64(1), index.Segments[0].Number)
+	require.True(t, first.Equal(index.Segments[0].First))
+	require.True(t, first.Add(9*time.Minute).Equal(index.Segments[0].Last))
+
+	// Archives older than the maximum age are removed.
+	err = archiveLog(logfile, time.Hour)
 	require.NoError(t, err)
-	afterTruncation, err := os.ReadFile(tmp.Name())
+	index, err = readLogIndex(logfile)
 	require.NoError(t, err)
-	// Content has changed
-	require.NotEqual(t, beforeTruncation, afterTruncation)
-	split := strings.Split(string(afterTruncation), "\n")
-	require.Len(t, split, 8) // 2 events + 5 rotated lines + last new line
-	require.Contains(t, split[0], "\"Attributes\":{\"io.podman.event.rotate\":\"begin\"}")
-	require.Equal(t, split[1:6], []string{"6", "7", "8", "9", "10"})
-	require.Contains(t, split[6], "\"Attributes\":{\"io.podman.event.rotate\":\"end\"}")
-	require.Contains(t, split[7], "")
+	require.Len(t, index.Segments, 1)
+	require.Equal(t, uint64(2), index.Segments[0].Number)
+	_, err = os.Stat(archivePath(logfile, 1))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestLogIndex(t *testing.T) {
+	base := time.Date(2022, 4, 6, 0, 0, 0, 0, time.UTC)
+	index := &logIndex{}
+	for i := 0; i < logFileMaxArchives+2; i++ {
+		index.Segments = append(index.Segments, logSegment{
+			Number: index.nextNumber(),
+			First:  base.Add(time.Duration(i) * time.Hour),
+			Last:   base.Add(time.Duration(i)*time.Hour + 59*time.Minute),
+		})
+	}
+
+	numbers := func(segments []logSegment) []uint64 {
+		var n []uint64
+		for _, s := range segments {
+			n = append(n, s.Number)
+		}
+		return n
+	}
+	require.Len(t, index.selectSegments(time.Time{}, time.Time{}), logFileMaxArchives+2)
+	require.Equal(t, []uint64{3, 4}, numbers(index.selectSegments(base.Add(2*time.Hour+30*time.Minute), base.Add(3*time.Hour+30*time.Minute))))
+	require.Equal(t, []uint64{11, 12}, numbers(index.selectSegments(base.Add(10*time.Hour), time.Time{})))
+	require.Equal(t, []uint64{1}, numbers(index.selectSegments(time.Time{}, base.Add(time.Minute))))
+
+	removed := index.prune(0, time.Now())
+	require.Equal(t, []uint64{1, 2}, numbers(removed))
+	require.Len(t, index.Segments, logFileMaxArchives)
+
+	removed = index.prune(2*time.Hour, base.Add(12*time.Hour))
+	require.Equal(t, []uint64{3, 4, 5, 6, 7, 8, 9, 10}, numbers(removed))
+	require.Equal(t, []uint64{11, 12}, numbers(index.Segments))
 }
 
 func TestRenameLog(t *testing.T) {
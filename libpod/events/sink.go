@@ -0,0 +1,422 @@
+//go:build linux || freebsd
+// +build linux freebsd
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultSinkTimeout is the time a sink may take to deliver an event.
+	defaultSinkTimeout = 5 * time.Second
+	// defaultSinkRetries is the number of times a webhook sink retries
+	// to deliver an event.
+	defaultSinkRetries = 3
+	// sinkBackoff is the delay before the first retry, it doubles with
+	// each retry.
+	sinkBackoff = 250 * time.Millisecond
+	// sinkQueueSize is the number of events queued for a sink.  Events
+	// are dropped while the queue is full.
+	sinkQueueSize = 64
+	// defaultSinkCloseTimeout is the time to wait for a sink to deliver
+	// the queued events when the eventer is closed.  It is short to not
+	// delay the exit of Podman, the remaining events are spooled.
+	defaultSinkCloseTimeout = time.Second
+)
+
+// SinkDirs are the directories searched for event sink configuration files.
+// A file in a later directory overrides a file with the same name in an
+// earlier one.
+var SinkDirs = []string{"/usr/share/containers/events.d", "/etc/containers/events.d"}
+
+// LoadSinkConfigs reads the *.json sink configuration files in the given
+// directories.  Non-existing directories are skipped, unreadable or invalid
+// files are logged and skipped.
+func LoadSinkConfigs(dirs []string) []SinkConfig {
+	found := make(map[string]SinkConfig)
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			logrus.Errorf("Reading event sink directory %s: %v", dir, err)
+			continue
+		}
+		for _, entry := range entries {
+			if entry.IsDir() || filepath.Ext(entry.Name()) != ".json" {
+				continue
+			}
+			path := filepath.Join(dir, entry.Name())
+			content, err := os.ReadFile(path)
+			if err != nil {
+				logrus.Errorf("Reading event sink %s: %v", path, err)
+				continue
+			}
+			config := SinkConfig{}
+			if err := json.Unmarshal(content, &config); err != nil {
+				logrus.Errorf("Parsing event sink %s: %v", path, err)
+				continue
+			}
+			config.Name = strings.TrimSuffix(entry.Name(), ".json")
+			found[config.Name] = config
+		}
+	}
+
+	configs := make([]SinkConfig, 0, len(found))
+	for _, config := range found {
+		configs = append(configs, config)
+	}
+	sort.Slice(configs, func(i, j int) bool { return configs[i].Name < configs[j].Name })
+	return configs
+}
+
+// sink delivers events to a single destination.
+type sink struct {
+	config       SinkConfig
+	filterMap    map[string][]EventFilter
+	timeout      time.Duration
+	closeTimeout time.Duration
+	retries      uint
+	client       *http.Client
+	// spool is the file holding the events which were not delivered
+	// before the eventer was closed, empty to drop them.
+	spool string
+
+	// queue holds the events waiting to be delivered by run.
+	queue chan sinkEvent
+	// done is closed when run returns.
+	done chan struct{}
+}
+
+// sinkEvent is an event queued for a sink.
+type sinkEvent struct {
+	event   Event
+	content []byte
+}
+
+// newSink validates the configuration and creates the sink.
+func newSink(config SinkConfig) (*sink, error) {
+	s := &sink{config: config, timeout: defaultSinkTimeout, closeTimeout: defaultSinkCloseTimeout, retries: defaultSinkRetries}
+	switch config.Type {
+	case WebhookSink:
+		if config.URL == "" {
+			return nil, fmt.Errorf("event sink %q: webhook sink requires a url", config.Name)
+		}
+		s.client = &http.Client{}
+	case UnixgramSink:
+		if config.Path == "" {
+			return nil, fmt.Errorf("event sink %q: unixgram sink requires a path", config.Name)
+		}
+	case ExecSink:
+		if len(config.Command) == 0 {
+			return nil, fmt.Errorf("event sink %q: exec sink requires a command", config.Name)
+		}
+	default:
+		return nil, fmt.Errorf("event sink %q: unknown sink type %q", config.Name, config.Type)
+	}
+	if config.Timeout != "" {
+		timeout, err := time.ParseDuration(config.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("event sink %q: parsing timeout: %w", config.Name, err)
+		}
+		s.timeout = timeout
+	}
+	if config.CloseTimeout != "" {
+		closeTimeout, err := time.ParseDuration(config.CloseTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("event sink %q: parsing closeTimeout: %w", config.Name, err)
+		}
+		s.closeTimeout = closeTimeout
+	}
+	if config.Retries != nil {
+		s.retries = *config.Retries
+	}
+	filterMap, err := generateEventFilters(config.Filters, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("event sink %q: failed to parse event filters: %w", config.Name, err)
+	}
+	s.filterMap = filterMap
+	return s, nil
+}
+
+// deliver sends the event to the sink if it passes the filters.
+func (s *sink) deliver(event *Event, content []byte) error {
+	if !applyFilters(event, s.filterMap) {
+		return nil
+	}
+	switch s.config.Type {
+	case WebhookSink:
+		return s.post(content)
+	case UnixgramSink:
+		return s.send(content)
+	default:
+		return s.exec(content)
+	}
+}
+
+// run delivers the queued events until the queue is closed.
+func (s *sink) run() {
+	defer close(s.done)
+	for e := range s.queue {
+		if err := s.deliver(&e.event, e.content); err != nil {
+			logrus.Errorf("Unable to deliver event to event sink %q: %v", s.config.Name, err)
+		}
+	}
+}
+
+// claimSpool returns the events spooled by a previous eventer and removes the
+// spool.  The spool is renamed first, so that the events are only claimed by
+// one of several concurrent Podman processes.
+func (s *sink) claimSpool() []sinkEvent {
+	if s.spool == "" {
+		return nil
+	}
+	claimed := fmt.Sprintf("%s.%d", s.spool, os.Getpid())
+	if err := os.Rename(s.spool, claimed); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logrus.Errorf("Claiming spooled events of event sink %q: %v", s.config.Name, err)
+		}
+		return nil
+	}
+	defer os.Remove(claimed)
+	content, err := os.ReadFile(claimed)
+	if err != nil {
+		logrus.Errorf("Reading spooled events of event sink %q: %v", s.config.Name, err)
+		return nil
+	}
+	var spooled []sinkEvent
+	for _, line := range bytes.Split(content, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		e := sinkEvent{content: line}
+		if err := json.Unmarshal(line, &e.event); err != nil {
+			logrus.Errorf("Parsing spooled event of event sink %q: %v", s.config.Name, err)
+			continue
+		}
+		spooled = append(spooled, e)
+	}
+	return spooled
+}
+
+// spoolQueued removes the events from the closed queue which were not
+// delivered yet and appends them to the spool.  It returns the number of
+// removed events.
+func (s *sink) spoolQueued() (int, error) {
+	var buf bytes.Buffer
+	count := 0
+	for e := range s.queue {
+		buf.Write(e.content)
+		buf.WriteByte('\n')
+		count++
+	}
+	if count == 0 || s.spool == "" {
+		return count, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(s.spool), 0o700); err != nil {
+		return count, err
+	}
+	f, err := os.OpenFile(s.spool, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o600)
+	if err != nil {
+		return count, err
+	}
+	_, err = f.Write(buf.Bytes())
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return count, err
+}
+
+// post POSTs the event to the webhook.  Connection errors and server errors
+// are retried with an exponential backoff.
+func (s *sink) post(content []byte) error {
+	backoff := sinkBackoff
+	var err error
+	for attempt := uint(0); ; attempt++ {
+		err = s.postOnce(content)
+		if err == nil || errors.Is(err, errPermanent) || attempt >= s.retries {
+			return err
+		}
+		logrus.Debugf("Delivering event to sink %q failed, retrying in %s: %v", s.config.Name, backoff, err)
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// errPermanent marks webhook errors which are not retried.
+var errPermanent = errors.New("permanent error")
+
+func (s *sink) postOnce(content []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.config.URL, bytes.NewReader(content))
+	if err != nil {
+		return fmt.Errorf("%v: %w", err, errPermanent)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode >= 500, resp.StatusCode == http.StatusTooManyRequests:
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	default:
+		return fmt.Errorf("webhook returned %s: %w", resp.Status, errPermanent)
+	}
+}
+
+// send writes the event as a single datagram to the socket.
+func (s *sink) send(content []byte) error {
+	conn, err := net.DialTimeout("unixgram", s.config.Path, s.timeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err := conn.SetWriteDeadline(time.Now().Add(s.timeout)); err != nil {
+		return err
+	}
+	_, err = conn.Write(content)
+	return err
+}
+
+// exec runs the command with the event on its stdin.
+func (s *sink) exec(content []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, s.config.Command[0], s.config.Command[1:]...)
+	cmd.Stdin = bytes.NewReader(content)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("running %v: %w: %s", s.config.Command, err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// EventSinks is an eventer which delivers all written events to the
+// configured sinks in addition to the wrapped eventer.  Every sink delivers
+// its events from its own queue, so a slow sink neither blocks the write nor
+// delays the other sinks.
+type EventSinks struct {
+	Eventer
+	sinks []*sink
+
+	lock   sync.Mutex
+	closed bool
+}
+
+// newSinkEventer wraps the eventer to deliver events to the sinks.  The
+// eventer is returned unchanged if there are no valid sinks.  Invalid sinks
+// are logged and skipped.  Events which were not delivered when the eventer is
+// closed are spooled in spoolDir and delivered first by the next eventer,
+// they are dropped if spoolDir is empty.
+func newSinkEventer(eventer Eventer, configs []SinkConfig, spoolDir string) Eventer {
+	sinks := make([]*sink, 0, len(configs))
+	for _, config := range configs {
+		s, err := newSink(config)
+		if err != nil {
+			logrus.Errorf("Skipping event sink: %v", err)
+			continue
+		}
+		sinks = append(sinks, s)
+	}
+	if len(sinks) == 0 {
+		return eventer
+	}
+	for _, s := range sinks {
+		if spoolDir != "" {
+			s.spool = filepath.Join(spoolDir, s.config.Name+".json")
+		}
+		spooled := s.claimSpool()
+		s.queue = make(chan sinkEvent, sinkQueueSize+len(spooled))
+		for _, e := range spooled {
+			s.queue <- e
+		}
+		s.done = make(chan struct{})
+		go s.run()
+	}
+	return &EventSinks{Eventer: eventer, sinks: sinks}
+}
+
+// Write writes the event to the wrapped eventer and queues it for the sinks.
+// The event is dropped and logged for sinks whose queue is full.  Failing
+// sinks are logged but do not fail the write.
+func (e *EventSinks) Write(ee Event) error {
+	err := e.Eventer.Write(ee)
+
+	content, jsonErr := json.Marshal(ee)
+	if jsonErr != nil {
+		logrus.Errorf("Unable to marshal event for event sinks: %v", jsonErr)
+		return err
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if e.closed {
+		return err
+	}
+	for _, s := range e.sinks {
+		select {
+		case s.queue <- sinkEvent{event: ee, content: content}:
+		default:
+			logrus.Errorf("Event sink %q is not keeping up, dropping %s event of %s", s.config.Name, ee.Status, ee.ID)
+		}
+	}
+	return err
+}
+
+// Close stops queueing events and waits for the sinks to deliver the queued
+// ones, for at most the close timeout of each sink.  Events which were not
+// delivered by then are spooled.  Events written after Close are only written
+// to the wrapped eventer.
+func (e *EventSinks) Close() error {
+	e.lock.Lock()
+	if e.closed {
+		e.lock.Unlock()
+		return nil
+	}
+	e.closed = true
+	for _, s := range e.sinks {
+		close(s.queue)
+	}
+	e.lock.Unlock()
+
+	start := time.Now()
+	for _, s := range e.sinks {
+		timer := time.NewTimer(time.Until(start.Add(s.closeTimeout)))
+		select {
+		case <-s.done:
+		case <-timer.C:
+			count, err := s.spoolQueued()
+			switch {
+			case err != nil:
+				logrus.Errorf("Timed out delivering queued events to event sink %q, dropping %d events: spooling: %v", s.config.Name, count, err)
+			case s.spool == "":
+				logrus.Errorf("Timed out delivering queued events to event sink %q, dropping %d events", s.config.Name, count)
+			default:
+				logrus.Debugf("Timed out delivering queued events to event sink %q, spooled %d events", s.config.Name, count)
+			}
+		}
+		timer.Stop()
+	}
+	return nil
+}
@@ -0,0 +1,213 @@
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEvent(status Status) Event {
+	e := NewEvent(status)
+	e.Type = Container
+	e.ID = "4b4a6fc3a0a1"
+	e.Name = "web"
+	return e
+}
+
+func TestLoadSinkConfigs(t *testing.T) {
+	system := t.TempDir()
+	user := t.TempDir()
+	err := os.WriteFile(filepath.Join(system, "alert.json"), []byte(`{"type":"webhook","url":"http://localhost/system"}`), 0600)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(system, "audit.json"), []byte(`{"type":"exec","command":["logger"],"filters":["type=container"]}`), 0600)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(system, "README"), []byte("ignored"), 0600)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(user, "alert.json"), []byte(`{"type":"webhook","url":"http://localhost/user"}`), 0600)
+	require.NoError(t, err)
+
+	err = os.WriteFile(filepath.Join(user, "broken.json"), []byte(`{`), 0600)
+	require.NoError(t, err)
+
+	// The broken file is skipped.
+	configs := LoadSinkConfigs([]string{system, filepath.Join(system, "missing"), user})
+	require.Len(t, configs, 2)
+	require.Equal(t, "alert", configs[0].Name)
+	require.Equal(t, "http://localhost/user", configs[0].URL)
+	require.Equal(t, "audit", configs[1].Name)
+	require.Equal(t, ExecSink, configs[1].Type)
+	require.Equal(t, []string{"type=container"}, configs[1].Filters)
+}
+
+func TestNewSinkEventerInvalid(t *testing.T) {
+	for _, config := range []SinkConfig{
+		{Name: "a", Type: "mail"},
+		{Name: "b", Type: WebhookSink},
+		{Name: "c", Type: UnixgramSink},
+		{Name: "d", Type: ExecSink},
+		{Name: "e", Type: ExecSink, Command: []string{"true"}, Timeout: "soon"},
+		{Name: "e", Type: ExecSink, Command: []string{"true"}, CloseTimeout: "later"},
+		{Name: "f", Type: ExecSink, Command: []string{"true"}, Filters: []string{"bogus"}},
+	} {
+		eventer := NewMemoryEventer()
+		require.Equal(t, eventer, newSinkEventer(eventer, []SinkConfig{config}, ""), config.Name)
+	}
+
+	// Invalid sinks are skipped, valid ones are used.
+	eventer := newSinkEventer(NewMemoryEventer(), []SinkConfig{{Name: "a", Type: "mail"}, {Name: "b", Type: ExecSink, Command: []string{"true"}}}, "")
+	sinks, ok := eventer.(*EventSinks)
+	require.True(t, ok)
+	require.Len(t, sinks.sinks, 1)
+	require.Equal(t, "b", sinks.sinks[0].config.Name)
+	require.NoError(t, sinks.Close())
+}
+
+func TestWebhookSink(t *testing.T) {
+	var requests int32
+	received := make(chan Event, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Fail the first request to make sure it is retried.
+		if atomic.AddInt32(&requests, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		e := Event{}
+		require.NoError(t, json.Unmarshal(body, &e))
+		received <- e
+	}))
+	defer server.Close()
+
+	retries := uint(1)
+	eventer := newSinkEventer(NewMemoryEventer(), []SinkConfig{{Name: "hook", Type: WebhookSink, URL: server.URL, Retries: &retries, Filters: []string{"event=died"}}}, "")
+
+	// Filtered events are not delivered.
+	require.NoError(t, eventer.Write(newTestEvent(Start)))
+	require.NoError(t, eventer.Write(newTestEvent(Exited)))
+	require.NoError(t, eventer.(*EventSinks).Close())
+	require.Equal(t, int32(2), atomic.LoadInt32(&requests))
+	e := <-received
+	require.Equal(t, Exited, e.Status)
+	require.Equal(t, "web", e.Name)
+}
+
+func TestWebhookSinkPermanentError(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	defer server.Close()
+
+	s, err := newSink(SinkConfig{Name: "hook", Type: WebhookSink, URL: server.URL})
+	require.NoError(t, err)
+	e := newTestEvent(Start)
+	content, err := json.Marshal(e)
+	require.NoError(t, err)
+	require.Error(t, s.deliver(&e, content))
+	require.Equal(t, int32(1), atomic.LoadInt32(&requests))
+}
+
+func TestUnixgramSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: path, Net: "unixgram"})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	eventer := newSinkEventer(NewMemoryEventer(), []SinkConfig{{Name: "socket", Type: UnixgramSink, Path: path}}, "")
+	require.NoError(t, eventer.Write(newTestEvent(Start)))
+
+	buf := make([]byte, 4096)
+	n, err := conn.Read(buf)
+	require.NoError(t, err)
+	e := Event{}
+	require.NoError(t, json.Unmarshal(buf[:n], &e))
+	require.Equal(t, Start, e.Status)
+}
+
+func TestExecSink(t *testing.T) {
+	out := filepath.Join(t.TempDir(), "event.json")
+	eventer := newSinkEventer(NewMemoryEventer(), []SinkConfig{{Name: "exec", Type: ExecSink, Command: []string{"sh", "-c", "cat > " + out}}}, "")
+	require.NoError(t, eventer.Write(newTestEvent(Start)))
+	require.NoError(t, eventer.(*EventSinks).Close())
+
+	content, err := os.ReadFile(out)
+	require.NoError(t, err)
+	e := Event{}
+	require.NoError(t, json.Unmarshal(content, &e))
+	require.Equal(t, Start, e.Status)
+	require.Equal(t, Memory.String(), eventer.String())
+}
+
+func TestSinkQueueFull(t *testing.T) {
+	release := make(chan struct{})
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+	}))
+	defer server.Close()
+
+	eventer := newSinkEventer(newNullEventer(), []SinkConfig{{Name: "slow", Type: WebhookSink, URL: server.URL, Timeout: "1m"}}, "")
+	// The first event is being delivered, the queue holds sinkQueueSize
+	// more, the rest is dropped without blocking the writes.
+	for i := 0; i < 2*sinkQueueSize; i++ {
+		require.NoError(t, eventer.Write(newTestEvent(Start)))
+	}
+	close(release)
+	require.NoError(t, eventer.(*EventSinks).Close())
+	require.LessOrEqual(t, atomic.LoadInt32(&requests), int32(sinkQueueSize+1))
+	require.Greater(t, atomic.LoadInt32(&requests), int32(0))
+}
+
+func TestSinkSpool(t *testing.T) {
+	spoolDir := t.TempDir()
+	release := make(chan struct{})
+	blocked := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer blocked.Close()
+	defer close(release)
+
+	// The first event is stuck in delivery, the others are spooled once
+	// the close timeout passed.
+	eventer := newSinkEventer(newNullEventer(), []SinkConfig{{Name: "hook", Type: WebhookSink, URL: blocked.URL, Timeout: "1m", CloseTimeout: "100ms"}}, spoolDir)
+	for _, status := range []Status{Create, Start, Exited} {
+		require.NoError(t, eventer.Write(newTestEvent(status)))
+	}
+	require.NoError(t, eventer.(*EventSinks).Close())
+	require.FileExists(t, filepath.Join(spoolDir, "hook.json"))
+
+	received := make(chan Event, 3)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		e := Event{}
+		if err := json.NewDecoder(r.Body).Decode(&e); err == nil {
+			received <- e
+		}
+	}))
+	defer server.Close()
+
+	// The next eventer delivers the spooled events first.
+	eventer = newSinkEventer(newNullEventer(), []SinkConfig{{Name: "hook", Type: WebhookSink, URL: server.URL}}, spoolDir)
+	require.NoError(t, eventer.Write(newTestEvent(Stop)))
+	require.NoError(t, eventer.(*EventSinks).Close())
+	require.NoFileExists(t, filepath.Join(spoolDir, "hook.json"))
+	close(received)
+	var statuses []Status
+	for e := range received {
+		statuses = append(statuses, e.Status)
+	}
+	require.Equal(t, Stop, statuses[len(statuses)-1])
+	require.Contains(t, statuses, Exited)
+	require.GreaterOrEqual(t, len(statuses), 3)
+}
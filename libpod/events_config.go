@@ -0,0 +1,113 @@
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v4/pkg/rootless"
+	"github.com/containers/storage/pkg/homedir"
+)
+
+// eventsConfig holds the event settings of the [engine] table in
+// containers.conf that are read by Podman rather than containers/common.
+type eventsConfig struct {
+	Engine struct {
+		// EventsLogFileMaxAge is the maximum age of archived event
+		// logs, e.g. "720h".  Older archives are removed when the
+		// events log file is rotated.  Empty or "0" keeps them.
+		EventsLogFileMaxAge string `toml:"events_logfile_max_age"`
+	} `toml:"engine"`
+}
+
+// eventsLogFileMaxAge returns the events_logfile_max_age set in
+// containers.conf, zero if it is not set.
+func eventsLogFileMaxAge() (time.Duration, error) {
+	paths, err := containersConfPaths()
+	if err != nil {
+		return 0, err
+	}
+	var conf eventsConfig
+	for _, path := range paths {
+		// Later files override the settings of earlier ones.
+		if _, err := toml.DecodeFile(path, &conf); err != nil {
+			return 0, fmt.Errorf("decode configuration %v: %w", path, err)
+		}
+	}
+	value := conf.Engine.EventsLogFileMaxAge
+	if value == "" {
+		return 0, nil
+	}
+	maxAge, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("parsing events_logfile_max_age %q: %w", value, err)
+	}
+	if maxAge < 0 {
+		return 0, fmt.Errorf("events_logfile_max_age %q must not be negative", value)
+	}
+	return maxAge, nil
+}
+
+// containersConfPaths returns the containers.conf files in the order they are
+// merged by containers/common.
+func containersConfPaths() (paths []string, finalErr error) {
+	if path := os.Getenv("CONTAINERS_CONF_OVERRIDE"); path != "" {
+		// The override file is applied last.
+		defer func() {
+			if finalErr == nil {
+				paths = append(paths, path)
+			}
+		}()
+	}
+	if path := os.Getenv("CONTAINERS_CONF"); path != "" {
+		return []string{path}, nil
+	}
+
+	for _, path := range []string{config.DefaultContainersConfig, config.OverrideContainersConfig} {
+		if _, err := os.Stat(path); err == nil {
+			paths = append(paths, path)
+		}
+	}
+	paths, err := addConfDir(config.OverrideContainersConfig+".d", paths)
+	if err != nil {
+		return nil, err
+	}
+
+	if rootless.IsRootless() {
+		configHome, err := homedir.GetConfigHome()
+		if err != nil {
+			return nil, err
+		}
+		path := filepath.Join(configHome, "containers", "containers.conf")
+		if _, err := os.Stat(path); err == nil {
+			paths = append(paths, path)
+		}
+		return addConfDir(path+".d", paths)
+	}
+	return paths, nil
+}
+
+// addConfDir appends the *.conf files in dir, in name order, to paths.  A
+// missing directory is not an error.
+func addConfDir(dir string, paths []string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return paths, nil
+		}
+		return nil, err
+	}
+	var confs []string
+	for _, entry := range entries {
+		if !entry.IsDir() && strings.HasSuffix(entry.Name(), ".conf") {
+			confs = append(confs, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return append(paths, confs...), nil
+}
@@ -0,0 +1,79 @@
+package libpod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventsLogFileMaxAge(t *testing.T) {
+	tests := []struct {
+		name     string
+		conf     string
+		override string
+		maxAge   time.Duration
+		err      string
+	}{
+		{
+			name: "unset",
+			conf: "[engine]\nevents_logger = \"file\"\n",
+		},
+		{
+			name:   "set",
+			conf:   "[engine]\nevents_logfile_max_age = \"72h\"\n",
+			maxAge: 72 * time.Hour,
+		},
+		{
+			name:     "override",
+			conf:     "[engine]\nevents_logfile_max_age = \"72h\"\n",
+			override: "[engine]\nevents_logfile_max_age = \"30m\"\n",
+			maxAge:   30 * time.Minute,
+		},
+		{
+			name:     "override without the key",
+			conf:     "[engine]\nevents_logfile_max_age = \"72h\"\n",
+			override: "[engine]\nevents_logger = \"file\"\n",
+			maxAge:   72 * time.Hour,
+		},
+		{
+			name: "zero",
+			conf: "[engine]\nevents_logfile_max_age = \"0\"\n",
+		},
+		{
+			name: "negative",
+			conf: "[engine]\nevents_logfile_max_age = \"-1h\"\n",
+			err:  "must not be negative",
+		},
+		{
+			name: "invalid",
+			conf: "[engine]\nevents_logfile_max_age = \"30 days\"\n",
+			err:  "parsing events_logfile_max_age",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			conf := filepath.Join(dir, "containers.conf")
+			require.NoError(t, os.WriteFile(conf, []byte(tt.conf), 0o600))
+			t.Setenv("CONTAINERS_CONF", conf)
+			t.Setenv("CONTAINERS_CONF_OVERRIDE", "")
+			if tt.override != "" {
+				override := filepath.Join(dir, "override.conf")
+				require.NoError(t, os.WriteFile(override, []byte(tt.override), 0o600))
+				t.Setenv("CONTAINERS_CONF_OVERRIDE", override)
+			}
+
+			maxAge, err := eventsLogFileMaxAge()
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.maxAge, maxAge)
+		})
+	}
+}
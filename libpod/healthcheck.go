@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -21,6 +22,12 @@ const (
 	MaxHealthCheckNumberLogs int = 5
 	// MaxHealthCheckLogLength in characters
 	MaxHealthCheckLogLength = 500
+	// MaxHealthCheckHistoryLength is the maximum number of attempts kept
+	// in the healthcheck history, which survives restarts of the container
+	MaxHealthCheckHistoryLength = 100
+	// MaxHealthCheckHistoryLogLength is the maximum length of the output
+	// of an attempt in the healthcheck history, in characters
+	MaxHealthCheckHistoryLogLength = 8192
 )
 
 // HealthCheck verifies the state and validity of the healthcheck configuration
@@ -54,9 +61,94 @@ func (r *Runtime) HealthCheck(ctx context.Context, name string) (define.HealthCh
 	return hcStatus, err
 }
 
+// ReadinessCheck runs the readiness check of a container and updates its
+// readiness accordingly
+func (r *Runtime) ReadinessCheck(ctx context.Context, name string) (define.HealthCheckStatus, error) {
+	container, err := r.LookupContainer(name)
+	if err != nil {
+		return define.HealthCheckContainerNotFound, fmt.Errorf("unable to look up %s to perform a readiness check: %w", name, err)
+	}
+
+	cstate, err := container.State()
+	if err != nil {
+		return define.HealthCheckInternalError, err
+	}
+	if cstate != define.ContainerStateRunning {
+		return define.HealthCheckContainerStopped, fmt.Errorf("container %s is not running", container.ID())
+	}
+	if container.config.ReadinessCheckConfig == nil {
+		return define.HealthCheckNotDefined, fmt.Errorf("container %s has no defined readiness check", container.ID())
+	}
+
+	return container.runReadinessCheck()
+}
+
+func (c *Container) runReadinessCheck() (define.HealthCheckStatus, error) {
+	config := c.config.ReadinessCheckConfig
+	command := healthCheckCommand(config.Test)
+	if len(command) < 1 || command[0] == "" {
+		return define.HealthCheckNotDefined, fmt.Errorf("container %s has no defined readiness check", c.ID())
+	}
+
+	logrus.Debugf("executing readiness check command %s for %s", strings.Join(command, " "), c.ID())
+	timeStart := time.Now()
+	exitCode, output, err := c.execCaptureOutput(command, true, config.Timeout)
+	success := err == nil && exitCode == 0
+	if err != nil {
+		output = err.Error()
+	}
+	if success && config.Timeout > 0 && time.Since(timeStart) > config.Timeout {
+		success = false
+		output = fmt.Sprintf("readiness check command exceeded timeout of %s", config.Timeout.String())
+	}
+	if !success {
+		logrus.Debugf("Readiness check for container %s failed (exit code %d): %s", c.ID(), exitCode, output)
+	}
+
+	if err := c.updateReadiness(success, timeStart); err != nil {
+		return define.HealthCheckInternalError, err
+	}
+	if !success {
+		return define.HealthCheckFailure, nil
+	}
+	return define.HealthCheckSuccess, nil
+}
+
+// updateReadiness records the result of a readiness check and flips the
+// readiness of the container once enough consecutive results agree
+func (c *Container) updateReadiness(success bool, checkStart time.Time) error {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return err
+		}
+	}
+
+	config := c.config.ReadinessCheckConfig
+	if success {
+		c.state.ReadinessFailureCount = 0
+		c.state.ReadinessSuccessCount++
+		if c.state.ReadinessSuccessCount >= config.Successes {
+			c.state.Ready = true
+		}
+	} else {
+		// Failures during the start period do not count
+		if config.StartPeriod > 0 && checkStart.Before(c.state.StartedTime.Add(config.StartPeriod)) {
+			return nil
+		}
+		c.state.ReadinessSuccessCount = 0
+		c.state.ReadinessFailureCount++
+		if c.state.ReadinessFailureCount >= config.Retries {
+			c.state.Ready = false
+		}
+	}
+	return c.save()
+}
+
 func (c *Container) runHealthCheck(ctx context.Context, isStartup bool) (define.HealthCheckStatus, string, error) {
 	var (
-		newCommand    []string
 		returnCode    int
 		inStartPeriod bool
 	)
@@ -65,21 +157,7 @@ func (c *Container) runHealthCheck(ctx context.Context, isStartup bool) (define.
 		logrus.Debugf("Running startup healthcheck for container %s", c.ID())
 		hcCommand = c.config.StartupHealthCheckConfig.Test
 	}
-	if len(hcCommand) < 1 {
-		return define.HealthCheckNotDefined, "", fmt.Errorf("container %s has no defined healthcheck", c.ID())
-	}
-	switch hcCommand[0] {
-	case "", define.HealthConfigTestNone:
-		return define.HealthCheckNotDefined, "", fmt.Errorf("container %s has no defined healthcheck", c.ID())
-	case define.HealthConfigTestCmd:
-		newCommand = hcCommand[1:]
-	case define.HealthConfigTestCmdShell:
-		// TODO: SHELL command from image not available in Container - use Docker default
-		newCommand = []string{"/bin/sh", "-c", strings.Join(hcCommand[1:], " ")}
-	default:
-		// command supplied on command line - pass as-is
-		newCommand = hcCommand
-	}
+	newCommand := healthCheckCommand(hcCommand)
 	if len(newCommand) < 1 || newCommand[0] == "" {
 		return define.HealthCheckNotDefined, "", fmt.Errorf("container %s has no defined healthcheck", c.ID())
 	}
@@ -151,8 +229,8 @@ func (c *Container) runHealthCheck(ctx context.Context, isStartup bool) (define.
 	}
 
 	eventLog := strings.Join(stdout, "\n")
-	if len(eventLog) > MaxHealthCheckLogLength {
-		eventLog = eventLog[:MaxHealthCheckLogLength]
+	if len(eventLog) > MaxHealthCheckHistoryLogLength {
+		eventLog = eventLog[:MaxHealthCheckHistoryLogLength]
 	}
 
 	if timeEnd.Sub(timeStart) > c.HealthCheckConfig().Timeout {
@@ -170,6 +248,26 @@ func (c *Container) runHealthCheck(ctx context.Context, isStartup bool) (define.
 	return hcResult, logStatus, hcErr
 }
 
+// healthCheckCommand returns the command to execute for the given healthcheck
+// test, or nil if the test does not define a command
+func healthCheckCommand(hcCommand []string) []string {
+	if len(hcCommand) < 1 {
+		return nil
+	}
+	switch hcCommand[0] {
+	case "", define.HealthConfigTestNone:
+		return nil
+	case define.HealthConfigTestCmd:
+		return hcCommand[1:]
+	case define.HealthConfigTestCmdShell:
+		// TODO: SHELL command from image not available in Container - use Docker default
+		return []string{"/bin/sh", "-c", strings.Join(hcCommand[1:], " ")}
+	default:
+		// command supplied on command line - pass as-is
+		return hcCommand
+	}
+}
+
 func (c *Container) processHealthCheckStatus(status string) error {
 	if status != define.HealthCheckUnhealthy {
 		return nil
@@ -367,6 +465,13 @@ func (c *Container) updateHealthCheckLog(hcl define.HealthCheckLog, inStartPerio
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
+	if err := c.appendHealthCheckHistory(hcl); err != nil {
+		logrus.Errorf("Updating health check history of container %s: %v", c.ID(), err)
+	}
+	if len(hcl.Output) > MaxHealthCheckLogLength {
+		hcl.Output = hcl.Output[:MaxHealthCheckLogLength]
+	}
+
 	healthCheck, err := c.getHealthCheckLog()
 	if err != nil {
 		return "", err
@@ -404,6 +509,206 @@ func (c *Container) healthCheckLogPath() string {
 	return filepath.Join(filepath.Dir(c.state.RunDir), "healthcheck.log")
 }
 
+// healthCheckHistoryPath returns the path of the health check history
+func (c *Container) healthCheckHistoryPath() string {
+	return filepath.Join(filepath.Dir(c.state.RunDir), "healthcheck-history.log")
+}
+
+// healthCheckHistoryCompactSize is the size of the health check history above
+// which it is compacted to the last MaxHealthCheckHistoryLength attempts, the
+// size their output can take up at most.
+const healthCheckHistoryCompactSize = MaxHealthCheckHistoryLength * MaxHealthCheckHistoryLogLength
+
+// appendHealthCheckHistory appends the attempt to the health check history as
+// a JSON line.  The history is only rewritten to drop the oldest attempts once
+// it grows beyond healthCheckHistoryCompactSize.
+// The caller should lock the container before this function is called.
+func (c *Container) appendHealthCheckHistory(hcl define.HealthCheckLog) error {
+	line, err := json.Marshal(hcl)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(c.healthCheckHistoryPath(), os.O_RDWR|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	size, err := appendLine(f, line)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if size <= healthCheckHistoryCompactSize {
+		return nil
+	}
+	return c.compactHealthCheckHistory()
+}
+
+// appendLine appends line and a newline to the file opened for appending and
+// returns the new size of the file.  A previous line which was only partially
+// written is terminated first, so that it does not corrupt the new line.
+func appendLine(f *os.File, line []byte) (int64, error) {
+	st, err := f.Stat()
+	if err != nil {
+		return 0, err
+	}
+	size := st.Size()
+	if size > 0 {
+		last := make([]byte, 1)
+		if _, err := f.ReadAt(last, size-1); err != nil {
+			return 0, err
+		}
+		if last[0] != '\n' {
+			line = append([]byte{'\n'}, line...)
+		}
+	}
+	n, err := f.Write(append(line, '\n'))
+	return size + int64(n), err
+}
+
+// compactHealthCheckHistory rewrites the health check history with the last
+// MaxHealthCheckHistoryLength attempts.
+// The caller should lock the container before this function is called.
+func (c *Container) compactHealthCheckHistory() error {
+	history, err := c.readHealthCheckHistory()
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(c.healthCheckHistoryPath()), "healthcheck-history")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	encoder := json.NewEncoder(tmp)
+	for _, entry := range history {
+		if err := encoder.Encode(entry); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), c.healthCheckHistoryPath())
+}
+
+// readHealthCheckHistory returns the last MaxHealthCheckHistoryLength
+// attempts in the health check history, oldest first.  Lines which cannot be
+// parsed, e.g. an attempt which was only partially written, are skipped.
+// The caller should lock the container before this function is called.
+func (c *Container) readHealthCheckHistory() ([]define.HealthCheckLog, error) {
+	f, err := os.Open(c.healthCheckHistoryPath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read health check history: %w", err)
+	}
+	defer f.Close()
+
+	var history []define.HealthCheckLog
+	reader := bufio.NewReader(f)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 {
+			var hcl define.HealthCheckLog
+			if jsonErr := json.Unmarshal(line, &hcl); jsonErr != nil {
+				logrus.Warnf("Skipping invalid entry in health check history %s: %v", c.healthCheckHistoryPath(), jsonErr)
+			} else {
+				history = append(history, hcl)
+			}
+		}
+		if err != nil {
+			if !errors.Is(err, io.EOF) {
+				return nil, fmt.Errorf("failed to read health check history %s: %w", c.healthCheckHistoryPath(), err)
+			}
+			break
+		}
+	}
+	if len(history) > MaxHealthCheckHistoryLength {
+		history = history[len(history)-MaxHealthCheckHistoryLength:]
+	}
+	return history, nil
+}
+
+// HealthCheckHistory returns the past attempts of the container's health
+// check, oldest first.  Unlike the health check log shown by inspect, the
+// history is kept across restarts of the container and holds the output of
+// each attempt up to MaxHealthCheckHistoryLogLength characters.  If since is
+// not zero, only attempts started after since are returned.
+func (c *Container) HealthCheckHistory(since time.Time) ([]define.HealthCheckLog, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return nil, err
+		}
+	}
+	if !c.HasHealthCheck() {
+		return nil, fmt.Errorf("container %s: %w", c.ID(), define.ErrNoHealthCheck)
+	}
+
+	history, err := c.readHealthCheckHistory()
+	if err != nil {
+		return nil, err
+	}
+	if since.IsZero() {
+		return history, nil
+	}
+	filtered := make([]define.HealthCheckLog, 0, len(history))
+	for _, hcl := range history {
+		start, err := time.Parse(time.RFC3339Nano, hcl.Start)
+		if err != nil || start.After(since) {
+			filtered = append(filtered, hcl)
+		}
+	}
+	return filtered, nil
+}
+
+// HealthCheckState returns the current health check results of the container
+// and the time its health check is expected to run next.  The time is zero if
+// the container is not running or the health check does not run
+// periodically.
+func (c *Container) HealthCheckState() (define.HealthCheckResults, time.Time, error) {
+	if !c.batched {
+		c.lock.Lock()
+		defer c.lock.Unlock()
+
+		if err := c.syncContainer(); err != nil {
+			return define.HealthCheckResults{}, time.Time{}, err
+		}
+	}
+	if !c.HasHealthCheck() {
+		return define.HealthCheckResults{}, time.Time{}, fmt.Errorf("container %s: %w", c.ID(), define.ErrNoHealthCheck)
+	}
+
+	results, err := c.getHealthCheckLog()
+	if err != nil {
+		return results, time.Time{}, fmt.Errorf("unable to get healthcheck log for %s: %w", c.ID(), err)
+	}
+	if c.state.State != define.ContainerStateRunning {
+		return results, time.Time{}, nil
+	}
+
+	interval := c.HealthCheckConfig().Interval
+	if c.config.StartupHealthCheckConfig != nil && !c.state.StartupHCPassed {
+		interval = c.config.StartupHealthCheckConfig.Interval
+	}
+	if interval <= 0 {
+		return results, time.Time{}, nil
+	}
+	// The timer fires the interval after the previous check finished.
+	last := c.state.StartedTime
+	if len(results.Log) > 0 {
+		if end, err := time.Parse(time.RFC3339Nano, results.Log[len(results.Log)-1].End); err == nil && end.After(last) {
+			last = end
+		}
+	}
+	return results, last.Add(interval), nil
+}
+
 // getHealthCheckLog returns HealthCheck results by reading the container's
 // health check log file.  If the health check log file does not exist, then
 // an empty healthcheck struct is returned
@@ -0,0 +1,66 @@
+package libpod
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthCheckHistory(t *testing.T) {
+	ctr := &Container{state: &ContainerState{RunDir: filepath.Join(t.TempDir(), "userdata")}}
+	for i := 0; i < 3; i++ {
+		require.NoError(t, ctr.appendHealthCheckHistory(define.HealthCheckLog{ExitCode: i, Output: "ok"}))
+	}
+
+	// Every attempt is appended as a line.
+	content, err := os.ReadFile(ctr.healthCheckHistoryPath())
+	require.NoError(t, err)
+	assert.Equal(t, 3, bytes.Count(content, []byte("\n")))
+
+	// A partially written attempt is skipped.
+	f, err := os.OpenFile(ctr.healthCheckHistoryPath(), os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(t, err)
+	_, err = f.WriteString(`{"Start":"2024`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	history, err := ctr.readHealthCheckHistory()
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	assert.Equal(t, 2, history[2].ExitCode)
+
+	// The next attempt is not appended to the partial one.
+	require.NoError(t, ctr.appendHealthCheckHistory(define.HealthCheckLog{ExitCode: 3, Output: "ok"}))
+	history, err = ctr.readHealthCheckHistory()
+	require.NoError(t, err)
+	require.Len(t, history, 4)
+	assert.Equal(t, 3, history[3].ExitCode)
+}
+
+func TestHealthCheckHistoryCompact(t *testing.T) {
+	ctr := &Container{state: &ContainerState{RunDir: filepath.Join(t.TempDir(), "userdata")}}
+	output := strings.Repeat("x", MaxHealthCheckHistoryLogLength)
+	total := MaxHealthCheckHistoryLength + 10
+	for i := 0; i < total; i++ {
+		require.NoError(t, ctr.appendHealthCheckHistory(define.HealthCheckLog{Start: strconv.Itoa(i), Output: output}))
+	}
+
+	// The history was compacted once it grew too large, the attempts
+	// appended since are kept.
+	content, err := os.ReadFile(ctr.healthCheckHistoryPath())
+	require.NoError(t, err)
+	assert.Less(t, bytes.Count(content, []byte("\n")), total)
+	assert.LessOrEqual(t, len(content), healthCheckHistoryCompactSize+MaxHealthCheckHistoryLogLength+1024)
+
+	history, err := ctr.readHealthCheckHistory()
+	require.NoError(t, err)
+	require.Len(t, history, MaxHealthCheckHistoryLength)
+	assert.Equal(t, strconv.Itoa(total-MaxHealthCheckHistoryLength), history[0].Start)
+	assert.Equal(t, strconv.Itoa(total-1), history[len(history)-1].Start)
+}
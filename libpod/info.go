@@ -62,10 +62,11 @@ func (r *Runtime) info() (*define.Info, error) {
 	if len(regs) > 0 {
 		registries["search"] = regs
 	}
-	volumePlugins := make([]string, 0, len(r.config.Engine.VolumePlugins)+1)
+	enabledVolumePlugins := r.volumePlugins()
+	volumePlugins := make([]string, 0, len(enabledVolumePlugins)+1)
 	// the local driver always exists
 	volumePlugins = append(volumePlugins, "local")
-	for plugin := range r.config.Engine.VolumePlugins {
+	for plugin := range enabledVolumePlugins {
 		volumePlugins = append(volumePlugins, plugin)
 	}
 	info.Plugins.Volume = volumePlugins
@@ -20,6 +20,8 @@ import (
 	"github.com/containers/podman/v4/pkg/annotations"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/containers/podman/v4/pkg/env"
+	v1apps "github.com/containers/podman/v4/pkg/k8s.io/api/apps/v1"
+	v1batch "github.com/containers/podman/v4/pkg/k8s.io/api/batch/v1"
 	v1 "github.com/containers/podman/v4/pkg/k8s.io/api/core/v1"
 	"github.com/containers/podman/v4/pkg/k8s.io/apimachinery/pkg/api/resource"
 	v12 "github.com/containers/podman/v4/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -176,6 +178,98 @@ func GenerateForKubeDeployment(ctx context.Context, pod *YAMLPod, options entiti
 	return &dep, nil
 }
 
+// GenerateForKubeDaemonSet returns a YAMLDaemonSet from a YAMLPod that is then used to create a kubernetes DaemonSet
+// kind YAML.
+func GenerateForKubeDaemonSet(ctx context.Context, pod *YAMLPod, options entities.GenerateKubeOptions) (*YAMLDaemonSet, error) {
+	// Restart policy for DaemonSets can only be set to Always
+	if !(pod.Spec.RestartPolicy == "" || pod.Spec.RestartPolicy == v1.RestartPolicyAlways) {
+		return nil, fmt.Errorf("k8s DaemonSets can only have restartPolicy set to Always")
+	}
+
+	// Create label map that will be added to podSpec and DaemonSet metadata
+	// The matching label lets the daemon set know which pods to manage
+	appKey := "app"
+	matchLabels := map[string]string{appKey: pod.Name}
+	// Add the key:value (app:pod-name) to the podSpec labels
+	if pod.Labels == nil {
+		pod.Labels = matchLabels
+	} else {
+		pod.Labels[appKey] = pod.Name
+	}
+
+	dsSpec := YAMLDaemonSetSpec{
+		DaemonSetSpec: v1apps.DaemonSetSpec{
+			Selector: &v12.LabelSelector{
+				MatchLabels: matchLabels,
+			},
+		},
+		Template: &YAMLPodTemplateSpec{
+			PodTemplateSpec: v1.PodTemplateSpec{
+				ObjectMeta: pod.ObjectMeta,
+			},
+			Spec: pod.Spec,
+		},
+	}
+
+	// Create the DaemonSet object
+	ds := YAMLDaemonSet{
+		DaemonSet: v1apps.DaemonSet{
+			ObjectMeta: v12.ObjectMeta{
+				Name:              pod.Name + "-daemonset",
+				CreationTimestamp: pod.CreationTimestamp,
+				Labels:            pod.Labels,
+			},
+			TypeMeta: v12.TypeMeta{
+				Kind:       "DaemonSet",
+				APIVersion: "apps/v1",
+			},
+		},
+		Spec: &dsSpec,
+	}
+
+	return &ds, nil
+}
+
+// GenerateForKubeJob returns a YAMLJob from a YAMLPod that is then used to create a kubernetes Job
+// kind YAML.
+func GenerateForKubeJob(ctx context.Context, pod *YAMLPod, options entities.GenerateKubeOptions) (*YAMLJob, error) {
+	// Restart policy for Jobs can only be set to OnFailure or Never
+	switch pod.Spec.RestartPolicy {
+	case "":
+		// k8s does not default the restart policy of Jobs, Never
+		// matches the default restart policy of Podman
+		pod.Spec.RestartPolicy = v1.RestartPolicyNever
+	case v1.RestartPolicyOnFailure, v1.RestartPolicyNever:
+	default:
+		return nil, fmt.Errorf("k8s Jobs can only have restartPolicy set to OnFailure or Never")
+	}
+
+	// Create the Job object, k8s generates the selector of a Job
+	job := YAMLJob{
+		Job: v1batch.Job{
+			ObjectMeta: v12.ObjectMeta{
+				Name:              pod.Name + "-job",
+				CreationTimestamp: pod.CreationTimestamp,
+				Labels:            pod.Labels,
+			},
+			TypeMeta: v12.TypeMeta{
+				Kind:       "Job",
+				APIVersion: "batch/v1",
+			},
+		},
+		Spec: &YAMLJobSpec{
+			Template: &YAMLPodTemplateSpec{
+				PodTemplateSpec: v1.PodTemplateSpec{
+					ObjectMeta: pod.ObjectMeta,
+				},
+				Spec: pod.Spec,
+			},
+		},
+	}
+
+	return &job, nil
+}
+
 // GenerateForKube generates a v1.PersistentVolumeClaim from a libpod volume.
 func (v *Volume) GenerateForKube() *v1.PersistentVolumeClaim {
 	annotations := make(map[string]string)
@@ -271,6 +365,48 @@ type YAMLDeployment struct {
 	Status *v1.DeploymentStatus `json:"status,omitempty"`
 }
 
+// YAMLDaemonSetSpec represents the same k8s API apps DaemonSetSpec with a small
+// change and that is having Template as a pointer to YAMLPodTemplateSpec and
+// UpdateStrategy as a pointer to k8s API apps DaemonSetUpdateStrategy.
+// Because Go doesn't omit empty struct and we want to omit UpdateStrategy and any
+// fields in the Pod YAML if it's empty.
+type YAMLDaemonSetSpec struct {
+	v1apps.DaemonSetSpec
+	Template       *YAMLPodTemplateSpec            `json:"template,omitempty"`
+	UpdateStrategy *v1apps.DaemonSetUpdateStrategy `json:"updateStrategy,omitempty"`
+}
+
+// YAMLDaemonSet represents the same k8s API apps DaemonSet with a small change
+// and that is having Spec as a pointer to YAMLDaemonSetSpec and Status as a pointer to
+// k8s API apps DaemonSetStatus.
+// Because Go doesn't omit empty struct and we want to omit Status and any fields in the
+// DaemonSetSpec if it's empty.
+type YAMLDaemonSet struct {
+	v1apps.DaemonSet
+	Spec   *YAMLDaemonSetSpec      `json:"spec,omitempty"`
+	Status *v1apps.DaemonSetStatus `json:"status,omitempty"`
+}
+
+// YAMLJobSpec represents the same k8s API batch JobSpec with a small change and
+// that is having Template as a pointer to YAMLPodTemplateSpec.
+// Because Go doesn't omit empty struct and we want to omit any fields in the Pod YAML
+// if it's empty.
+type YAMLJobSpec struct {
+	v1batch.JobSpec
+	Template *YAMLPodTemplateSpec `json:"template,omitempty"`
+}
+
+// YAMLJob represents the same k8s API batch Job with a small change and that is
+// having Spec as a pointer to YAMLJobSpec and Status as a pointer to k8s API
+// batch JobStatus.
+// Because Go doesn't omit empty struct and we want to omit Status and any fields in the
+// JobSpec if it's empty.
+type YAMLJob struct {
+	v1batch.Job
+	Spec   *YAMLJobSpec       `json:"spec,omitempty"`
+	Status *v1batch.JobStatus `json:"status,omitempty"`
+}
+
 // YAMLService represents the same k8s API core Service struct with a small
 // change and that is having Status as a pointer to k8s API core ServiceStatus.
 // Because Go doesn't omit empty struct and we want to omit Status in YAML
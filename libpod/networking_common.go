@@ -26,12 +26,18 @@ import (
 // This is need because a HostIP of 127.0.0.1 would now allow the gvproxy forwarder to reach to open ports.
 // For machine the HostIP must only be used by gvproxy and never in the VM.
 func (c *Container) convertPortMappings() []types.PortMapping {
-	if !machine.IsGvProxyBased() || len(c.config.PortMappings) == 0 {
-		return c.config.PortMappings
+	return convertMachinePorts(c.config.PortMappings)
+}
+
+// convertMachinePorts removes the HostIP part from the given ports when
+// running inside podman machine, see convertPortMappings.
+func convertMachinePorts(ports []types.PortMapping) []types.PortMapping {
+	if !machine.IsGvProxyBased() || len(ports) == 0 {
+		return ports
 	}
 	// if we run in a machine VM we have to ignore the host IP part
-	newPorts := make([]types.PortMapping, 0, len(c.config.PortMappings))
-	for _, port := range c.config.PortMappings {
+	newPorts := make([]types.PortMapping, 0, len(ports))
+	for _, port := range ports {
 		port.HostIP = ""
 		newPorts = append(newPorts, port)
 	}
@@ -186,13 +192,26 @@ func (r *Runtime) reloadContainerNetwork(ctr *Container) (map[string]types.Statu
 		}
 	}
 
-	networkOpts, err := ctr.networks()
+	networkOpts, err := ctr.staticNetworkOptions()
+	if err != nil {
+		return nil, err
+	}
+	ctr.perNetworkOpts = networkOpts
+
+	return r.configureNetNS(ctr, ctr.state.NetNS)
+}
+
+// staticNetworkOptions returns the per network options of the container with
+// the interface names, MAC and IP addresses of its current network status, so
+// that setting up the networks again keeps them.
+func (c *Container) staticNetworkOptions() (map[string]types.PerNetworkOptions, error) {
+	networkOpts, err := c.networks()
 	if err != nil {
 		return nil, err
 	}
 
 	// Set the same network settings as before..
-	netStatus := ctr.getNetworkStatus()
+	netStatus := c.getNetworkStatus()
 	for network, perNetOpts := range networkOpts {
 		for name, netInt := range netStatus[network].Interfaces {
 			perNetOpts.InterfaceName = name
@@ -206,9 +225,73 @@ func (r *Runtime) reloadContainerNetwork(ctr *Container) (map[string]types.Statu
 		}
 		networkOpts[network] = perNetOpts
 	}
-	ctr.perNetworkOpts = networkOpts
+	return networkOpts, nil
+}
 
-	return r.configureNetNS(ctr, ctr.state.NetNS)
+// updateContainerPorts changes the forwarded ports of a container with a
+// configured network to newPorts.  added and removed are the ports which
+// differ from the current port mappings.  With bridge networking the networks
+// are set up again with the new ports to recreate the firewall rules, keeping
+// the addresses of the container.  If a rootless port forwarder is running,
+// the ports are added to and removed from it.
+func (r *Runtime) updateContainerPorts(ctr *Container, newPorts, added, removed []types.PortMapping) (retErr error) {
+	if ctr.config.NetMode.IsPasta() {
+		return fmt.Errorf("the forwarded ports of pasta cannot be changed while the network of container %s is set up, stop the container first: %w", ctr.ID(), define.ErrCtrStateInvalid)
+	}
+
+	if err := r.exposeMachinePorts(added); err != nil {
+		return err
+	}
+	defer func() {
+		if retErr != nil {
+			if err := r.unexposeMachinePorts(added); err != nil {
+				logrus.Errorf("failed to free gvproxy machine ports: %v", err)
+			}
+		}
+	}()
+
+	if ctr.config.NetMode.IsSlirp4netns() || (ctr.config.NetMode.IsBridge() && rootless.IsRootless()) {
+		if err := ctr.updateRootlessPortForwarder(convertMachinePorts(added), convertMachinePorts(removed)); err != nil {
+			return err
+		}
+		defer func() {
+			if retErr != nil {
+				if err := ctr.updateRootlessPortForwarder(convertMachinePorts(removed), convertMachinePorts(added)); err != nil {
+					logrus.Errorf("Restoring the forwarded ports of container %s: %v", ctr.ID(), err)
+				}
+			}
+		}()
+	}
+
+	if ctr.config.NetMode.IsBridge() {
+		networks, err := ctr.staticNetworkOptions()
+		if err != nil {
+			return err
+		}
+		// The networks are not changed, only the ports.
+		if len(networks) > 0 {
+			oldOpts := ctr.getNetworkOptions(networks)
+			newOpts := oldOpts
+			newOpts.PortMappings = convertMachinePorts(newPorts)
+			if err := r.teardownNetworkBackend(ctr.state.NetNS, oldOpts); err != nil {
+				// Missing firewall rules must not block setting up the new ones.
+				logrus.Warnf("Tearing down network of container %s to update its ports: %v", ctr.ID(), err)
+			}
+			netStatus, err := r.setUpNetwork(ctr.state.NetNS, newOpts)
+			if err != nil {
+				if _, rerr := r.setUpNetwork(ctr.state.NetNS, oldOpts); rerr != nil {
+					logrus.Errorf("Restoring network of container %s: %v", ctr.ID(), rerr)
+				}
+				return fmt.Errorf("setting up network of container %s with the new ports: %w", ctr.ID(), err)
+			}
+			ctr.state.NetworkStatus = netStatus
+		}
+	}
+
+	if err := r.unexposeMachinePorts(removed); err != nil {
+		logrus.Errorf("failed to free gvproxy machine ports: %v", err)
+	}
+	return nil
 }
 
 // Produce an InspectNetworkSettings containing information on the container
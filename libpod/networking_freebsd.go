@@ -268,6 +268,10 @@ func (c *Container) reloadRootlessRLKPortMapping() error {
 	return errors.New("unsupported (*Container).reloadRootlessRLKPortMapping")
 }
 
+func (c *Container) updateRootlessPortForwarder(added, removed []types.PortMapping) error {
+	return errors.New("unsupported (*Container).updateRootlessPortForwarder")
+}
+
 func (c *Container) setupRootlessNetwork() error {
 	return nil
 }
@@ -20,6 +20,7 @@ import (
 
 	"github.com/containernetworking/plugins/pkg/ns"
 	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/errorhandling"
 	"github.com/containers/podman/v4/pkg/rootless"
 	"github.com/containers/podman/v4/pkg/rootlessport"
@@ -639,15 +640,6 @@ func (r *Runtime) setupRootlessPortMappingViaSlirp(ctr *Container, cmd *exec.Cmd
 
 // openSlirp4netnsPort sends the slirp4netns pai quey to the given socket
 func openSlirp4netnsPort(apiSocket, proto, hostip string, hostport, guestport uint16) error {
-	conn, err := net.Dial("unix", apiSocket)
-	if err != nil {
-		return fmt.Errorf("cannot open connection to %s: %w", apiSocket, err)
-	}
-	defer func() {
-		if err := conn.Close(); err != nil {
-			logrus.Errorf("Unable to close slirp4netns connection: %q", err)
-		}
-	}()
 	apiCmd := slirp4netnsCmd{
 		Execute: "add_hostfwd",
 		Args: slirp4netnsCmdArg{
@@ -657,9 +649,77 @@ func openSlirp4netnsPort(apiSocket, proto, hostip string, hostport, guestport ui
 			GuestPort: guestport,
 		},
 	}
+	// if there is no 'error' key in the received JSON data, then the operation was
+	// successful.
+	var y map[string]interface{}
+	if err := slirp4netnsRequest(apiSocket, &apiCmd, &y); err != nil {
+		return err
+	}
+	if e, found := y["error"]; found {
+		return fmt.Errorf("from slirp4netns while setting up port redirection: %v", e)
+	}
+	return nil
+}
+
+// slirp4netnsHostFwd is a port forwarding rule of the slirp4netns API.
+type slirp4netnsHostFwd struct {
+	ID       int    `json:"id"`
+	Proto    string `json:"proto"`
+	HostAddr string `json:"host_addr"`
+	HostPort uint16 `json:"host_port"`
+}
+
+// closeSlirp4netnsPort removes the forwarding rule of the host port via the
+// given slirp4netns API socket.
+func closeSlirp4netnsPort(apiSocket, proto, hostip string, hostport uint16) error {
+	var list struct {
+		Return struct {
+			Entries []slirp4netnsHostFwd `json:"entries"`
+		} `json:"return"`
+		Error interface{} `json:"error"`
+	}
+	if err := slirp4netnsRequest(apiSocket, map[string]string{"execute": "list_hostfwd"}, &list); err != nil {
+		return err
+	}
+	if list.Error != nil {
+		return fmt.Errorf("from slirp4netns while listing port redirections: %v", list.Error)
+	}
+	for _, entry := range list.Return.Entries {
+		if entry.Proto != proto || entry.HostAddr != hostip || entry.HostPort != hostport {
+			continue
+		}
+		apiCmd := map[string]interface{}{
+			"execute":   "remove_hostfwd",
+			"arguments": map[string]int{"id": entry.ID},
+		}
+		var y map[string]interface{}
+		if err := slirp4netnsRequest(apiSocket, apiCmd, &y); err != nil {
+			return err
+		}
+		if e, found := y["error"]; found {
+			return fmt.Errorf("from slirp4netns while removing port redirection: %v", e)
+		}
+		return nil
+	}
+	logrus.Debugf("slirp4netns does not redirect port %s:%d/%s", hostip, hostport, proto)
+	return nil
+}
+
+// slirp4netnsRequest sends the API command to the given slirp4netns socket
+// and decodes the response into resp.
+func slirp4netnsRequest(apiSocket string, apiCmd, resp interface{}) error {
+	conn, err := net.Dial("unix", apiSocket)
+	if err != nil {
+		return fmt.Errorf("cannot open connection to %s: %w", apiSocket, err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logrus.Errorf("Unable to close slirp4netns connection: %q", err)
+		}
+	}()
 	// create the JSON payload and send it.  Mark the end of request shutting down writes
 	// to the socket, as requested by slirp4netns.
-	data, err := json.Marshal(&apiCmd)
+	data, err := json.Marshal(apiCmd)
 	if err != nil {
 		return fmt.Errorf("cannot marshal JSON for slirp4netns: %w", err)
 	}
@@ -669,19 +729,8 @@ func openSlirp4netnsPort(apiSocket, proto, hostip string, hostport, guestport ui
 	if err := conn.(*net.UnixConn).CloseWrite(); err != nil {
 		return fmt.Errorf("cannot shutdown the socket %s: %w", apiSocket, err)
 	}
-	buf := make([]byte, 2048)
-	readLength, err := conn.Read(buf)
-	if err != nil {
-		return fmt.Errorf("cannot read from control socket %s: %w", apiSocket, err)
-	}
-	// if there is no 'error' key in the received JSON data, then the operation was
-	// successful.
-	var y map[string]interface{}
-	if err := json.Unmarshal(buf[0:readLength], &y); err != nil {
-		return fmt.Errorf("parsing error status from slirp4netns: %w", err)
-	}
-	if e, found := y["error"]; found {
-		return fmt.Errorf("from slirp4netns while setting up port redirection: %v", e)
+	if err := json.NewDecoder(conn).Decode(resp); err != nil {
+		return fmt.Errorf("parsing response from slirp4netns: %w", err)
 	}
 	return nil
 }
@@ -722,13 +771,25 @@ func (c *Container) reloadRootlessRLKPortMapping() error {
 	childIP := getRootlessPortChildIP(c, c.state.NetworkStatus)
 	logrus.Debugf("reloading rootless ports for container %s, childIP is %s", c.config.ID, childIP)
 
-	conn, err := openUnixSocket(filepath.Join(c.runtime.config.Engine.TmpDir, "rp", c.config.ID))
+	conn, err := openUnixSocket(c.rootlessPortSocketPath())
 	if err != nil {
 		return fmt.Errorf("could not reload rootless port mappings, port forwarding may no longer work correctly: %w", err)
 	}
 	defer conn.Close()
+	return sendRootlessPortRequest(conn, childIP)
+}
+
+// rootlessPortSocketPath returns the path of the socket of the container's
+// rootlessport process.
+func (c *Container) rootlessPortSocketPath() string {
+	return filepath.Join(c.runtime.config.Engine.TmpDir, "rp", c.config.ID)
+}
+
+// sendRootlessPortRequest sends the request to the rootlessport process and
+// waits for its answer.
+func sendRootlessPortRequest(conn net.Conn, request interface{}) error {
 	enc := json.NewEncoder(conn)
-	err = enc.Encode(childIP)
+	err := enc.Encode(request)
 	if err != nil {
 		return fmt.Errorf("port reloading failed: %w", err)
 	}
@@ -742,3 +803,71 @@ func (c *Container) reloadRootlessRLKPortMapping() error {
 	}
 	return nil
 }
+
+// updateRootlessPortForwarder adds and removes the ports of the running port
+// forwarder of the container, either slirp4netns or rootlessport.  The port
+// forwarder only runs if the container was started with published ports.
+func (c *Container) updateRootlessPortForwarder(added, removed []types.PortMapping) error {
+	if c.config.NetMode.IsSlirp4netns() {
+		netOptions, err := parseSlirp4netnsNetworkOptions(c.runtime, c.config.NetworkOptions[slirp4netnsBinaryName])
+		if err != nil {
+			return err
+		}
+		if netOptions.isSlirpHostForward {
+			return c.updateSlirp4netnsPorts(added, removed)
+		}
+	}
+
+	socketPath := c.rootlessPortSocketPath()
+	if _, err := os.Stat(socketPath); err != nil {
+		return fmt.Errorf("no port forwarder is running for container %s, restart the container to change its ports: %w", c.ID(), define.ErrCtrStateInvalid)
+	}
+	conn, err := openUnixSocket(socketPath)
+	if err != nil {
+		return fmt.Errorf("connecting to the port forwarder of container %s: %w", c.ID(), err)
+	}
+	defer conn.Close()
+	update := rootlessport.PortUpdate{
+		ChildIP: getRootlessPortChildIP(c, c.state.NetworkStatus),
+		Add:     added,
+		Remove:  removed,
+	}
+	logrus.Debugf("updating rootless ports for container %s, childIP is %s", c.config.ID, update.ChildIP)
+	return sendRootlessPortRequest(conn, update)
+}
+
+// updateSlirp4netnsPorts adds and removes the ports of the container via the
+// slirp4netns API socket.
+func (c *Container) updateSlirp4netnsPorts(added, removed []types.PortMapping) error {
+	apiSocket := filepath.Join(c.runtime.config.Engine.TmpDir, fmt.Sprintf("%s.net", c.config.ID))
+	if _, err := os.Stat(apiSocket); err != nil {
+		return fmt.Errorf("no slirp4netns API socket exists for container %s, restart the container to change its ports: %w", c.ID(), define.ErrCtrStateInvalid)
+	}
+	for _, port := range removed {
+		for _, protocol := range strings.Split(port.Protocol, ",") {
+			hostIP := port.HostIP
+			if hostIP == "" {
+				hostIP = "0.0.0.0"
+			}
+			for i := uint16(0); i < port.Range; i++ {
+				if err := closeSlirp4netnsPort(apiSocket, protocol, hostIP, port.HostPort+i); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for _, port := range added {
+		for _, protocol := range strings.Split(port.Protocol, ",") {
+			hostIP := port.HostIP
+			if hostIP == "" {
+				hostIP = "0.0.0.0"
+			}
+			for i := uint16(0); i < port.Range; i++ {
+				if err := openSlirp4netnsPort(apiSocket, protocol, hostIP, port.HostPort+i, port.ContainerPort+i); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
@@ -89,3 +89,7 @@ func (c *Container) convertPortMappings() []types.PortMapping {
 func GetSlirp4netnsIP(subnet *net.IPNet) (*net.IP, error) {
 	return nil, errors.New("not implemented GetSlirp4netnsIP")
 }
+
+func (r *Runtime) updateContainerPorts(ctr *Container, newPorts, added, removed []types.PortMapping) error {
+	return errors.New("not implemented (*Runtime) updateContainerPorts")
+}
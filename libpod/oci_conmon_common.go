@@ -848,6 +848,13 @@ func (r *ConmonOCIRuntime) CheckpointContainer(ctr *Container, options Container
 			filepath.Join("..", preCheckpointDir),
 		)
 	}
+	if options.PreCheckPoint && options.WithPrevious {
+		parent, err := ctr.parentPreCheckPointDir()
+		if err != nil {
+			return 0, err
+		}
+		args = append(args, "--parent-path", filepath.Join("..", parent))
+	}
 
 	args = append(args, ctr.ID())
 	logrus.Debugf("the args to checkpoint: %s %s", r.path, strings.Join(args, " "))
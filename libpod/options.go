@@ -533,6 +533,26 @@ func WithMigrateRuntime(requestedRuntime string) RuntimeOption {
 	}
 }
 
+// WithMigrateDatabaseBackend instructs Engine to move all containers, pods
+// and volumes to the given database backend during a migration. This is not
+// used if `WithMigrate()` is not also passed.
+func WithMigrateDatabaseBackend(backend string) RuntimeOption {
+	return func(rt *Runtime) error {
+		if rt.valid {
+			return define.ErrRuntimeFinalized
+		}
+
+		dbBackend, err := config.ParseDBBackend(backend)
+		if err != nil {
+			return fmt.Errorf("%v: %w", err, define.ErrInvalidArg)
+		}
+
+		rt.migrateDBBackend = dbBackend
+
+		return nil
+	}
+}
+
 // WithEventsLogger sets the events backend to use.
 // Currently supported values are "file" for file backend and "journald" for
 // journald backend.
@@ -1429,12 +1449,10 @@ func WithRestartPolicy(policy string) CtrCreateOption {
 			return define.ErrCtrFinalized
 		}
 
-		switch policy {
-		case define.RestartPolicyNone, define.RestartPolicyNo, define.RestartPolicyOnFailure, define.RestartPolicyAlways, define.RestartPolicyUnlessStopped:
-			ctr.config.RestartPolicy = policy
-		default:
-			return fmt.Errorf("%q is not a valid restart policy: %w", policy, define.ErrInvalidArg)
+		if err := define.ValidateRestartPolicy(policy); err != nil {
+			return err
 		}
+		ctr.config.RestartPolicy = policy
 
 		return nil
 	}
@@ -1455,6 +1473,20 @@ func WithRestartRetries(tries uint) CtrCreateOption {
 	}
 }
 
+// WithRestartDependents sets the container to restart the containers
+// depending on it whenever it is restarted.
+func WithRestartDependents() CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+
+		ctr.config.RestartDependents = true
+
+		return nil
+	}
+}
+
 // WithNamedVolumes adds the given named volumes to the container.
 func WithNamedVolumes(volumes []*ContainerNamedVolume) CtrCreateOption {
 	return func(ctr *Container) error {
@@ -1773,6 +1805,23 @@ func WithVolumeDisableQuota() VolumeCreateOption {
 	}
 }
 
+// WithVolumeQuotaWarning sets the percentage of the maximum size of the volume
+// at which a warning event is emitted.
+func WithVolumeQuotaWarning(percent uint) VolumeCreateOption {
+	return func(volume *Volume) error {
+		if volume.valid {
+			return define.ErrVolumeFinalized
+		}
+
+		if percent == 0 || percent > 100 {
+			return fmt.Errorf("quota warning must be a percentage between 1 and 100: %w", define.ErrInvalidArg)
+		}
+		volume.config.QuotaWarning = percent
+
+		return nil
+	}
+}
+
 // withSetAnon sets a bool notifying libpod that this volume is anonymous and
 // should be removed when containers using it are removed and volumes are
 // specified for removal.
@@ -1967,6 +2016,33 @@ func WithStartupHealthcheck(startupHC *define.StartupHealthCheck) CtrCreateOptio
 	}
 }
 
+// WithReadinessCheck sets a readiness check for the container.
+func WithReadinessCheck(readinessCheck *define.ReadinessCheck) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.ReadinessCheckConfig = new(define.ReadinessCheck)
+		if err := JSONDeepCopy(readinessCheck, ctr.config.ReadinessCheckConfig); err != nil {
+			return fmt.Errorf("error copying readiness check into container: %w", err)
+		}
+		return nil
+	}
+}
+
+// WithLifecycleHooks sets the commands executed inside the container after it
+// started and before it is stopped.
+func WithLifecycleHooks(postStart, preStop []string) CtrCreateOption {
+	return func(ctr *Container) error {
+		if ctr.valid {
+			return define.ErrCtrFinalized
+		}
+		ctr.config.PostStartHook = postStart
+		ctr.config.PreStopHook = preStop
+		return nil
+	}
+}
+
 // Pod Creation Options
 
 // WithPodCreateCommand adds the full command plus arguments of the current
@@ -2024,13 +2100,11 @@ func WithPodRestartPolicy(policy string) PodCreateOption {
 			return define.ErrPodFinalized
 		}
 
-		switch policy {
 		//TODO: v5.0 if no restart policy is set, follow k8s convention and default to Always
-		case define.RestartPolicyNone, define.RestartPolicyNo, define.RestartPolicyOnFailure, define.RestartPolicyAlways, define.RestartPolicyUnlessStopped:
-			pod.config.RestartPolicy = policy
-		default:
-			return fmt.Errorf("%q is not a valid restart policy: %w", policy, define.ErrInvalidArg)
+		if err := define.ValidateRestartPolicy(policy); err != nil {
+			return err
 		}
+		pod.config.RestartPolicy = policy
 
 		return nil
 	}
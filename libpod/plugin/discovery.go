@@ -0,0 +1,143 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// SocketDirs are the directories searched for plugin sockets, following
+	// the Docker plugin discovery rules.
+	SocketDirs = []string{"/run/docker/plugins"}
+	// SpecDirs are the directories searched for plugin spec (.spec) and
+	// JSON (.json) files, following the Docker plugin discovery rules.
+	SpecDirs = []string{"/etc/docker/plugins", "/usr/lib/docker/plugins"}
+)
+
+// pluginSpec is the content of a JSON plugin spec file.
+type pluginSpec struct {
+	Name string
+	Addr string
+}
+
+// DiscoverPlugins searches the plugin directories and returns the socket paths
+// of all found plugins, keyed by plugin name.  Plugins are not validated, the
+// socket may not even exist.  Plugins found in the socket directories take
+// precedence over spec files.
+func DiscoverPlugins() (map[string]string, error) {
+	return discoverPlugins(SocketDirs, SpecDirs)
+}
+
+func discoverPlugins(socketDirs, specDirs []string) (map[string]string, error) {
+	found := make(map[string]string)
+	for _, dir := range socketDirs {
+		if err := discoverSockets(dir, found); err != nil {
+			return nil, err
+		}
+	}
+	for _, dir := range specDirs {
+		if err := discoverSpecs(dir, found); err != nil {
+			return nil, err
+		}
+	}
+	return found, nil
+}
+
+// discoverSockets adds the plugins at DIR/NAME.sock and DIR/NAME/NAME.sock.
+func discoverSockets(dir string, found map[string]string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("reading plugin directory %s: %w", dir, err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		path := filepath.Join(dir, name)
+		if entry.IsDir() {
+			path = filepath.Join(path, name+".sock")
+		} else {
+			if filepath.Ext(name) != ".sock" {
+				continue
+			}
+			name = strings.TrimSuffix(name, ".sock")
+		}
+		stat, err := os.Stat(path)
+		if err != nil || stat.Mode()&os.ModeSocket == 0 {
+			continue
+		}
+		if _, ok := found[name]; !ok {
+			found[name] = path
+		}
+	}
+	return nil
+}
+
+// discoverSpecs adds the plugins described by DIR/NAME.spec and DIR/NAME.json
+// files.  Only unix socket addresses are supported.
+func discoverSpecs(dir string, found map[string]string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return fmt.Errorf("reading plugin directory %s: %w", dir, err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(entry.Name())
+		if ext != ".spec" && ext != ".json" {
+			continue
+		}
+		name := strings.TrimSuffix(entry.Name(), ext)
+		if _, ok := found[name]; ok {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		socket, err := readSpecFile(path)
+		if err != nil {
+			logrus.Warnf("Ignoring plugin spec file %s: %v", path, err)
+			continue
+		}
+		found[name] = socket
+	}
+	return nil
+}
+
+// readSpecFile returns the socket path of the plugin described by the spec
+// file.
+func readSpecFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	addr := strings.TrimSpace(string(content))
+	if filepath.Ext(path) == ".json" {
+		spec := new(pluginSpec)
+		if err := json.Unmarshal(content, spec); err != nil {
+			return "", fmt.Errorf("unmarshalling plugin spec: %w", err)
+		}
+		addr = spec.Addr
+	}
+
+	u, err := url.Parse(addr)
+	if err != nil {
+		return "", fmt.Errorf("parsing plugin address %q: %w", addr, err)
+	}
+	if u.Scheme != "unix" {
+		return "", fmt.Errorf("unsupported plugin address %q, only unix sockets are supported", addr)
+	}
+	if !filepath.IsAbs(u.Path) {
+		return "", fmt.Errorf("plugin socket path %q must be absolute", u.Path)
+	}
+	return filepath.Clean(u.Path), nil
+}
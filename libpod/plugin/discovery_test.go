@@ -0,0 +1,49 @@
+package plugin
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func listenUnix(t *testing.T, path string) {
+	l, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	t.Cleanup(func() { l.Close() })
+}
+
+func TestDiscoverPlugins(t *testing.T) {
+	socketDir := t.TempDir()
+	specDir := t.TempDir()
+
+	listenUnix(t, filepath.Join(socketDir, "flat.sock"))
+	require.NoError(t, os.Mkdir(filepath.Join(socketDir, "nested"), 0755))
+	listenUnix(t, filepath.Join(socketDir, "nested", "nested.sock"))
+	// Not a socket.
+	require.NoError(t, os.WriteFile(filepath.Join(socketDir, "file.sock"), nil, 0644))
+
+	specs := map[string]string{
+		"spec.spec":     "unix:///run/spec/spec.sock\n",
+		"json.json":     `{"Name": "json", "Addr": "unix:///run/json.sock"}`,
+		"flat.spec":     "unix:///run/other.sock",
+		"tcp.spec":      "tcp://localhost:8080",
+		"relative.spec": "unix://relative.sock",
+		"ignored.txt":   "unix:///run/ignored.sock",
+	}
+	for name, content := range specs {
+		require.NoError(t, os.WriteFile(filepath.Join(specDir, name), []byte(content), 0644))
+	}
+
+	found, err := discoverPlugins([]string{socketDir, filepath.Join(socketDir, "missing")}, []string{specDir})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		"flat":   filepath.Join(socketDir, "flat.sock"),
+		"nested": filepath.Join(socketDir, "nested", "nested.sock"),
+		"spec":   "/run/spec/spec.sock",
+		"json":   "/run/json.sock",
+	}, found)
+}
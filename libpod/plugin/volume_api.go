@@ -29,18 +29,23 @@ var json = jsoniter.ConfigCompatibleWithStandardLibrary
 // These are well-established paths that should not change unless the plugin API
 // version changes.
 var (
-	activatePath    = "/Plugin.Activate"
-	createPath      = "/VolumeDriver.Create"
-	getPath         = "/VolumeDriver.Get"
-	listPath        = "/VolumeDriver.List"
-	removePath      = "/VolumeDriver.Remove"
-	hostVirtualPath = "/VolumeDriver.Path"
-	mountPath       = "/VolumeDriver.Mount"
-	unmountPath     = "/VolumeDriver.Unmount"
+	activatePath     = "/Plugin.Activate"
+	createPath       = "/VolumeDriver.Create"
+	getPath          = "/VolumeDriver.Get"
+	listPath         = "/VolumeDriver.List"
+	removePath       = "/VolumeDriver.Remove"
+	hostVirtualPath  = "/VolumeDriver.Path"
+	mountPath        = "/VolumeDriver.Mount"
+	unmountPath      = "/VolumeDriver.Unmount"
+	capabilitiesPath = "/VolumeDriver.Capabilities"
 )
 
 const (
 	volumePluginType = "VolumeDriver"
+
+	// defaultScope is the scope of plugins which do not report their
+	// capabilities.
+	defaultScope = "local"
 )
 
 var (
@@ -469,3 +474,41 @@ func (p *VolumePlugin) UnmountVolume(req *volume.UnmountRequest) error {
 
 	return p.handleErrorResponse(resp, unmountPath, req.Name)
 }
+
+// Capabilities gets the capabilities of the plugin. Plugins that do not
+// implement the capabilities endpoint are assumed to be of local scope.
+func (p *VolumePlugin) Capabilities() (*volume.Capability, error) {
+	if err := p.verifyReachable(); err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("Getting capabilities of plugin %s", p.Name)
+
+	resp, err := p.sendRequest(nil, capabilitiesPath)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return &volume.Capability{Scope: defaultScope}, nil
+	}
+	if err := p.handleErrorResponse(resp, capabilitiesPath, ""); err != nil {
+		return nil, err
+	}
+
+	capRespBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body from volume plugin %s: %w", p.Name, err)
+	}
+
+	capResp := new(volume.CapabilitiesResponse)
+	if err := json.Unmarshal(capRespBytes, capResp); err != nil {
+		return nil, fmt.Errorf("unmarshalling volume plugin %s capabilities response: %w", p.Name, err)
+	}
+	if capResp.Capabilities.Scope == "" {
+		capResp.Capabilities.Scope = defaultScope
+	}
+
+	return &capResp.Capabilities, nil
+}
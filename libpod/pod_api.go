@@ -752,3 +752,74 @@ func (p *Pod) Inspect() (*define.InspectPodData, error) {
 
 	return &inspectData, nil
 }
+
+// Update updates the pod's resource limits and restart policy.
+// The resource limits given are merged into the pod's limits and applied to
+// the pod cgroup in place.  The restart policy is set on the pod and all of
+// its containers except init containers.  A nil resources or an empty restart
+// policy leaves the respective setting untouched; restartRetries is only used
+// together with a restart policy.
+func (p *Pod) Update(ctx context.Context, resources *specs.LinuxResources, restartPolicy string, restartRetries *uint) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if err := p.updatePod(); err != nil {
+		return err
+	}
+
+	if restartPolicy != "" {
+		if err := define.ValidateRestartPolicy(restartPolicy); err != nil {
+			return err
+		}
+		if restartRetries != nil && restartPolicy != define.RestartPolicyOnFailure {
+			return fmt.Errorf("restart retries can only be used with the %s restart policy: %w", define.RestartPolicyOnFailure, define.ErrInvalidArg)
+		}
+	}
+
+	newConfig := new(PodConfig)
+	if err := JSONDeepCopy(p.config, newConfig); err != nil {
+		return err
+	}
+
+	if resources != nil {
+		// Unmarshalling over the current limits only overwrites the
+		// limits which are set in resources.
+		if err := JSONDeepCopy(resources, &newConfig.ResourceLimits); err != nil {
+			return err
+		}
+		if err := p.platformUpdateCgroup(&newConfig.ResourceLimits); err != nil {
+			return fmt.Errorf("updating cgroup of pod %s: %w", p.ID(), err)
+		}
+	}
+
+	if restartPolicy != "" {
+		newConfig.RestartPolicy = restartPolicy
+		newConfig.RestartRetries = restartRetries
+
+		ctrs, err := p.runtime.state.PodContainers(p)
+		if err != nil {
+			return err
+		}
+		ctrOptions := &ContainerUpdateOptions{
+			RestartPolicy:  &restartPolicy,
+			RestartRetries: restartRetries,
+		}
+		for _, ctr := range ctrs {
+			if ctr.IsInitCtr() {
+				continue
+			}
+			if err := ctr.Update(ctrOptions); err != nil {
+				return fmt.Errorf("updating restart policy of container %s: %w", ctr.ID(), err)
+			}
+		}
+	}
+
+	if err := p.runtime.state.RewritePodConfig(p, newConfig); err != nil {
+		return err
+	}
+	p.config = newConfig
+
+	p.newPodEvent(events.Update)
+
+	return nil
+}
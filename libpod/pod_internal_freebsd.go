@@ -1,5 +1,15 @@
 package libpod
 
+import (
+	"errors"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
 func (p *Pod) platformRefresh() error {
 	return nil
 }
+
+func (p *Pod) platformUpdateCgroup(resources *specs.LinuxResources) error {
+	return errors.New("updating pod resource limits is not supported on FreeBSD")
+}
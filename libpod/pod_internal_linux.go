@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"path/filepath"
 
+	"github.com/containers/common/pkg/cgroups"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/rootless"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
 )
 
@@ -32,3 +34,19 @@ func (p *Pod) platformRefresh() error {
 	}
 	return nil
 }
+
+// platformUpdateCgroup applies the resource limits to the pod cgroup.
+func (p *Pod) platformUpdateCgroup(resources *specs.LinuxResources) error {
+	if !p.config.UsePodCgroup || p.state.CgroupPath == "" {
+		return fmt.Errorf("pod %s does not have a cgroup: %w", p.ID(), define.ErrNoCgroups)
+	}
+	res, err := GetLimits(resources)
+	if err != nil {
+		return err
+	}
+	cgroup, err := cgroups.Load(p.state.CgroupPath)
+	if err != nil {
+		return err
+	}
+	return cgroup.Update(&res)
+}
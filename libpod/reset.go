@@ -171,6 +171,12 @@ func (r *Runtime) reset(ctx context.Context) error {
 			return err
 		}
 	}
+	// The artifacts of manifest lists are not removed with the images
+	// if the graph root is kept below.
+	if err := os.RemoveAll(r.ManifestArtifactsDir()); err != nil {
+		logrus.Errorf("Removing artifacts of manifest lists: %v", err)
+	}
+
 	_, prevError := r.store.Shutdown(true)
 	graphRoot := filepath.Clean(r.store.GraphRoot())
 	if graphRoot == xdgRuntimeDir {
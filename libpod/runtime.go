@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
@@ -101,6 +102,12 @@ type Runtime struct {
 	// We make no promises that these migrated containers work on the new
 	// runtime, though.
 	migrateRuntime string
+	// System migrate can also move all containers, pods and volumes to a
+	// different database backend.
+	migrateDBBackend config.DBBackend
+	// migratedDBBackup is the path of the old database after a database
+	// migration.
+	migratedDBBackup string
 
 	// valid indicates whether the runtime is ready to use.
 	// valid is set to true when a runtime is returned from GetRuntime(),
@@ -337,27 +344,9 @@ func makeRuntime(runtime *Runtime) (retErr error) {
 	if err != nil {
 		return err
 	}
-	switch backend {
-	case config.DBBackendBoltDB:
-		baseDir := runtime.config.Engine.StaticDir
-		if runtime.storageConfig.TransientStore {
-			baseDir = runtime.config.Engine.TmpDir
-		}
-		dbPath := filepath.Join(baseDir, "bolt_state.db")
-
-		state, err := NewBoltState(dbPath, runtime)
-		if err != nil {
-			return err
-		}
-		runtime.state = state
-	case config.DBBackendSQLite:
-		state, err := NewSqliteState(runtime)
-		if err != nil {
-			return err
-		}
-		runtime.state = state
-	default:
-		return fmt.Errorf("unrecognized state type passed (%v): %w", runtime.config.Engine.StateType, define.ErrInvalidArg)
+	runtime.state, err = runtime.newState(backend)
+	if err != nil {
+		return err
 	}
 
 	// Grab config from the database so we can reset some defaults
@@ -664,6 +653,33 @@ func makeRuntime(runtime *Runtime) (retErr error) {
 	return nil
 }
 
+// newState opens the state database of the given backend.
+func (r *Runtime) newState(backend config.DBBackend) (State, error) {
+	switch backend {
+	case config.DBBackendBoltDB:
+		return NewBoltState(r.stateDBPath(backend), r)
+	case config.DBBackendSQLite:
+		return NewSqliteState(r)
+	default:
+		return nil, fmt.Errorf("unrecognized state type passed (%v): %w", r.config.Engine.StateType, define.ErrInvalidArg)
+	}
+}
+
+// stateDBPath returns the path of the database file of the given backend.
+func (r *Runtime) stateDBPath(backend config.DBBackend) string {
+	switch backend {
+	case config.DBBackendBoltDB:
+		baseDir := r.config.Engine.StaticDir
+		if r.storageConfig.TransientStore {
+			baseDir = r.config.Engine.TmpDir
+		}
+		return filepath.Join(baseDir, "bolt_state.db")
+	case config.DBBackendSQLite:
+		return filepath.Join(sqliteStateDir(r), sqliteDBFile)
+	}
+	return ""
+}
+
 // TmpDir gets the current Libpod temporary files directory.
 func (r *Runtime) TmpDir() (string, error) {
 	if !r.valid {
@@ -817,6 +833,13 @@ func (r *Runtime) Shutdown(force bool) error {
 			lastError = fmt.Errorf("shutting down container storage: %w", err)
 		}
 	}
+	// Deliver the events queued for the event sinks.
+	if closer, ok := r.eventer.(io.Closer); ok {
+		if err := closer.Close(); err != nil {
+			logrus.Errorf("Closing eventer: %v", err)
+		}
+	}
+
 	if err := r.state.Close(); err != nil {
 		if lastError != nil {
 			logrus.Error(lastError)
@@ -972,6 +995,23 @@ func (r *Runtime) StorageConfig() storage.StoreOptions {
 	return r.storageConfig
 }
 
+// MigratedDatabaseBackup returns the path the database was moved to when it
+// was migrated to a different backend while creating the runtime.
+func (r *Runtime) MigratedDatabaseBackup() string {
+	return r.migratedDBBackup
+}
+
+// GetStore returns the c/storage store in use by Libpod.
+func (r *Runtime) GetStore() storage.Store {
+	return r.store
+}
+
+// ManifestArtifactsDir returns the directory holding the OCI artifacts added
+// to manifest lists, in one OCI layout per list.
+func (r *Runtime) ManifestArtifactsDir() string {
+	return filepath.Join(r.store.GraphRoot(), "manifest-artifacts")
+}
+
 func (r *Runtime) GarbageCollect() error {
 	return r.store.GarbageCollect()
 }
@@ -1115,7 +1155,7 @@ func (r *Runtime) getVolumePlugin(volConfig *VolumeConfig) (*plugin.VolumePlugin
 		return nil, nil
 	}
 
-	pluginPath, ok := r.config.Engine.VolumePlugins[name]
+	pluginPath, ok := r.volumePlugins()[name]
 	if !ok {
 		if name == define.VolumeDriverImage {
 			return nil, nil
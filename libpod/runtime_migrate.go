@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"syscall"
 
+	"github.com/containers/common/pkg/config"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/rootless"
 	"github.com/containers/podman/v4/pkg/util"
@@ -96,5 +97,11 @@ func (r *Runtime) migrate() error {
 		}
 	}
 
+	if r.migrateDBBackend != config.DBBackendUnsupported {
+		if err := r.migrateDatabase(); err != nil {
+			return fmt.Errorf("migrating database: %w", err)
+		}
+	}
+
 	return r.stopPauseProcess()
 }
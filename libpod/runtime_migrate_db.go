@@ -0,0 +1,432 @@
+//go:build linux
+// +build linux
+
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/sirupsen/logrus"
+)
+
+// migrateDatabase copies all containers, pods and volumes from the current
+// state to a state of the database backend requested by the migration.
+// Once the copy is verified, the database of the current backend is renamed
+// so it is kept as a backup. All containers must be stopped.
+func (r *Runtime) migrateDatabase() (retErr error) {
+	current, err := config.ParseDBBackend(r.config.Engine.DBBackend)
+	if err != nil {
+		return err
+	}
+	target := r.migrateDBBackend
+	if current == target {
+		return fmt.Errorf("database backend is already %s: %w", target, define.ErrInvalidArg)
+	}
+
+	logrus.Infof("Migrating database from %s to %s", current, target)
+
+	newState, err := r.newState(target)
+	if err != nil {
+		return fmt.Errorf("opening %s database: %w", target, err)
+	}
+	// Only set once the target is known to be empty, an existing database
+	// must never be removed.
+	removeOnError := false
+	defer func() {
+		if err := newState.Close(); err != nil {
+			logrus.Errorf("Closing %s database: %v", target, err)
+		}
+		// Do not leave a partial copy behind, the target was empty before.
+		if retErr != nil && removeOnError {
+			removeStateDB(r.stateDBPath(target))
+		}
+	}()
+
+	if err := newState.ValidateDBConfig(r); err != nil {
+		return err
+	}
+	if err := checkStateEmpty(newState); err != nil {
+		return fmt.Errorf("%s database at %s: %w", target, r.stateDBPath(target), err)
+	}
+	removeOnError = true
+
+	if err := copyState(r.state, newState); err != nil {
+		return err
+	}
+	if err := verifyStateCopy(r.state, newState); err != nil {
+		return fmt.Errorf("verifying migrated database: %w", err)
+	}
+
+	// Close the old database before moving it, so that sqlite has written
+	// back its write-ahead log and no connection refers to the old path.
+	if sqlite, ok := r.state.(*SQLiteState); ok {
+		if _, err := sqlite.conn.Exec("PRAGMA wal_checkpoint(TRUNCATE);"); err != nil {
+			return fmt.Errorf("checkpointing %s database: %w", current, err)
+		}
+	}
+	if err := r.state.Close(); err != nil {
+		return fmt.Errorf("closing %s database: %w", current, err)
+	}
+	oldPath := r.stateDBPath(current)
+	backupPath := fmt.Sprintf("%s.%s.bak", oldPath, time.Now().Format("20060102150405"))
+	backend := target
+	if err := moveStateDB(oldPath, backupPath); err != nil {
+		retErr = fmt.Errorf("backing up %s database: %w", current, err)
+		backend = current
+	}
+	// The rest of this process continues with the database in place.
+	state, err := r.newState(backend)
+	if err != nil {
+		if retErr != nil {
+			logrus.Errorf("Reopening %s database: %v", backend, err)
+			return retErr
+		}
+		return fmt.Errorf("opening %s database: %w", backend, err)
+	}
+	r.state = state
+	if retErr != nil {
+		return retErr
+	}
+
+	logrus.Infof("Migrated database from %s to %s, the old database was moved to %s", current, target, backupPath)
+	r.migratedDBBackup = backupPath
+	return nil
+}
+
+// removeStateDB removes an incomplete database including the write-ahead log
+// and shared memory files of sqlite.
+func removeStateDB(path string) {
+	for _, p := range []string{path, path + "-wal", path + "-shm"} {
+		if err := os.Remove(p); err != nil && !errors.Is(err, os.ErrNotExist) {
+			logrus.Errorf("Removing incomplete database %s: %v", p, err)
+		}
+	}
+}
+
+// moveStateDB renames a database including the write-ahead log and shared
+// memory files of sqlite.  Files already moved are moved back on errors.
+func moveStateDB(oldPath, newPath string) error {
+	suffixes := []string{"", "-wal", "-shm"}
+	for i, suffix := range suffixes {
+		err := os.Rename(oldPath+suffix, newPath+suffix)
+		if err == nil || (suffix != "" && errors.Is(err, os.ErrNotExist)) {
+			continue
+		}
+		for _, moved := range suffixes[:i] {
+			if err := os.Rename(newPath+moved, oldPath+moved); err != nil && !errors.Is(err, os.ErrNotExist) {
+				logrus.Errorf("Restoring database %s: %v", oldPath+moved, err)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// checkStateEmpty returns an error if the state contains any container, pod
+// or volume.
+func checkStateEmpty(state State) error {
+	ctrs, err := state.AllContainers(false)
+	if err != nil {
+		return err
+	}
+	pods, err := state.AllPods()
+	if err != nil {
+		return err
+	}
+	vols, err := state.AllVolumes()
+	if err != nil {
+		return err
+	}
+	if len(ctrs) > 0 || len(pods) > 0 || len(vols) > 0 {
+		return fmt.Errorf("database is not empty: %w", define.ErrInternal)
+	}
+	return nil
+}
+
+// copyState adds all volumes, pods and containers of the old state to the new
+// state, including exec sessions, exit codes and network attachments.
+func copyState(oldState, newState State) error {
+	vols, err := oldState.AllVolumes()
+	if err != nil {
+		return err
+	}
+	for _, vol := range vols {
+		if err := oldState.UpdateVolume(vol); err != nil {
+			return fmt.Errorf("retrieving state of volume %s: %w", vol.Name(), err)
+		}
+		if err := newState.AddVolume(vol); err != nil {
+			return fmt.Errorf("adding volume %s: %w", vol.Name(), err)
+		}
+	}
+
+	pods, err := oldState.AllPods()
+	if err != nil {
+		return err
+	}
+	podsByID := make(map[string]*Pod, len(pods))
+	for _, pod := range pods {
+		if err := oldState.UpdatePod(pod); err != nil {
+			return fmt.Errorf("retrieving state of pod %s: %w", pod.ID(), err)
+		}
+		if err := newState.AddPod(pod); err != nil {
+			return fmt.Errorf("adding pod %s: %w", pod.ID(), err)
+		}
+		podsByID[pod.ID()] = pod
+	}
+
+	ctrs, err := oldState.AllContainers(true)
+	if err != nil {
+		return err
+	}
+	ctrs, err = sortByDependencies(ctrs)
+	if err != nil {
+		return err
+	}
+	for _, ctr := range ctrs {
+		networks, err := oldState.GetNetworks(ctr)
+		if err != nil {
+			return fmt.Errorf("retrieving networks of container %s: %w", ctr.ID(), err)
+		}
+		// Both backends add the short ID as alias when a container is added
+		for name, opts := range networks {
+			opts.Aliases = removeAlias(opts.Aliases, ctr.ID()[:12])
+			networks[name] = opts
+		}
+		if len(networks) > 0 {
+			ctr.config.Networks = networks
+		}
+
+		if ctr.config.Pod != "" {
+			pod, ok := podsByID[ctr.config.Pod]
+			if !ok {
+				return fmt.Errorf("pod %s of container %s does not exist: %w", ctr.config.Pod, ctr.ID(), define.ErrNoSuchPod)
+			}
+			err = newState.AddContainerToPod(pod, ctr)
+		} else {
+			err = newState.AddContainer(ctr)
+		}
+		if err != nil {
+			return fmt.Errorf("adding container %s: %w", ctr.ID(), err)
+		}
+
+		sessionIDs, err := oldState.GetContainerExecSessions(ctr)
+		if err != nil {
+			return fmt.Errorf("retrieving exec sessions of container %s: %w", ctr.ID(), err)
+		}
+		for _, id := range sessionIDs {
+			session, ok := ctr.state.ExecSessions[id]
+			if !ok {
+				logrus.Warnf("Exec session %s of container %s has no state, not migrating it", id, ctr.ID())
+				continue
+			}
+			if err := newState.AddExecSession(ctr, session); err != nil {
+				return fmt.Errorf("adding exec session %s of container %s: %w", id, ctr.ID(), err)
+			}
+		}
+
+		exitCode, err := oldState.GetContainerExitCode(ctr.ID())
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchExitCode) {
+				continue
+			}
+			return fmt.Errorf("retrieving exit code of container %s: %w", ctr.ID(), err)
+		}
+		if err := newState.AddContainerExitCode(ctr.ID(), exitCode); err != nil {
+			return fmt.Errorf("adding exit code of container %s: %w", ctr.ID(), err)
+		}
+	}
+
+	return nil
+}
+
+// verifyStateCopy checks that the new state holds the same volumes, pods and
+// containers as the old state.
+func verifyStateCopy(oldState, newState State) error {
+	oldVols, err := oldState.AllVolumes()
+	if err != nil {
+		return err
+	}
+	newVols, err := newState.AllVolumes()
+	if err != nil {
+		return err
+	}
+	if err := compareIDs("volumes", volumeNames(oldVols), volumeNames(newVols)); err != nil {
+		return err
+	}
+
+	oldPods, err := oldState.AllPods()
+	if err != nil {
+		return err
+	}
+	newPods, err := newState.AllPods()
+	if err != nil {
+		return err
+	}
+	if err := compareIDs("pods", podIDs(oldPods), podIDs(newPods)); err != nil {
+		return err
+	}
+	for _, pod := range oldPods {
+		oldCtrs, err := oldState.PodContainersByID(pod)
+		if err != nil {
+			return err
+		}
+		newCtrs, err := newState.PodContainersByID(pod)
+		if err != nil {
+			return err
+		}
+		if err := compareIDs(fmt.Sprintf("containers of pod %s", pod.ID()), oldCtrs, newCtrs); err != nil {
+			return err
+		}
+	}
+
+	oldCtrs, err := oldState.AllContainers(false)
+	if err != nil {
+		return err
+	}
+	newCtrs, err := newState.AllContainers(false)
+	if err != nil {
+		return err
+	}
+	if err := compareIDs("containers", ctrIDs(oldCtrs), ctrIDs(newCtrs)); err != nil {
+		return err
+	}
+	for _, ctr := range oldCtrs {
+		oldSessions, err := oldState.GetContainerExecSessions(ctr)
+		if err != nil {
+			return err
+		}
+		newSessions, err := newState.GetContainerExecSessions(ctr)
+		if err != nil {
+			return err
+		}
+		if err := compareIDs(fmt.Sprintf("exec sessions of container %s", ctr.ID()), oldSessions, newSessions); err != nil {
+			return err
+		}
+
+		oldNetworks, err := oldState.GetNetworks(ctr)
+		if err != nil {
+			return err
+		}
+		newNetworks, err := newState.GetNetworks(ctr)
+		if err != nil {
+			return err
+		}
+		if err := compareIDs(fmt.Sprintf("networks of container %s", ctr.ID()), networkNames(oldNetworks), networkNames(newNetworks)); err != nil {
+			return err
+		}
+
+		oldExitCode, oldErr := oldState.GetContainerExitCode(ctr.ID())
+		newExitCode, newErr := newState.GetContainerExitCode(ctr.ID())
+		if (oldErr == nil) != (newErr == nil) || oldExitCode != newExitCode {
+			return fmt.Errorf("exit code of container %s does not match: %w", ctr.ID(), define.ErrInternal)
+		}
+	}
+
+	return nil
+}
+
+// sortByDependencies orders the containers so that every container comes
+// after all of its dependencies.
+func sortByDependencies(ctrs []*Container) ([]*Container, error) {
+	byID := make(map[string]*Container, len(ctrs))
+	for _, ctr := range ctrs {
+		byID[ctr.ID()] = ctr
+	}
+
+	sorted := make([]*Container, 0, len(ctrs))
+	// false while visiting, true once added to sorted
+	visited := make(map[string]bool, len(ctrs))
+	var visit func(ctr *Container) error
+	visit = func(ctr *Container) error {
+		done, seen := visited[ctr.ID()]
+		if done {
+			return nil
+		}
+		if seen {
+			return fmt.Errorf("dependency cycle at container %s: %w", ctr.ID(), define.ErrInternal)
+		}
+		visited[ctr.ID()] = false
+		for _, dep := range ctr.Dependencies() {
+			depCtr, ok := byID[dep]
+			if !ok {
+				return fmt.Errorf("dependency %s of container %s does not exist: %w", dep, ctr.ID(), define.ErrNoSuchCtr)
+			}
+			if err := visit(depCtr); err != nil {
+				return err
+			}
+		}
+		visited[ctr.ID()] = true
+		sorted = append(sorted, ctr)
+		return nil
+	}
+	for _, ctr := range ctrs {
+		if err := visit(ctr); err != nil {
+			return nil, err
+		}
+	}
+	return sorted, nil
+}
+
+func removeAlias(aliases []string, alias string) []string {
+	filtered := make([]string, 0, len(aliases))
+	for _, a := range aliases {
+		if a != alias {
+			filtered = append(filtered, a)
+		}
+	}
+	return filtered
+}
+
+// compareIDs returns an error if the two lists do not hold the same IDs.
+func compareIDs(what string, oldIDs, newIDs []string) error {
+	sort.Strings(oldIDs)
+	sort.Strings(newIDs)
+	if len(oldIDs) != len(newIDs) {
+		return fmt.Errorf("found %d %s, expected %d: %w", len(newIDs), what, len(oldIDs), define.ErrInternal)
+	}
+	for i := range oldIDs {
+		if oldIDs[i] != newIDs[i] {
+			return fmt.Errorf("%s do not match, %s is missing: %w", what, oldIDs[i], define.ErrInternal)
+		}
+	}
+	return nil
+}
+
+func ctrIDs(ctrs []*Container) []string {
+	ids := make([]string, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		ids = append(ids, ctr.ID())
+	}
+	return ids
+}
+
+func podIDs(pods []*Pod) []string {
+	ids := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		ids = append(ids, pod.ID())
+	}
+	return ids
+}
+
+func volumeNames(vols []*Volume) []string {
+	names := make([]string, 0, len(vols))
+	for _, vol := range vols {
+		names = append(names, vol.Name())
+	}
+	return names
+}
+
+func networkNames(networks map[string]types.PerNetworkOptions) []string {
+	names := make([]string, 0, len(networks))
+	for name := range networks {
+		names = append(names, name)
+	}
+	return names
+}
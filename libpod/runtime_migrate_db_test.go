@@ -0,0 +1,124 @@
+//go:build linux
+// +build linux
+
+package libpod
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/containers/common/libnetwork/types"
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCopyState(t *testing.T) {
+	oldState, oldPath, manager, err := getEmptyBoltState()
+	require.NoError(t, err)
+	defer os.RemoveAll(oldPath)
+	defer oldState.Close()
+
+	newState, newPath, _, err := getEmptyBoltState()
+	require.NoError(t, err)
+	defer os.RemoveAll(newPath)
+	defer newState.Close()
+
+	testPod, err := getTestPodN("4", manager)
+	require.NoError(t, err)
+	require.NoError(t, oldState.AddPod(testPod))
+
+	podCtr, err := getTestCtr2(manager)
+	require.NoError(t, err)
+	podCtr.config.Pod = testPod.ID()
+	require.NoError(t, oldState.AddContainerToPod(testPod, podCtr))
+
+	// Sorts after the container depending on it, so the copy has to order
+	// containers by their dependencies.
+	depCtr, err := getTestCtrN("3", manager)
+	require.NoError(t, err)
+	depCtr.config.NetMode = "bridge"
+	depCtr.config.Networks = map[string]types.PerNetworkOptions{
+		"podman": {InterfaceName: "eth0", Aliases: []string{"alias"}},
+	}
+	depCtr.state.ExecSessions = map[string]*ExecSession{
+		"abcd": {Id: "abcd", ContainerId: depCtr.ID()},
+	}
+	require.NoError(t, oldState.AddContainer(depCtr))
+	require.NoError(t, oldState.AddExecSession(depCtr, depCtr.state.ExecSessions["abcd"]))
+	require.NoError(t, oldState.AddContainerExitCode(depCtr.ID(), 42))
+
+	testCtr, err := getTestCtr1(manager)
+	require.NoError(t, err)
+	testCtr.config.IPCNsCtr = depCtr.ID()
+	require.NoError(t, oldState.AddContainer(testCtr))
+
+	require.NoError(t, checkStateEmpty(newState))
+	require.NoError(t, copyState(oldState, newState))
+	require.NoError(t, verifyStateCopy(oldState, newState))
+	assert.Error(t, checkStateEmpty(newState))
+
+	ctr, err := newState.Container(depCtr.ID())
+	require.NoError(t, err)
+	networks, err := newState.GetNetworks(ctr)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"alias", depCtr.ID()[:12]}, networks["podman"].Aliases)
+
+	sessions, err := newState.GetContainerExecSessions(ctr)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"abcd"}, sessions)
+
+	exitCode, err := newState.GetContainerExitCode(depCtr.ID())
+	require.NoError(t, err)
+	assert.Equal(t, int32(42), exitCode)
+
+	podCtrs, err := newState.PodContainersByID(testPod)
+	require.NoError(t, err)
+	assert.Equal(t, []string{podCtr.ID()}, podCtrs)
+
+	_, err = newState.GetContainerExitCode(testCtr.ID())
+	assert.ErrorIs(t, err, define.ErrNoSuchExitCode)
+}
+
+func TestSortByDependencies(t *testing.T) {
+	_, path, manager, err := getEmptyBoltState()
+	require.NoError(t, err)
+	defer os.RemoveAll(path)
+
+	ctr1, err := getTestCtr1(manager)
+	require.NoError(t, err)
+	ctr2, err := getTestCtr2(manager)
+	require.NoError(t, err)
+	ctr3, err := getTestCtrN("3", manager)
+	require.NoError(t, err)
+	ctr1.config.IPCNsCtr = ctr2.ID()
+	ctr2.config.NetNsCtr = ctr3.ID()
+
+	sorted, err := sortByDependencies([]*Container{ctr1, ctr2, ctr3})
+	require.NoError(t, err)
+	assert.Equal(t, []string{ctr3.ID(), ctr2.ID(), ctr1.ID()}, ctrIDs(sorted))
+
+	ctr3.config.PIDNsCtr = ctr1.ID()
+	_, err = sortByDependencies([]*Container{ctr1, ctr2, ctr3})
+	assert.Error(t, err)
+}
+
+func TestMoveStateDB(t *testing.T) {
+	dir := t.TempDir()
+	oldPath := filepath.Join(dir, "db.sql")
+	newPath := oldPath + ".bak"
+	for _, p := range []string{oldPath, oldPath + "-wal"} {
+		require.NoError(t, os.WriteFile(p, []byte(p), 0o600))
+	}
+
+	require.NoError(t, moveStateDB(oldPath, newPath))
+	for _, suffix := range []string{"", "-wal"} {
+		assert.NoFileExists(t, oldPath+suffix)
+		assert.FileExists(t, newPath+suffix)
+	}
+	assert.NoFileExists(t, newPath+"-shm")
+
+	// A missing database is an error
+	assert.Error(t, moveStateDB(oldPath, newPath))
+}
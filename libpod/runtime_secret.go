@@ -0,0 +1,157 @@
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/containers/common/pkg/secrets"
+	"github.com/containers/common/pkg/secrets/filedriver"
+	"github.com/containers/common/pkg/secrets/passdriver"
+	"github.com/containers/common/pkg/secrets/shelldriver"
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/storage/pkg/lockfile"
+	"github.com/sirupsen/logrus"
+)
+
+// maxSecretSize is the max size for secret data, it matches the limit
+// enforced by the secrets manager when creating a secret.
+const maxSecretSize = 512000
+
+// UpdateSecret replaces the data of the secret with the given name or ID.
+// The ID and metadata of the secret are kept so that containers referencing
+// the secret remain valid. Copies of the secret in the storage of containers
+// mounting it are refreshed as well; containers consuming the secret as
+// environment variable only see the new data after they are restarted.
+func (r *Runtime) UpdateSecret(nameOrID string, data []byte) (*secrets.Secret, error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	if !(len(data) > 0 && len(data) < maxSecretSize) {
+		return nil, fmt.Errorf("secret data must be larger than 0 and less than %d bytes: %w", maxSecretSize, define.ErrInvalidArg)
+	}
+
+	manager, err := r.SecretsManager()
+	if err != nil {
+		return nil, err
+	}
+	secr, err := manager.Lookup(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := secretsDriver(secr)
+	if err != nil {
+		return nil, err
+	}
+
+	// The secrets manager does not support replacing data, so take its
+	// lock ourselves while we swap the data in the driver.
+	lock, err := lockfile.GetLockFile(filepath.Join(r.GetSecretsStorageDir(), "secrets.lock"))
+	if err != nil {
+		return nil, err
+	}
+	if err := func() error {
+		lock.Lock()
+		defer lock.Unlock()
+
+		oldData, err := driver.Lookup(secr.ID)
+		if err != nil {
+			return fmt.Errorf("looking up data of secret %s: %w", secr.Name, err)
+		}
+		if err := driver.Delete(secr.ID); err != nil {
+			return fmt.Errorf("removing old data of secret %s: %w", secr.Name, err)
+		}
+		if err := driver.Store(secr.ID, data); err != nil {
+			if restoreErr := driver.Store(secr.ID, oldData); restoreErr != nil {
+				logrus.Errorf("Restoring old data of secret %s: %v", secr.Name, restoreErr)
+			}
+			return fmt.Errorf("storing new data of secret %s: %w", secr.Name, err)
+		}
+		return nil
+	}(); err != nil {
+		return nil, err
+	}
+
+	ctrs, err := r.SecretContainers(secr.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, ctr := range ctrs {
+		if err := ctr.refreshSecret(secr.ID); err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+				continue
+			}
+			return nil, fmt.Errorf("refreshing secret %s in container %s: %w", secr.Name, ctr.ID(), err)
+		}
+	}
+
+	return secr, nil
+}
+
+// SecretContainers returns all containers that mount the secret with the
+// given ID or consume it as environment variable.
+func (r *Runtime) SecretContainers(id string) ([]*Container, error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	return r.GetContainers(false, func(c *Container) bool {
+		return c.usesSecret(id)
+	})
+}
+
+// usesSecret returns whether the container references the secret with the
+// given ID. The secrets are part of the immutable config, so no lock is
+// required.
+func (c *Container) usesSecret(id string) bool {
+	for _, secr := range c.config.Secrets {
+		if secr.ID == id {
+			return true
+		}
+	}
+	for _, secr := range c.config.EnvSecrets {
+		if secr.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// refreshSecret copies the current data of the secret with the given ID to
+// the container's storage if the container mounts it.
+func (c *Container) refreshSecret(id string) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.syncContainer(); err != nil {
+		return err
+	}
+
+	for _, secr := range c.config.Secrets {
+		if secr.ID != id {
+			continue
+		}
+		if err := c.extractSecretToCtrStorage(secr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// secretsDriver returns the driver storing the data of the given secret.
+func secretsDriver(secr *secrets.Secret) (secrets.SecretsDriver, error) {
+	switch secr.Driver {
+	case "file":
+		if path, ok := secr.DriverOptions["path"]; ok {
+			return filedriver.NewDriver(path)
+		}
+		return nil, fmt.Errorf("need path for filedriver: %w", define.ErrInvalidArg)
+	case "pass":
+		return passdriver.NewDriver(secr.DriverOptions)
+	case "shell":
+		return shelldriver.NewDriver(secr.DriverOptions)
+	}
+	return nil, fmt.Errorf("unknown secrets driver %q: %w", secr.Driver, define.ErrInvalidArg)
+}
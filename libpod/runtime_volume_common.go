@@ -26,6 +26,10 @@ import (
 
 const volumeSuffix = "+volume"
 
+// defaultQuotaWarning is the percentage of the maximum size of a volume at
+// which a warning event is emitted if not set by the user.
+const defaultQuotaWarning = 90
+
 // NewVolume creates a new empty volume
 func (r *Runtime) NewVolume(ctx context.Context, options ...VolumeCreateOption) (*Volume, error) {
 	if !r.valid {
@@ -83,12 +87,15 @@ func (r *Runtime) newVolume(ctx context.Context, noCreatePluginVolume bool, opti
 						return nil, fmt.Errorf("invalid volume option %s for driver 'local': %w", key, err)
 					}
 				}
-			case "o", "type", "uid", "gid", "size", "inodes", "noquota", "copy", "nocopy":
+			case "o", "type", "uid", "gid", "size", "inodes", "noquota", "quota_warning", "copy", "nocopy":
 				// Do nothing, valid keys
 			default:
 				return nil, fmt.Errorf("invalid mount option %s for driver 'local': %w", key, define.ErrInvalidArg)
 			}
 		}
+		if volume.config.QuotaWarning > 0 && volume.config.Size == 0 {
+			return nil, fmt.Errorf("volume option quota_warning requires the size option: %w", define.ErrInvalidArg)
+		}
 	} else if volume.config.Driver == define.VolumeDriverImage && !volume.UsesVolumeDriver() {
 		logrus.Debugf("Creating image-based volume")
 		var imgString string
@@ -185,10 +192,20 @@ func (r *Runtime) newVolume(ctx context.Context, noCreatePluginVolume bool, opti
 			quota := quota.Quota{}
 			if volume.config.Size > 0 || volume.config.Inodes > 0 {
 				if !projectQuotaSupported {
-					return nil, errors.New("volume options size and inodes not supported. Filesystem does not support Project Quota")
+					// Volumes mounted from a device or filesystem
+					// cannot be backed by a loopback image.
+					if volume.needsMount() {
+						return nil, errors.New("volume options size and inodes not supported. Filesystem does not support Project Quota")
+					}
+					if err := volume.createQuotaImage(volPathRoot); err != nil {
+						return nil, fmt.Errorf("volume options size and inodes not supported. Filesystem does not support Project Quota and creating a loopback image failed: %w", err)
+					}
 				}
 				quota.Size = volume.config.Size
 				quota.Inodes = volume.config.Inodes
+				if volume.config.Size > 0 && volume.config.QuotaWarning == 0 {
+					volume.config.QuotaWarning = defaultQuotaWarning
+				}
 			}
 			if projectQuotaSupported {
 				if err := q.SetQuota(fullVolPath, quota); err != nil {
@@ -247,7 +264,7 @@ func (r *Runtime) UpdateVolumePlugins(ctx context.Context) *define.VolumeReload
 		allPluginVolumes = map[string]struct{}{}
 	)
 
-	for driverName, socket := range r.config.Engine.VolumePlugins {
+	for driverName, socket := range r.volumePlugins() {
 		driver, err := volplugin.GetVolumePlugin(driverName, socket, nil, r.config)
 		if err != nil {
 			errs = append(errs, err)
@@ -0,0 +1,200 @@
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/containers/podman/v4/libpod/define"
+	volplugin "github.com/containers/podman/v4/libpod/plugin"
+	"github.com/containers/storage/pkg/ioutils"
+	"github.com/containers/storage/pkg/lockfile"
+	"github.com/sirupsen/logrus"
+)
+
+// Contains the public Runtime API for volume plugins
+
+// volumePluginsStatePath is the file holding the discovered volume plugins
+// that were enabled.
+func (r *Runtime) volumePluginsStatePath() string {
+	return filepath.Join(r.config.Engine.StaticDir, "volume-plugins.json")
+}
+
+// readEnabledVolumePlugins returns the socket paths of the enabled discovered
+// volume plugins, keyed by plugin name.
+func (r *Runtime) readEnabledVolumePlugins() (map[string]string, error) {
+	enabled := make(map[string]string)
+	content, err := os.ReadFile(r.volumePluginsStatePath())
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return enabled, nil
+		}
+		return nil, err
+	}
+	if err := json.Unmarshal(content, &enabled); err != nil {
+		return nil, fmt.Errorf("unmarshalling enabled volume plugins: %w", err)
+	}
+	return enabled, nil
+}
+
+// updateEnabledVolumePlugins applies the update function to the enabled
+// discovered volume plugins and writes them back.
+func (r *Runtime) updateEnabledVolumePlugins(update func(enabled map[string]string) error) error {
+	lock, err := lockfile.GetLockFile(r.volumePluginsStatePath() + ".lock")
+	if err != nil {
+		return err
+	}
+	lock.Lock()
+	defer lock.Unlock()
+
+	enabled, err := r.readEnabledVolumePlugins()
+	if err != nil {
+		return err
+	}
+	if err := update(enabled); err != nil {
+		return err
+	}
+	content, err := json.Marshal(enabled)
+	if err != nil {
+		return err
+	}
+	return ioutils.AtomicWriteFile(r.volumePluginsStatePath(), content, 0600)
+}
+
+// volumePlugins returns the socket paths of all usable volume plugins, keyed
+// by plugin name. These are the plugins set in containers.conf and the
+// enabled discovered plugins.  Plugins set in containers.conf take precedence.
+func (r *Runtime) volumePlugins() map[string]string {
+	plugins, err := r.readEnabledVolumePlugins()
+	if err != nil {
+		logrus.Errorf("Reading enabled volume plugins: %v", err)
+		plugins = make(map[string]string)
+	}
+	for name, socket := range r.config.Engine.VolumePlugins {
+		plugins[name] = socket
+	}
+	return plugins
+}
+
+// VolumePlugins returns all volume plugins set in containers.conf, found in
+// the plugin directories or previously enabled, sorted by name.
+func (r *Runtime) VolumePlugins() ([]*define.VolumePlugin, error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+
+	discovered, err := volplugin.DiscoverPlugins()
+	if err != nil {
+		return nil, err
+	}
+	enabled, err := r.readEnabledVolumePlugins()
+	if err != nil {
+		return nil, err
+	}
+
+	plugins := make(map[string]*define.VolumePlugin)
+	for name, socket := range discovered {
+		plugins[name] = &define.VolumePlugin{Name: name, Socket: socket, Source: define.VolumePluginSourceDiscovered}
+	}
+	// A plugin enabled earlier might not be running right now.
+	for name, socket := range enabled {
+		plugins[name] = &define.VolumePlugin{Name: name, Socket: socket, Source: define.VolumePluginSourceDiscovered, Enabled: true}
+	}
+	for name, socket := range r.config.Engine.VolumePlugins {
+		plugins[name] = &define.VolumePlugin{Name: name, Socket: socket, Source: define.VolumePluginSourceConfig, Enabled: true}
+	}
+
+	list := make([]*define.VolumePlugin, 0, len(plugins))
+	for _, p := range plugins {
+		list = append(list, p)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Name < list[j].Name
+	})
+	return list, nil
+}
+
+// lookupVolumePlugin returns the volume plugin with the given name.
+func (r *Runtime) lookupVolumePlugin(name string) (*define.VolumePlugin, error) {
+	plugins, err := r.VolumePlugins()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range plugins {
+		if p.Name == name {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("no volume plugin with name %s found: %w", name, define.ErrMissingPlugin)
+}
+
+// VolumePlugin returns the volume plugin with the given name. The scope of
+// the plugin is queried from the plugin, which must therefore be running.
+func (r *Runtime) VolumePlugin(name string) (*define.VolumePlugin, error) {
+	p, err := r.lookupVolumePlugin(name)
+	if err != nil {
+		return nil, err
+	}
+	driver, err := volplugin.GetVolumePlugin(p.Name, p.Socket, nil, r.config)
+	if err != nil {
+		return nil, err
+	}
+	capabilities, err := driver.Capabilities()
+	if err != nil {
+		return nil, err
+	}
+	p.Scope = capabilities.Scope
+	return p, nil
+}
+
+// EnableVolumePlugin enables the discovered volume plugin with the given name
+// so it can be used as a volume driver.  The plugin must be running.
+func (r *Runtime) EnableVolumePlugin(name string) error {
+	p, err := r.lookupVolumePlugin(name)
+	if err != nil {
+		return err
+	}
+	if p.Enabled {
+		return nil
+	}
+	if _, err := volplugin.GetVolumePlugin(p.Name, p.Socket, nil, r.config); err != nil {
+		return err
+	}
+	return r.updateEnabledVolumePlugins(func(enabled map[string]string) error {
+		enabled[p.Name] = p.Socket
+		return nil
+	})
+}
+
+// DisableVolumePlugin disables the discovered volume plugin with the given
+// name.  Plugins set in containers.conf cannot be disabled, neither can
+// plugins still used by volumes.
+func (r *Runtime) DisableVolumePlugin(name string) error {
+	p, err := r.lookupVolumePlugin(name)
+	if err != nil {
+		return err
+	}
+	if p.Source == define.VolumePluginSourceConfig {
+		return fmt.Errorf("volume plugin %s is set in containers.conf and cannot be disabled: %w", name, define.ErrInvalidArg)
+	}
+	if !p.Enabled {
+		return nil
+	}
+
+	vols, err := r.state.AllVolumes()
+	if err != nil {
+		return err
+	}
+	for _, vol := range vols {
+		if vol.config.Driver == name {
+			return fmt.Errorf("volume plugin %s is used by volume %s: %w", name, vol.Name(), define.ErrVolumeBeingUsed)
+		}
+	}
+
+	return r.updateEnabledVolumePlugins(func(enabled map[string]string) error {
+		delete(enabled, name)
+		return nil
+	})
+}
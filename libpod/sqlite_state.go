@@ -39,8 +39,11 @@ const (
 	// Make sure that transactions happen exclusively.
 	sqliteOptionTXLock = "&_txlock=exclusive"
 
+	// Name of the database file.
+	sqliteDBFile = "db.sql"
+
 	// Assembled sqlite options used when opening the database.
-	sqliteOptions = "db.sql?" +
+	sqliteOptions = sqliteDBFile + "?" +
 		sqliteOptionLocation +
 		sqliteOptionSynchronous +
 		sqliteOptionForeignKeys +
@@ -51,10 +54,7 @@ const (
 func NewSqliteState(runtime *Runtime) (_ State, defErr error) {
 	state := new(SQLiteState)
 
-	basePath := runtime.storageConfig.GraphRoot
-	if runtime.storageConfig.TransientStore {
-		basePath = runtime.storageConfig.RunRoot
-	}
+	basePath := sqliteStateDir(runtime)
 
 	// c/storage is set up *after* the DB - so even though we use the c/s
 	// root (or, for transient, runroot) dir, we need to make the dir
@@ -86,6 +86,14 @@ func NewSqliteState(runtime *Runtime) (_ State, defErr error) {
 	return state, nil
 }
 
+// sqliteStateDir returns the directory holding the SQLite database.
+func sqliteStateDir(runtime *Runtime) string {
+	if runtime.storageConfig.TransientStore {
+		return runtime.storageConfig.RunRoot
+	}
+	return runtime.storageConfig.GraphRoot
+}
+
 // Close closes the state and prevents further use
 func (s *SQLiteState) Close() error {
 	if err := s.conn.Close(); err != nil {
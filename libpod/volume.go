@@ -53,6 +53,13 @@ type VolumeConfig struct {
 	Size uint64 `json:"size"`
 	// Inodes maximum of the volume.
 	Inodes uint64 `json:"inodes"`
+	// QuotaWarning is the percentage of Size at which a warning event is
+	// emitted. Zero disables the warning.
+	QuotaWarning uint `json:"quotaWarning,omitempty"`
+	// QuotaImage is the path of the loopback image limiting the size of
+	// the volume. It is only used if the filesystem does not support
+	// project quotas.
+	QuotaImage string `json:"quotaImage,omitempty"`
 	// DisableQuota indicates that the volume should completely disable using any
 	// quota tracking.
 	DisableQuota bool `json:"disableQuota,omitempty"`
@@ -69,6 +76,17 @@ type VolumeConfig struct {
 	StorageImageID string `json:"storageImageID,omitempty"`
 	// MountLabel is the SELinux label to assign to mount points
 	MountLabel string `json:"mountlabel,omitempty"`
+	// Snapshots are the snapshots of the volume's data, ordered by
+	// creation time.
+	Snapshots []VolumeSnapshot `json:"snapshots,omitempty"`
+}
+
+// VolumeSnapshot is a point-in-time copy of the data of a volume.
+type VolumeSnapshot struct {
+	// Name of the snapshot, unique per volume.
+	Name string `json:"name"`
+	// Time the snapshot was created.
+	CreatedTime time.Time `json:"createdAt"`
 }
 
 // VolumeState holds the volume's mutable state.
@@ -100,6 +118,15 @@ type VolumeState struct {
 	UIDChowned int `json:"uidChowned,omitempty"`
 	// GIDChowned is the GID the volume was chowned to.
 	GIDChowned int `json:"gidChowned,omitempty"`
+	// UsedBytes is the number of bytes used by a volume backed by a
+	// loopback image when it was last unmounted.
+	UsedBytes uint64 `json:"usedBytes,omitempty"`
+	// UsedInodes is the number of inodes used by a volume backed by a
+	// loopback image when it was last unmounted.
+	UsedInodes uint64 `json:"usedInodes,omitempty"`
+	// QuotaWarned indicates that a quota warning event was emitted for the
+	// volume, it is reset once the usage drops below the threshold.
+	QuotaWarned bool `json:"quotaWarned,omitempty"`
 }
 
 // Name retrieves the volume's name
@@ -264,7 +291,7 @@ func (v *Volume) IsDangling() (bool, error) {
 // mounting.
 func (v *Volume) UsesVolumeDriver() bool {
 	if v.config.Driver == define.VolumeDriverImage {
-		if _, ok := v.runtime.config.Engine.VolumePlugins[v.config.Driver]; ok {
+		if _, ok := v.runtime.volumePlugins()[v.config.Driver]; ok {
 			return true
 		}
 		return false
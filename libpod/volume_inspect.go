@@ -66,6 +66,23 @@ func (v *Volume) Inspect() (*define.InspectVolumeData, error) {
 	data.NeedsChown = v.state.NeedsChown
 	data.StorageID = v.config.StorageID
 	data.LockNumber = v.lock.ID()
+	data.SizeLimit = v.config.Size
+	data.InodesLimit = v.config.Inodes
+	data.QuotaWarning = v.config.QuotaWarning
+	usage, err := v.usage()
+	if err != nil {
+		return nil, err
+	}
+	if usage != nil {
+		data.UsedBytes = uint64(usage.Size)
+		data.UsedInodes = uint64(usage.InodeCount)
+	}
+	for _, snapshot := range v.config.Snapshots {
+		data.Snapshots = append(data.Snapshots, define.InspectVolumeSnapshot{
+			Name:      snapshot.Name,
+			CreatedAt: snapshot.CreatedTime,
+		})
+	}
 
 	if v.config.Timeout != nil {
 		data.Timeout = *v.config.Timeout
@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 
 	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/libpod/events"
 )
 
 // Creates a new volume
@@ -44,6 +45,12 @@ func (v *Volume) needsMount() bool {
 		return true
 	}
 
+	// Volumes exceeding the size of a project quota are backed by a
+	// loopback image.
+	if v.config.QuotaImage != "" {
+		return true
+	}
+
 	// Commit 28138dafcc added the UID and GID options to this map
 	// However we should only mount when options other than uid and gid are set.
 	// see https://github.com/containers/podman/issues/10620
@@ -57,9 +64,15 @@ func (v *Volume) needsMount() bool {
 	if _, ok := v.config.Options["SIZE"]; ok {
 		index++
 	}
+	if _, ok := v.config.Options["INODES"]; ok {
+		index++
+	}
 	if _, ok := v.config.Options["NOQUOTA"]; ok {
 		index++
 	}
+	if _, ok := v.config.Options["QUOTA_WARNING"]; ok {
+		index++
+	}
 	if _, ok := v.config.Options["nocopy"]; ok {
 		index++
 	}
@@ -68,7 +81,7 @@ func (v *Volume) needsMount() bool {
 	}
 	// when uid or gid is set there is also the "o" option
 	// set so we have to ignore this one as well
-	if index > 0 {
+	if _, ok := v.config.Options["o"]; ok && index > 0 {
 		index++
 	}
 	// Local driver with options other than uid,gid needs mount
@@ -109,3 +122,34 @@ func resetVolumeState(state *VolumeState) {
 	state.MountCount = 0
 	state.MountPoint = ""
 }
+
+// checkQuotaWarning emits a warning event if the usage of the volume reached
+// the configured percentage of its maximum size. The event is emitted once,
+// until the usage drops below the threshold again. It is only called when a
+// container using the volume exits, writes of running containers are not
+// observed.
+// Must be called with the volume locked.
+func (v *Volume) checkQuotaWarning() error {
+	if v.config.Size == 0 || v.config.QuotaWarning == 0 {
+		return nil
+	}
+	if err := v.update(); err != nil {
+		return err
+	}
+	usage, err := v.usage()
+	if err != nil || usage == nil {
+		return err
+	}
+	reached := uint64(usage.Size)*100 >= v.config.Size*uint64(v.config.QuotaWarning)
+	if reached == v.state.QuotaWarned {
+		return nil
+	}
+	v.state.QuotaWarned = reached
+	if err := v.save(); err != nil {
+		return err
+	}
+	if reached {
+		v.newVolumeEvent(events.QuotaWarning)
+	}
+	return nil
+}
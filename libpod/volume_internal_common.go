@@ -81,6 +81,14 @@ func (v *Volume) mount() error {
 	volType := v.config.Options["type"]
 	volOptions := v.config.Options["o"]
 
+	// The options of volumes backed by a loopback image only hold the
+	// size and inodes limits of the image.
+	if v.config.QuotaImage != "" {
+		volDevice = v.config.QuotaImage
+		volType = "ext4"
+		volOptions = "loop"
+	}
+
 	// Some filesystems (tmpfs) don't have a device, but we still need to
 	// give the kernel something.
 	if volDevice == "" && volType != "" {
@@ -179,6 +187,14 @@ func (v *Volume) unmount(force bool) error {
 			return v.save()
 		}
 
+		// The usage of a loopback image cannot be determined while it is
+		// not mounted, record it for inspect.
+		if v.config.QuotaImage != "" {
+			if err := v.recordImageUsage(); err != nil {
+				logrus.Errorf("Recording usage of volume %s: %v", v.Name(), err)
+			}
+		}
+
 		// Unmount the volume
 		if err := detachUnmount(v.config.MountPoint); err != nil {
 			if err == unix.EINVAL {
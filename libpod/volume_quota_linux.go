@@ -0,0 +1,155 @@
+package libpod
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman/v4/pkg/rootless"
+	"github.com/containers/storage/pkg/directory"
+	"github.com/containers/storage/pkg/idtools"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+// createQuotaImage creates an ext4 image of the maximum size of the volume in
+// the volume directory. It is mounted on the data directory of the volume to
+// limit its size if the filesystem does not support project quotas.
+func (v *Volume) createQuotaImage(volPathRoot string) (deferredErr error) {
+	if v.config.Size == 0 {
+		return errors.New("a loopback image requires the size option")
+	}
+	if rootless.IsRootless() {
+		return errors.New("loopback images cannot be mounted rootless")
+	}
+	mkfsPath, err := exec.LookPath("mkfs.ext4")
+	if err != nil {
+		return fmt.Errorf("locating 'mkfs.ext4' binary: %w", err)
+	}
+	mountPath, err := exec.LookPath("mount")
+	if err != nil {
+		return fmt.Errorf("locating 'mount' binary: %w", err)
+	}
+
+	image := filepath.Join(volPathRoot, "quota.img")
+	f, err := os.OpenFile(image, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return fmt.Errorf("creating loopback image: %w", err)
+	}
+	defer func() {
+		if deferredErr != nil {
+			if err := os.Remove(image); err != nil {
+				logrus.Errorf("Removing loopback image %s: %v", image, err)
+			}
+		}
+	}()
+	// The image is sparse, it only takes up the space used by the volume.
+	err = f.Truncate(int64(v.config.Size))
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("sizing loopback image: %w", err)
+	}
+
+	// Do not reserve blocks for root, the whole size is usable by the
+	// volume.
+	mkfsArgs := []string{"-q", "-F", "-m", "0"}
+	if v.config.Inodes > 0 {
+		mkfsArgs = append(mkfsArgs, "-N", strconv.FormatUint(v.config.Inodes, 10))
+	}
+	mkfsArgs = append(mkfsArgs, image)
+	logrus.Debugf("Running mkfs command: %s %s", mkfsPath, strings.Join(mkfsArgs, " "))
+	if output, err := exec.Command(mkfsPath, mkfsArgs...).CombinedOutput(); err != nil {
+		return fmt.Errorf("formatting loopback image: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	// Mount the image once to prepare its root directory the same way as
+	// the data directory of other volumes.
+	mountPoint := filepath.Join(volPathRoot, "_data")
+	if output, err := exec.Command(mountPath, "-t", "ext4", "-o", "loop", image, mountPoint).CombinedOutput(); err != nil {
+		return fmt.Errorf("mounting loopback image: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+	err = prepareQuotaImage(mountPoint, v.config.UID, v.config.GID, v.config.MountLabel)
+	if unmountErr := unix.Unmount(mountPoint, 0); unmountErr != nil {
+		if err == nil {
+			err = fmt.Errorf("unmounting loopback image: %w", unmountErr)
+		} else {
+			logrus.Errorf("Unmounting loopback image %s: %v", image, unmountErr)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	v.config.QuotaImage = image
+	return nil
+}
+
+// prepareQuotaImage removes the lost+found directory created by mkfs from the
+// mounted image and sets the owner and label of its root directory.
+func prepareQuotaImage(mountPoint string, uid, gid int, mountLabel string) error {
+	if err := os.Remove(filepath.Join(mountPoint, "lost+found")); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing lost+found from loopback image: %w", err)
+	}
+	if err := idtools.SafeChown(mountPoint, uid, gid); err != nil {
+		return fmt.Errorf("chowning loopback image to %d:%d: %w", uid, gid, err)
+	}
+	return LabelVolumePath(mountPoint, mountLabel)
+}
+
+// usage returns the disk usage of a volume with a size or inodes limit
+// without walking the volume. On XFS, statfs reports the project quota of the
+// data directory. Volumes backed by a loopback image report the usage of the
+// mounted image, or the usage recorded on unmount if it is not mounted.
+// Returns nil if the usage is unknown.
+// Must be called with the volume locked.
+func (v *Volume) usage() (*directory.DiskUsage, error) {
+	if v.config.Size == 0 && v.config.Inodes == 0 {
+		return nil, nil
+	}
+	if v.config.QuotaImage != "" && v.state.MountCount == 0 {
+		return &directory.DiskUsage{
+			Size:       int64(v.state.UsedBytes),
+			InodeCount: int64(v.state.UsedInodes),
+		}, nil
+	}
+
+	var st unix.Statfs_t
+	if err := unix.Statfs(v.config.MountPoint, &st); err != nil {
+		return nil, fmt.Errorf("retrieving usage of volume %s: %w", v.Name(), err)
+	}
+	// Without a quota statfs reports the whole filesystem, which is
+	// larger than the limits of the volume.
+	usage := new(directory.DiskUsage)
+	known := false
+	if v.config.QuotaImage != "" || (v.config.Size > 0 && st.Blocks*uint64(st.Bsize) <= v.config.Size) {
+		usage.Size = int64((st.Blocks - st.Bfree) * uint64(st.Bsize))
+		known = true
+	}
+	if v.config.QuotaImage != "" || (v.config.Inodes > 0 && st.Files <= v.config.Inodes) {
+		usage.InodeCount = int64(st.Files - st.Ffree)
+		known = true
+	}
+	if !known {
+		return nil, nil
+	}
+	return usage, nil
+}
+
+// recordImageUsage stores the usage of the mounted loopback image of the
+// volume in its state, as usage cannot determine it once it is unmounted.
+// Must be called with the volume locked, the state is saved by the caller.
+func (v *Volume) recordImageUsage() error {
+	var st unix.Statfs_t
+	if err := unix.Statfs(v.config.MountPoint, &st); err != nil {
+		return err
+	}
+	v.state.UsedBytes = (st.Blocks - st.Bfree) * uint64(st.Bsize)
+	v.state.UsedInodes = st.Files - st.Ffree
+	return nil
+}
@@ -0,0 +1,28 @@
+//go:build !linux
+// +build !linux
+
+package libpod
+
+import (
+	"errors"
+
+	"github.com/containers/storage/pkg/directory"
+)
+
+// createQuotaImage creates a loopback image limiting the size of the volume.
+// Loopback images are only supported on Linux.
+func (v *Volume) createQuotaImage(volPathRoot string) error {
+	return errors.New("loopback images are not supported on this platform")
+}
+
+// usage returns the disk usage of the volume without walking it. It is never
+// known on this platform.
+func (v *Volume) usage() (*directory.DiskUsage, error) {
+	return nil, nil
+}
+
+// recordImageUsage stores the usage of the mounted loopback image of the
+// volume in its state.
+func (v *Volume) recordImageUsage() error {
+	return errors.New("loopback images are not supported on this platform")
+}
@@ -0,0 +1,304 @@
+package libpod
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/libpod/events"
+	dircopy "github.com/containers/storage/drivers/copy"
+	"github.com/containers/storage/pkg/stringid"
+	"github.com/sirupsen/logrus"
+)
+
+// CloneVolume creates a new volume with the given name holding a copy of the
+// data of the source volume. Labels and options of the source volume are
+// copied as well, its snapshots are not.
+func (r *Runtime) CloneVolume(ctx context.Context, src *Volume, name string) (_ *Volume, deferredErr error) {
+	if !r.valid {
+		return nil, define.ErrRuntimeStopped
+	}
+	if !src.valid {
+		return nil, define.ErrVolumeRemoved
+	}
+
+	src.lock.Lock()
+	defer src.lock.Unlock()
+
+	if err := src.update(); err != nil {
+		return nil, err
+	}
+	if err := src.canCopyData(); err != nil {
+		return nil, err
+	}
+
+	options := []VolumeCreateOption{
+		WithVolumeName(name),
+		WithVolumeDriver(src.config.Driver),
+		WithVolumeLabels(src.config.Labels),
+		WithVolumeOptions(src.config.Options),
+		WithVolumeUID(src.config.UID),
+		WithVolumeGID(src.config.GID),
+		WithVolumeSize(src.config.Size),
+		WithVolumeInodes(src.config.Inodes),
+		WithVolumeMountLabel(src.config.MountLabel),
+		func(volume *Volume) error {
+			volume.state.NeedsCopyUp = src.state.NeedsCopyUp
+			volume.state.NeedsChown = src.state.NeedsChown
+			return nil
+		},
+	}
+	if src.config.DisableQuota {
+		options = append(options, WithVolumeDisableQuota())
+	}
+	if src.config.QuotaWarning > 0 {
+		options = append(options, WithVolumeQuotaWarning(src.config.QuotaWarning))
+	}
+	vol, err := r.NewVolume(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if deferredErr != nil {
+			if err := r.RemoveVolume(ctx, vol, true, nil); err != nil {
+				logrus.Errorf("Removing volume %s after failed clone: %v", vol.Name(), err)
+			}
+		}
+	}()
+
+	vol.lock.Lock()
+	defer vol.lock.Unlock()
+
+	err = src.withDataMounted(func() error {
+		return vol.withDataMounted(func() error {
+			return copyVolumeData(src.config.MountPoint, vol.config.MountPoint)
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("copying data of volume %s to volume %s: %w", src.Name(), vol.Name(), err)
+	}
+	return vol, nil
+}
+
+// Snapshots returns the snapshots of the volume.
+func (v *Volume) Snapshots() ([]VolumeSnapshot, error) {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if err := v.update(); err != nil {
+		return nil, err
+	}
+	snapshots := make([]VolumeSnapshot, len(v.config.Snapshots))
+	copy(snapshots, v.config.Snapshots)
+	return snapshots, nil
+}
+
+// CreateSnapshot copies the data of the volume into a new snapshot. A random
+// name is generated if name is empty.
+func (v *Volume) CreateSnapshot(name string) (*VolumeSnapshot, error) {
+	if name == "" {
+		name = stringid.TruncateID(stringid.GenerateRandomID())
+	} else if !define.NameRegex.MatchString(name) {
+		return nil, define.RegexError
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if err := v.update(); err != nil {
+		return nil, err
+	}
+	if err := v.canCopyData(); err != nil {
+		return nil, err
+	}
+	if v.snapshotIndex(name) >= 0 {
+		return nil, fmt.Errorf("volume %s already has a snapshot named %s: %w", v.Name(), name, define.ErrVolumeSnapshotExists)
+	}
+
+	// Remove leftovers of a snapshot that was not added to the config.
+	path := v.snapshotPath(name)
+	if err := os.RemoveAll(path); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("creating snapshot directory of volume %s: %w", v.Name(), err)
+	}
+	err := v.withDataMounted(func() error {
+		return copyVolumeData(v.config.MountPoint, path)
+	})
+	if err != nil {
+		if rmErr := os.RemoveAll(path); rmErr != nil {
+			logrus.Errorf("Removing incomplete snapshot %s of volume %s: %v", name, v.Name(), rmErr)
+		}
+		return nil, fmt.Errorf("copying data of volume %s to snapshot %s: %w", v.Name(), name, err)
+	}
+
+	snapshot := VolumeSnapshot{Name: name, CreatedTime: time.Now()}
+	newConfig := new(VolumeConfig)
+	if err := JSONDeepCopy(v.config, newConfig); err != nil {
+		return nil, err
+	}
+	newConfig.Snapshots = append(newConfig.Snapshots, snapshot)
+	if err := v.runtime.state.RewriteVolumeConfig(v, newConfig); err != nil {
+		if rmErr := os.RemoveAll(path); rmErr != nil {
+			logrus.Errorf("Removing snapshot %s of volume %s: %v", name, v.Name(), rmErr)
+		}
+		return nil, fmt.Errorf("adding snapshot %s to volume %s: %w", name, v.Name(), err)
+	}
+	v.config = newConfig
+	return &snapshot, nil
+}
+
+// RestoreSnapshot replaces the data of the volume with the data of the given
+// snapshot. The volume must not be used by a running container.
+func (v *Volume) RestoreSnapshot(name string) error {
+	// Check the containers before taking the volume lock, containers lock
+	// their volumes when they are started.
+	ctrs, err := v.VolumeInUse()
+	if err != nil {
+		return err
+	}
+	for _, id := range ctrs {
+		ctr, err := v.runtime.state.Container(id)
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) {
+				continue
+			}
+			return err
+		}
+		state, err := ctr.State()
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+				continue
+			}
+			return err
+		}
+		switch state {
+		case define.ContainerStateRunning, define.ContainerStatePaused, define.ContainerStateStopping:
+			return fmt.Errorf("volume %s is being used by running container %s: %w", v.Name(), ctr.ID(), define.ErrVolumeBeingUsed)
+		}
+	}
+
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if err := v.update(); err != nil {
+		return err
+	}
+	if err := v.canCopyData(); err != nil {
+		return err
+	}
+	if v.snapshotIndex(name) < 0 {
+		return fmt.Errorf("volume %s has no snapshot named %s: %w", v.Name(), name, define.ErrNoSuchVolumeSnapshot)
+	}
+
+	err = v.withDataMounted(func() error {
+		// Empty the data directory instead of replacing it, to keep
+		// the quota, ownership and label of the directory.
+		entries, err := os.ReadDir(v.config.MountPoint)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err := os.RemoveAll(filepath.Join(v.config.MountPoint, entry.Name())); err != nil {
+				return fmt.Errorf("removing data of volume %s: %w", v.Name(), err)
+			}
+		}
+		return copyVolumeData(v.snapshotPath(name), v.config.MountPoint)
+	})
+	if err != nil {
+		return fmt.Errorf("restoring snapshot %s of volume %s: %w", name, v.Name(), err)
+	}
+
+	defer v.newVolumeEvent(events.Restore)
+	return nil
+}
+
+// RemoveSnapshot removes the given snapshot of the volume.
+func (v *Volume) RemoveSnapshot(name string) error {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if err := v.update(); err != nil {
+		return err
+	}
+	index := v.snapshotIndex(name)
+	if index < 0 {
+		return fmt.Errorf("volume %s has no snapshot named %s: %w", v.Name(), name, define.ErrNoSuchVolumeSnapshot)
+	}
+
+	newConfig := new(VolumeConfig)
+	if err := JSONDeepCopy(v.config, newConfig); err != nil {
+		return err
+	}
+	newConfig.Snapshots = append(newConfig.Snapshots[:index], newConfig.Snapshots[index+1:]...)
+	if err := v.runtime.state.RewriteVolumeConfig(v, newConfig); err != nil {
+		return fmt.Errorf("removing snapshot %s from volume %s: %w", name, v.Name(), err)
+	}
+	v.config = newConfig
+
+	if err := os.RemoveAll(v.snapshotPath(name)); err != nil {
+		return fmt.Errorf("removing data of snapshot %s of volume %s: %w", name, v.Name(), err)
+	}
+	return nil
+}
+
+// canCopyData returns an error if the data of the volume cannot be copied.
+// Only volumes of the local driver which are not mounted from a device or
+// filesystem store their data in the volume directory or in their loopback
+// image.
+func (v *Volume) canCopyData() error {
+	if v.needsMount() && v.config.QuotaImage == "" {
+		return fmt.Errorf("volume %s: only volumes of the local driver without mount options can be cloned or snapshotted: %w", v.Name(), define.ErrInvalidArg)
+	}
+	return nil
+}
+
+// withDataMounted runs fn with the loopback image of the volume mounted on its
+// data directory, if the volume is backed by one.
+// Must be called with the volume locked.
+func (v *Volume) withDataMounted(fn func() error) (deferredErr error) {
+	if v.config.QuotaImage == "" {
+		return fn()
+	}
+	if err := v.mount(); err != nil {
+		return err
+	}
+	defer func() {
+		if err := v.unmount(false); err != nil {
+			if deferredErr == nil {
+				deferredErr = err
+			} else {
+				logrus.Errorf("Unmounting volume %s: %v", v.Name(), err)
+			}
+		}
+	}()
+	return fn()
+}
+
+// snapshotIndex returns the index of the snapshot with the given name or -1
+// if the volume has no such snapshot.
+func (v *Volume) snapshotIndex(name string) int {
+	for i, snapshot := range v.config.Snapshots {
+		if snapshot.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// snapshotPath returns the directory holding the data of the snapshot. It is
+// next to the data directory, so it is removed together with the volume.
+func (v *Volume) snapshotPath(name string) string {
+	return filepath.Join(v.runtime.config.Engine.VolumePath, v.Name(), "snapshots", name)
+}
+
+// copyVolumeData copies the content of the src directory into dst. Files are
+// cloned with reflinks on filesystems supporting them and copied otherwise.
+func copyVolumeData(src, dst string) error {
+	return dircopy.DirCopy(src, dst, dircopy.Content, true)
+}
@@ -0,0 +1,132 @@
+package compat
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/containers/podman/v4/libpod"
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/pkg/api/handlers/utils"
+	api "github.com/containers/podman/v4/pkg/api/types"
+	"github.com/containers/podman/v4/pkg/util"
+	docker_api_types "github.com/docker/docker/api/types"
+)
+
+// volumeDriverInterface is the interface implemented by volume plugins.
+var volumeDriverInterface = docker_api_types.PluginInterfaceType{
+	Capability: "volumedriver",
+	Prefix:     "docker",
+	Version:    "1.0",
+}
+
+// pluginFromVolumePlugin converts a volume plugin into the Docker plugin
+// representation.
+func pluginFromVolumePlugin(p *define.VolumePlugin) *docker_api_types.Plugin {
+	return &docker_api_types.Plugin{
+		ID:      p.Name,
+		Name:    p.Name,
+		Enabled: p.Enabled,
+		Config: docker_api_types.PluginConfig{
+			Description: "Volume plugin",
+			Interface: docker_api_types.PluginConfigInterface{
+				Socket: p.Socket,
+				Types:  []docker_api_types.PluginInterfaceType{volumeDriverInterface},
+			},
+		},
+	}
+}
+
+func pluginError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, define.ErrMissingPlugin):
+		utils.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, define.ErrVolumeBeingUsed):
+		utils.Error(w, http.StatusConflict, err)
+	case errors.Is(err, define.ErrInvalidArg):
+		utils.Error(w, http.StatusBadRequest, err)
+	default:
+		utils.InternalServerError(w, err)
+	}
+}
+
+func ListPlugins(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	filtersMap, err := util.PrepareFilters(r)
+	if err != nil {
+		utils.Error(w, http.StatusInternalServerError,
+			fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	plugins, err := runtime.VolumePlugins()
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+
+	list := make([]*docker_api_types.Plugin, 0, len(plugins))
+	for _, p := range plugins {
+		include := true
+		for filter, values := range *filtersMap {
+			for _, value := range values {
+				switch filter {
+				case "capability":
+					include = include && value == volumeDriverInterface.Capability
+				case "enable":
+					enabled, err := strconv.ParseBool(value)
+					if err != nil {
+						utils.Error(w, http.StatusBadRequest, fmt.Errorf("invalid filter %q=%q: %w", filter, value, err))
+						return
+					}
+					include = include && p.Enabled == enabled
+				default:
+					utils.Error(w, http.StatusBadRequest, fmt.Errorf("invalid filter %q", filter))
+					return
+				}
+			}
+		}
+		if include {
+			list = append(list, pluginFromVolumePlugin(p))
+		}
+	}
+	utils.WriteResponse(w, http.StatusOK, list)
+}
+
+func InspectPlugin(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	name := utils.GetName(r)
+
+	plugins, err := runtime.VolumePlugins()
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	for _, p := range plugins {
+		if p.Name == name {
+			utils.WriteResponse(w, http.StatusOK, pluginFromVolumePlugin(p))
+			return
+		}
+	}
+	utils.Error(w, http.StatusNotFound, fmt.Errorf("no volume plugin with name %s found: %w", name, define.ErrMissingPlugin))
+}
+
+func EnablePlugin(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	if err := runtime.EnableVolumePlugin(utils.GetName(r)); err != nil {
+		pluginError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, nil)
+}
+
+func DisablePlugin(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	if err := runtime.DisableVolumePlugin(utils.GetName(r)); err != nil {
+		pluginError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, nil)
+}
@@ -1,24 +1,29 @@
 package libpod
 
 import (
+	"archive/tar"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
+	"path/filepath"
 	"strings"
 
+	"github.com/containers/image/v5/manifest"
 	"github.com/containers/podman/v4/libpod"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/api/handlers"
 	"github.com/containers/podman/v4/pkg/api/handlers/compat"
 	"github.com/containers/podman/v4/pkg/api/handlers/utils"
 	api "github.com/containers/podman/v4/pkg/api/types"
+	"github.com/containers/podman/v4/pkg/autoupdate"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/containers/podman/v4/pkg/domain/infra/abi"
+	"github.com/containers/podman/v4/pkg/specgen"
 	"github.com/containers/podman/v4/pkg/util"
 	"github.com/gorilla/schema"
-	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
 )
 
@@ -301,6 +306,7 @@ func Restore(w http.ResponseWriter, r *http.Request) {
 		Keep            bool   `schema:"keep"`
 		TCPEstablished  bool   `schema:"tcpEstablished"`
 		Import          bool   `schema:"import"`
+		ImportPrevious  bool   `schema:"importPrevious"`
 		Name            string `schema:"name"`
 		IgnoreRootFS    bool   `schema:"ignoreRootFS"`
 		IgnoreVolumes   bool   `schema:"ignoreVolumes"`
@@ -333,7 +339,26 @@ func Restore(w http.ResponseWriter, r *http.Request) {
 	}
 
 	var names []string
-	if query.Import {
+	switch {
+	case query.Import && query.ImportPrevious:
+		dir, err := os.MkdirTemp("", "restore")
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		defer os.RemoveAll(dir)
+		archives, err := saveArchivesFromBody(dir, r)
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		if len(archives) == 0 {
+			utils.Error(w, http.StatusBadRequest, errors.New("no checkpoint archive found in body"))
+			return
+		}
+		options.ImportPrevious = archives[:len(archives)-1]
+		options.Import = archives[len(archives)-1]
+	case query.Import:
 		t, err := os.CreateTemp("", "restore")
 		if err != nil {
 			utils.InternalServerError(w, err)
@@ -345,7 +370,7 @@ func Restore(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 		options.Import = t.Name()
-	} else {
+	default:
 		name := utils.GetName(r)
 		if _, err := runtime.LookupContainer(name); err != nil {
 			// If container was not found, check if this is a checkpoint image
@@ -379,6 +404,39 @@ func Restore(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, http.StatusOK, reports[0])
 }
 
+// saveArchivesFromBody writes each file of the tar archive in the body to the
+// given directory and returns their paths in the order of the archive.
+func saveArchivesFromBody(dir string, r *http.Request) ([]string, error) {
+	var archives []string
+	tr := tar.NewReader(r.Body)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return archives, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		// Do not trust the names in the archive.
+		path := filepath.Join(dir, fmt.Sprintf("%d.tar", len(archives)))
+		f, err := os.Create(path)
+		if err != nil {
+			return nil, err
+		}
+		_, err = io.Copy(f, tr)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return nil, err
+		}
+		archives = append(archives, path)
+	}
+}
+
 func InitContainer(w http.ResponseWriter, r *http.Request) {
 	name := utils.GetName(r)
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
@@ -402,23 +460,136 @@ func InitContainer(w http.ResponseWriter, r *http.Request) {
 func UpdateContainer(w http.ResponseWriter, r *http.Request) {
 	name := utils.GetName(r)
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
-	ctr, err := runtime.LookupContainer(name)
-	if err != nil {
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		RestartPolicy    string `schema:"restartPolicy"`
+		RestartRetries   *uint  `schema:"restartRetries"`
+		HealthConfig     string `schema:"healthConfig"`
+		HealthOnFailure  string `schema:"healthOnFailure"`
+		Labels           string `schema:"labels"`
+		AutoUpdatePolicy string `schema:"autoUpdatePolicy"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	if _, err := runtime.LookupContainer(name); err != nil {
 		utils.ContainerNotFound(w, name, err)
 		return
 	}
 
-	options := &handlers.UpdateEntities{Resources: &specs.LinuxResources{}}
-	if err := json.NewDecoder(r.Body).Decode(&options.Resources); err != nil {
-		utils.Error(w, http.StatusInternalServerError, fmt.Errorf("decode(): %w", err))
+	options := &entities.ContainerUpdateOptions{
+		NameOrID:         name,
+		RestartPolicy:    query.RestartPolicy,
+		RestartRetries:   query.RestartRetries,
+		HealthOnFailure:  query.HealthOnFailure,
+		AutoUpdatePolicy: query.AutoUpdatePolicy,
+	}
+	if query.HealthConfig != "" {
+		options.HealthConfig = new(manifest.Schema2HealthConfig)
+		if err := json.Unmarshal([]byte(query.HealthConfig), options.HealthConfig); err != nil {
+			utils.BadRequest(w, "healthConfig", query.HealthConfig, err)
+			return
+		}
+	}
+	if query.Labels != "" {
+		if err := json.Unmarshal([]byte(query.Labels), &options.Labels); err != nil {
+			utils.BadRequest(w, "labels", query.Labels, err)
+			return
+		}
+	}
+	if _, err := define.ParseHealthCheckOnFailureAction(query.HealthOnFailure); err != nil {
+		utils.BadRequest(w, "healthOnFailure", query.HealthOnFailure, err)
 		return
 	}
-	err = ctr.Update(options.Resources)
+	if query.AutoUpdatePolicy != "" {
+		if _, err := autoupdate.LookupPolicy(query.AutoUpdatePolicy); err != nil {
+			utils.BadRequest(w, "autoUpdatePolicy", query.AutoUpdatePolicy, err)
+			return
+		}
+	}
+
+	body := &handlers.UpdateEntities{}
+	if err := json.NewDecoder(r.Body).Decode(body); err != nil {
+		// An empty body does not change any resources.
+		if !errors.Is(err, io.EOF) {
+			utils.Error(w, http.StatusInternalServerError, fmt.Errorf("decode(): %w", err))
+			return
+		}
+	} else {
+		options.Specgen = &specgen.SpecGenerator{}
+		options.Specgen.ResourceLimits = &body.LinuxResources
+	}
+
+	// Now use the ABI implementation to prevent us from having duplicate
+	// code.
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	id, err := containerEngine.ContainerUpdate(r.Context(), options)
 	if err != nil {
+		if errors.Is(err, define.ErrInvalidArg) {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
 		utils.InternalServerError(w, err)
 		return
 	}
-	utils.WriteResponse(w, http.StatusCreated, ctr.ID())
+	utils.WriteResponse(w, http.StatusCreated, id)
+}
+
+// portUpdateError writes the error of adding or removing container ports.
+func portUpdateError(w http.ResponseWriter, name string, err error) {
+	switch {
+	case errors.Is(err, define.ErrNoSuchCtr):
+		utils.ContainerNotFound(w, name, err)
+	case errors.Is(err, define.ErrInvalidArg):
+		utils.Error(w, http.StatusBadRequest, err)
+	case errors.Is(err, define.ErrNetworkModeInvalid), errors.Is(err, define.ErrCtrStateInvalid):
+		utils.Error(w, http.StatusConflict, err)
+	default:
+		utils.InternalServerError(w, err)
+	}
+}
+
+func AddContainerPorts(w http.ResponseWriter, r *http.Request) {
+	name := utils.GetName(r)
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		Ports []string `schema:"ports"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	report, err := containerEngine.ContainerPortAdd(r.Context(), name, entities.ContainerPortUpdateOptions{Ports: query.Ports})
+	if err != nil {
+		portUpdateError(w, name, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, report)
+}
+
+func RemoveContainerPorts(w http.ResponseWriter, r *http.Request) {
+	name := utils.GetName(r)
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		Ports []string `schema:"ports"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	if err := containerEngine.ContainerPortRemove(r.Context(), name, entities.ContainerPortUpdateOptions{Ports: query.Ports}); err != nil {
+		portUpdateError(w, name, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
 }
 
 func ShouldRestart(w http.ResponseWriter, r *http.Request) {
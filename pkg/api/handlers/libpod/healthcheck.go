@@ -1,18 +1,42 @@
 package libpod
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/containers/podman/v4/libpod"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/api/handlers/utils"
 	api "github.com/containers/podman/v4/pkg/api/types"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/containers/podman/v4/pkg/domain/infra/abi"
+	"github.com/containers/podman/v4/pkg/util"
+	"github.com/gorilla/schema"
+	"github.com/sirupsen/logrus"
 )
 
 func RunHealthCheck(w http.ResponseWriter, r *http.Request) {
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		Readiness bool `schema:"readiness"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
 	name := utils.GetName(r)
-	status, err := runtime.HealthCheck(r.Context(), name)
+	var (
+		status define.HealthCheckStatus
+		err    error
+	)
+	if query.Readiness {
+		status, err = runtime.ReadinessCheck(r.Context(), name)
+	} else {
+		status, err = runtime.HealthCheck(r.Context(), name)
+	}
 	if err != nil {
 		if status == define.HealthCheckContainerNotFound {
 			utils.ContainerNotFound(w, name, err)
@@ -29,6 +53,22 @@ func RunHealthCheck(w http.ResponseWriter, r *http.Request) {
 		utils.InternalServerError(w, err)
 		return
 	}
+
+	if query.Readiness {
+		ctr, err := runtime.LookupContainer(name)
+		if err != nil {
+			utils.ContainerNotFound(w, name, err)
+			return
+		}
+		readiness, err := ctr.ReadinessStatus()
+		if err != nil {
+			utils.InternalServerError(w, err)
+			return
+		}
+		utils.WriteResponse(w, http.StatusOK, define.HealthCheckResults{Status: readiness})
+		return
+	}
+
 	hcStatus := define.HealthCheckUnhealthy
 	if status == define.HealthCheckSuccess {
 		hcStatus = define.HealthCheckHealthy
@@ -40,3 +80,73 @@ func RunHealthCheck(w http.ResponseWriter, r *http.Request) {
 	}
 	utils.WriteResponse(w, http.StatusOK, report)
 }
+
+func HealthCheckLogs(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	decoder := r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	query := struct {
+		Since  string `schema:"since"`
+		Follow bool   `schema:"follow"`
+	}{}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	if query.Since != "" {
+		if _, err := util.ParseInputTime(query.Since, true); err != nil {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
+	}
+
+	name := utils.GetName(r)
+	ctr, err := runtime.LookupContainer(name)
+	if err != nil {
+		utils.ContainerNotFound(w, name, err)
+		return
+	}
+	if !ctr.HasHealthCheck() {
+		utils.Error(w, http.StatusConflict, fmt.Errorf("container %s: %w", ctr.ID(), define.ErrNoHealthCheck))
+		return
+	}
+
+	logChan := make(chan *define.HealthCheckLog)
+	errChan := make(chan error, 1)
+	go func() {
+		containerEngine := abi.ContainerEngine{Libpod: runtime}
+		options := entities.HealthCheckLogsOptions{Since: query.Since, Follow: query.Follow, LogChan: logChan}
+		errChan <- containerEngine.HealthCheckLogs(r.Context(), ctr.ID(), options)
+	}()
+
+	flush := func() {}
+	if flusher, ok := w.(http.Flusher); ok {
+		flush = flusher.Flush
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	flush()
+
+	coder := json.NewEncoder(w)
+	coder.SetEscapeHTML(true)
+	for hcl := range logChan {
+		if err := coder.Encode(hcl); err != nil {
+			logrus.Errorf("Unable to write json: %q", err)
+		}
+		flush()
+	}
+	if err := <-errChan; err != nil {
+		// The status has already been sent, only log the error.
+		logrus.Errorf("Reading health check logs of container %s: %v", ctr.ID(), err)
+	}
+}
+
+func ListHealthChecks(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	containerEngine := abi.ContainerEngine{Libpod: runtime}
+	reports, err := containerEngine.HealthCheckList(r.Context())
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, reports)
+}
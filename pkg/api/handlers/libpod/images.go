@@ -11,7 +11,6 @@ import (
 
 	"github.com/containers/buildah"
 	"github.com/containers/common/libimage"
-	"github.com/containers/common/pkg/ssh"
 	"github.com/containers/image/v5/manifest"
 	"github.com/containers/podman/v4/libpod"
 	"github.com/containers/podman/v4/libpod/define"
@@ -31,6 +30,7 @@ import (
 	"github.com/containers/storage/pkg/chrootarchive"
 	"github.com/containers/storage/pkg/idtools"
 	"github.com/gorilla/schema"
+	"github.com/sirupsen/logrus"
 )
 
 // Commit
@@ -183,8 +183,14 @@ func ExportImage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	imageEngine := abi.ImageEngine{Libpod: runtime}
+
 	switch query.Format {
-	case define.OCIArchive, define.V2s2Archive:
+	case define.V2s2Archive:
+		saveOptions := entities.ImageSaveOptions{Compress: query.Compress, Format: query.Format}
+		streamDockerArchive(w, r, &imageEngine, name, nil, saveOptions)
+		return
+	case define.OCIArchive:
 		tmpfile, err := os.CreateTemp("", "api.tar")
 		if err != nil {
 			utils.Error(w, http.StatusInternalServerError, fmt.Errorf("unable to create tempfile: %w", err))
@@ -207,8 +213,6 @@ func ExportImage(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	imageEngine := abi.ImageEngine{Libpod: runtime}
-
 	saveOptions := entities.ImageSaveOptions{
 		Compress: query.Compress,
 		Format:   query.Format,
@@ -239,6 +243,44 @@ func ExportImage(w http.ResponseWriter, r *http.Request) {
 	utils.WriteResponse(w, http.StatusOK, rdr)
 }
 
+// streamDockerArchive writes the images as a docker archive directly to the
+// response, without a temporary file.  Errors before the archive is started,
+// e.g. unknown images, are reported as usual.  Later errors abort the response,
+// so that the client does not take the truncated archive for a complete one.
+func streamDockerArchive(w http.ResponseWriter, r *http.Request, imageEngine *abi.ImageEngine, nameOrID string, tags []string, opts entities.ImageSaveOptions) {
+	archive := &archiveResponseWriter{w: w}
+	err := imageEngine.SaveToWriter(r.Context(), nameOrID, tags, archive, opts)
+	switch {
+	case err == nil:
+		archive.start()
+	case !archive.started:
+		utils.Error(w, http.StatusBadRequest, err)
+	default:
+		logrus.Errorf("Streaming docker archive of %s: %v", nameOrID, err)
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// archiveResponseWriter sends the response header with the first write of the
+// archive.
+type archiveResponseWriter struct {
+	w       http.ResponseWriter
+	started bool
+}
+
+func (a *archiveResponseWriter) start() {
+	if !a.started {
+		a.w.Header().Set("Content-Type", "application/x-tar")
+		a.w.WriteHeader(http.StatusOK)
+		a.started = true
+	}
+}
+
+func (a *archiveResponseWriter) Write(b []byte) (int, error) {
+	a.start()
+	return a.w.Write(b)
+}
+
 func ExportImages(w http.ResponseWriter, r *http.Request) {
 	var output string
 	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
@@ -270,8 +312,20 @@ func ExportImages(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Use the ABI image engine to share as much code as possible.
+	imageEngine := abi.ImageEngine{Libpod: runtime}
+	opts := entities.ImageSaveOptions{
+		Compress:                    query.Compress,
+		Format:                      query.Format,
+		MultiImageArchive:           len(query.References) > 1,
+		OciAcceptUncompressedLayers: query.OciAcceptUncompressedLayers,
+	}
+
 	switch query.Format {
-	case define.V2s2Archive, define.OCIArchive:
+	case define.V2s2Archive:
+		streamDockerArchive(w, r, &imageEngine, query.References[0], query.References[1:], opts)
+		return
+	case define.OCIArchive:
 		tmpfile, err := os.CreateTemp("", "api.tar")
 		if err != nil {
 			utils.Error(w, http.StatusInternalServerError, fmt.Errorf("unable to create tempfile: %w", err))
@@ -294,17 +348,8 @@ func ExportImages(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	defer os.RemoveAll(output)
+	opts.Output = output
 
-	// Use the ABI image engine to share as much code as possible.
-	opts := entities.ImageSaveOptions{
-		Compress:                    query.Compress,
-		Format:                      query.Format,
-		MultiImageArchive:           len(query.References) > 1,
-		OciAcceptUncompressedLayers: query.OciAcceptUncompressedLayers,
-		Output:                      output,
-	}
-
-	imageEngine := abi.ImageEngine{Libpod: runtime}
 	if err := imageEngine.Save(r.Context(), query.References[0], query.References[1:], opts); err != nil {
 		utils.Error(w, http.StatusBadRequest, err)
 		return
@@ -630,14 +675,24 @@ func ImageScp(w http.ResponseWriter, r *http.Request) {
 
 	sourceArg := utils.GetName(r)
 
-	rep, source, dest, _, err := domainUtils.ExecuteTransfer(sourceArg, query.Destination, []string{}, query.Quiet, ssh.GolangMode)
-	if err != nil {
-		utils.Error(w, http.StatusInternalServerError, err)
-		return
+	// The service does not act on behalf of other users.
+	for _, arg := range []string{sourceArg, query.Destination} {
+		location, _, err := domainUtils.ParseImageSCPArg(arg)
+		if err != nil {
+			utils.Error(w, http.StatusBadRequest, err)
+			return
+		}
+		if location.User != "" {
+			utils.Error(w, http.StatusBadRequest, fmt.Errorf("cannot use the user transfer function on the remote client: %w", define.ErrInvalidArg))
+			return
+		}
 	}
 
-	if source != nil || dest != nil {
-		utils.Error(w, http.StatusBadRequest, fmt.Errorf("cannot use the user transfer function on the remote client: %w", define.ErrInvalidArg))
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+	imageEngine := abi.ImageEngine{Libpod: runtime}
+	rep, err := imageEngine.Scp(r.Context(), sourceArg, query.Destination, true)
+	if err != nil {
+		utils.Error(w, http.StatusInternalServerError, err)
 		return
 	}
 
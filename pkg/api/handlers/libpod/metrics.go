@@ -0,0 +1,156 @@
+package libpod
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/containers/podman/v4/libpod"
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/pkg/api/handlers/utils"
+	"github.com/containers/podman/v4/pkg/api/server/metrics"
+	api "github.com/containers/podman/v4/pkg/api/types"
+	"github.com/sirupsen/logrus"
+)
+
+type metricFamily struct {
+	name       string
+	metricType string
+	help       string
+	samples    []metricSample
+}
+
+type metricSample struct {
+	labels []metrics.Label
+	value  float64
+}
+
+func (f *metricFamily) add(value float64, labels ...metrics.Label) {
+	f.samples = append(f.samples, metricSample{labels: labels, value: value})
+}
+
+// Metrics writes container, image, volume and API request metrics in the Prometheus text exposition format
+func Metrics(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	ctrs, err := runtime.GetAllContainers()
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	images, err := runtime.LibimageRuntime().ListImages(r.Context(), nil, nil)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	volumes, err := runtime.GetAllVolumes()
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+
+	var (
+		states      = metricFamily{name: "podman_containers", metricType: "gauge", help: "Number of containers by state."}
+		health      = metricFamily{name: "podman_container_health", metricType: "gauge", help: "Health check status of a container, 1 for the current status."}
+		restarts    = metricFamily{name: "podman_container_restarts_total", metricType: "counter", help: "Number of times a container has been restarted by its restart policy."}
+		cpu         = metricFamily{name: "podman_container_cpu_seconds_total", metricType: "counter", help: "Total CPU time consumed by a container in seconds."}
+		cpuSystem   = metricFamily{name: "podman_container_cpu_system_seconds_total", metricType: "counter", help: "System CPU time consumed by a container in seconds."}
+		memUsage    = metricFamily{name: "podman_container_memory_usage_bytes", metricType: "gauge", help: "Memory used by a container in bytes."}
+		memLimit    = metricFamily{name: "podman_container_memory_limit_bytes", metricType: "gauge", help: "Memory limit of a container in bytes."}
+		netInput    = metricFamily{name: "podman_container_network_receive_bytes_total", metricType: "counter", help: "Bytes received by a container over the network."}
+		netOutput   = metricFamily{name: "podman_container_network_transmit_bytes_total", metricType: "counter", help: "Bytes transmitted by a container over the network."}
+		blockInput  = metricFamily{name: "podman_container_block_read_bytes_total", metricType: "counter", help: "Bytes read by a container from block devices."}
+		blockOutput = metricFamily{name: "podman_container_block_write_bytes_total", metricType: "counter", help: "Bytes written by a container to block devices."}
+		pids        = metricFamily{name: "podman_container_pids", metricType: "gauge", help: "Number of processes running in a container."}
+		imageCount  = metricFamily{name: "podman_images", metricType: "gauge", help: "Number of images in local storage."}
+		volumeCount = metricFamily{name: "podman_volumes", metricType: "gauge", help: "Number of volumes."}
+	)
+
+	stateCount := make(map[define.ContainerStatus]int)
+	for _, ctr := range ctrs {
+		state, err := ctr.State()
+		if err != nil {
+			// The container may have been removed since it was listed
+			if !errors.Is(err, define.ErrNoSuchCtr) && !errors.Is(err, define.ErrCtrRemoved) {
+				logrus.Warnf("Unable to get state of container %s for metrics: %v", ctr.ID(), err)
+			}
+			continue
+		}
+		stateCount[state]++
+
+		id := metrics.Label{Name: "id", Value: ctr.ID()}
+		name := metrics.Label{Name: "name", Value: ctr.Name()}
+
+		if count, err := ctr.RestartCount(); err == nil {
+			restarts.add(float64(count), id, name)
+		}
+
+		if status, err := ctr.HealthCheckStatus(); err == nil && status != "" {
+			for _, s := range []string{define.HealthCheckHealthy, define.HealthCheckUnhealthy, define.HealthCheckStarting} {
+				value := 0.0
+				if s == status {
+					value = 1
+				}
+				health.add(value, id, name, metrics.Label{Name: "status", Value: s})
+			}
+		}
+
+		if state != define.ContainerStateRunning && state != define.ContainerStatePaused {
+			continue
+		}
+		stats, err := ctr.GetContainerStats(nil)
+		if err != nil {
+			logrus.Debugf("Unable to get stats of container %s for metrics: %v", ctr.ID(), err)
+			continue
+		}
+		cpu.add(float64(stats.CPUNano)/1e9, id, name)
+		cpuSystem.add(float64(stats.CPUSystemNano)/1e9, id, name)
+		memUsage.add(float64(stats.MemUsage), id, name)
+		memLimit.add(float64(stats.MemLimit), id, name)
+		netInput.add(float64(stats.NetInput), id, name)
+		netOutput.add(float64(stats.NetOutput), id, name)
+		blockInput.add(float64(stats.BlockInput), id, name)
+		blockOutput.add(float64(stats.BlockOutput), id, name)
+		pids.add(float64(stats.PIDs), id, name)
+	}
+
+	for _, state := range []define.ContainerStatus{
+		define.ContainerStateConfigured,
+		define.ContainerStateCreated,
+		define.ContainerStateRunning,
+		define.ContainerStateStopped,
+		define.ContainerStatePaused,
+		define.ContainerStateExited,
+		define.ContainerStateRemoving,
+		define.ContainerStateStopping,
+		define.ContainerStateUnknown,
+	} {
+		states.add(float64(stateCount[state]), metrics.Label{Name: "state", Value: state.String()})
+	}
+	imageCount.add(float64(len(images)))
+	volumeCount.add(float64(len(volumes)))
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	e := metrics.NewEncoder(w)
+	for _, f := range []*metricFamily{
+		&states, &health, &restarts,
+		&cpu, &cpuSystem, &memUsage, &memLimit, &netInput, &netOutput, &blockInput, &blockOutput, &pids,
+		&imageCount, &volumeCount,
+	} {
+		e.Header(f.name, f.metricType, f.help)
+		for _, s := range f.samples {
+			e.Sample(f.name, s.labels, s.value)
+		}
+	}
+	if err := e.Err(); err != nil {
+		logrus.Errorf("Unable to write metrics: %v", err)
+		return
+	}
+
+	if recorder, ok := r.Context().Value(api.MetricsKey).(*metrics.Recorder); ok {
+		if _, err := recorder.WriteTo(w); err != nil {
+			logrus.Errorf("Unable to write API request metrics: %v", err)
+		}
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/containers/podman/v4/pkg/domain/infra/abi"
 	"github.com/containers/podman/v4/pkg/domain/infra/abi/parse"
 	"github.com/containers/podman/v4/pkg/util"
+	"github.com/gorilla/mux"
 	"github.com/gorilla/schema"
 )
 
@@ -225,3 +226,126 @@ func ExistsVolume(w http.ResponseWriter, r *http.Request) {
 	}
 	utils.WriteResponse(w, http.StatusNoContent, "")
 }
+
+// volumeSnapshotError writes the error of a volume clone or snapshot
+// operation with the matching status code.
+func volumeSnapshotError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, define.ErrNoSuchVolume), errors.Is(err, define.ErrNoSuchVolumeSnapshot):
+		utils.Error(w, http.StatusNotFound, err)
+	case errors.Is(err, define.ErrInvalidArg):
+		utils.Error(w, http.StatusBadRequest, err)
+	case errors.Is(err, define.ErrVolumeExists), errors.Is(err, define.ErrVolumeSnapshotExists), errors.Is(err, define.ErrVolumeBeingUsed):
+		utils.Error(w, http.StatusConflict, err)
+	default:
+		utils.InternalServerError(w, err)
+	}
+}
+
+// CloneVolume creates a new volume as a copy of a volume
+func CloneVolume(w http.ResponseWriter, r *http.Request) {
+	var (
+		runtime = r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+		decoder = r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	)
+	query := struct {
+		Name string `schema:"name"`
+	}{
+		// override any golang type defaults
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest,
+			fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+	if query.Name == "" {
+		utils.Error(w, http.StatusBadRequest, fmt.Errorf("name of the new volume is required: %w", define.ErrInvalidArg))
+		return
+	}
+
+	ic := abi.ContainerEngine{Libpod: runtime}
+	report, err := ic.VolumeClone(r.Context(), utils.GetName(r), entities.VolumeCloneOptions{Name: query.Name})
+	if err != nil {
+		volumeSnapshotError(w, err)
+		return
+	}
+	vol, err := runtime.GetVolume(report.IDOrName)
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	inspectOut, err := vol.Inspect()
+	if err != nil {
+		utils.InternalServerError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusCreated, entities.VolumeConfigResponse{InspectVolumeData: *inspectOut})
+}
+
+// CreateVolumeSnapshot creates a snapshot of a volume
+func CreateVolumeSnapshot(w http.ResponseWriter, r *http.Request) {
+	var (
+		runtime = r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+		decoder = r.Context().Value(api.DecoderKey).(*schema.Decoder)
+	)
+	query := struct {
+		Name string `schema:"name"`
+	}{
+		// override any golang type defaults
+	}
+	if err := decoder.Decode(&query, r.URL.Query()); err != nil {
+		utils.Error(w, http.StatusBadRequest,
+			fmt.Errorf("failed to parse parameters for %s: %w", r.URL.String(), err))
+		return
+	}
+
+	ic := abi.ContainerEngine{Libpod: runtime}
+	report, err := ic.VolumeSnapshotCreate(r.Context(), utils.GetName(r), entities.VolumeSnapshotCreateOptions{Name: query.Name})
+	if err != nil {
+		volumeSnapshotError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusCreated, report)
+}
+
+// ListVolumeSnapshots lists the snapshots of a volume
+func ListVolumeSnapshots(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	ic := abi.ContainerEngine{Libpod: runtime}
+	reports, err := ic.VolumeSnapshotList(r.Context(), utils.GetName(r))
+	if err != nil {
+		volumeSnapshotError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusOK, reports)
+}
+
+// RestoreVolumeSnapshot replaces the data of a volume with a snapshot
+func RestoreVolumeSnapshot(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	ic := abi.ContainerEngine{Libpod: runtime}
+	if err := ic.VolumeSnapshotRestore(r.Context(), utils.GetName(r), mux.Vars(r)["snapshot"]); err != nil {
+		volumeSnapshotError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
+
+// RemoveVolumeSnapshot removes a snapshot of a volume
+func RemoveVolumeSnapshot(w http.ResponseWriter, r *http.Request) {
+	runtime := r.Context().Value(api.RuntimeKey).(*libpod.Runtime)
+
+	ic := abi.ContainerEngine{Libpod: runtime}
+	reports, err := ic.VolumeSnapshotRm(r.Context(), utils.GetName(r), []string{mux.Vars(r)["snapshot"]})
+	if err != nil {
+		volumeSnapshotError(w, err)
+		return
+	}
+	if err := reports[0].Err; err != nil {
+		volumeSnapshotError(w, err)
+		return
+	}
+	utils.WriteResponse(w, http.StatusNoContent, "")
+}
@@ -56,6 +56,13 @@ type manifestNotFound struct {
 	Body errorhandling.ErrorModel
 }
 
+// No such plugin
+// swagger:response
+type pluginNotFound struct {
+	// in:body
+	Body errorhandling.ErrorModel
+}
+
 // Internal server error
 // swagger:response
 type internalError struct {
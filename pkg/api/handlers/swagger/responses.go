@@ -173,6 +173,20 @@ type volumeCreateResponse struct {
 	Body entities.VolumeConfigResponse
 }
 
+// Volume snapshot
+// swagger:response
+type volumeSnapshotResponse struct {
+	// in:body
+	Body entities.VolumeSnapshotReport
+}
+
+// Volume snapshot list
+// swagger:response
+type volumeSnapshotListResponse struct {
+	// in:body
+	Body []entities.VolumeSnapshotReport
+}
+
 // Healthcheck Results
 // swagger:response
 type healthCheck struct {
@@ -180,6 +194,20 @@ type healthCheck struct {
 	Body define.HealthCheckResults
 }
 
+// Healthcheck Logs
+// swagger:response
+type healthCheckLogs struct {
+	// in:body
+	Body define.HealthCheckLog
+}
+
+// Healthcheck List
+// swagger:response
+type healthCheckList struct {
+	// in:body
+	Body []entities.HealthCheckListReport
+}
+
 // Version
 // swagger:response
 type versionResponse struct {
@@ -243,6 +271,20 @@ type volumeInspect struct {
 	Body dockerVolume.Volume
 }
 
+// Plugin list
+// swagger:response
+type pluginList struct {
+	// in:body
+	Body []dockerAPI.Plugin
+}
+
+// Plugin inspect
+// swagger:response
+type pluginInspect struct {
+	// in:body
+	Body dockerAPI.Plugin
+}
+
 // Volume prune
 // swagger:response
 type volumePruneResponse struct {
@@ -318,6 +360,13 @@ type containerUpdateResponse struct {
 	ID string
 }
 
+// Add container ports
+// swagger:response
+type containerPortAddResponse struct {
+	// in:body
+	Body entities.ContainerPortReport
+}
+
 // Wait container
 // swagger:response
 type containerWaitResponse struct {
@@ -387,6 +436,13 @@ type podStopResponse struct {
 	Body entities.PodStopReport
 }
 
+// Update pod
+// swagger:response
+type podUpdateResponse struct {
+	// in:body
+	Body entities.PodUpdateReport
+}
+
 // Restart pod
 // swagger:response
 type podRestartResponse struct {
@@ -65,10 +65,22 @@ type LibpodContainersRmReport struct {
 	RmError string `json:"Err,omitempty"`
 }
 
-// UpdateEntities used to wrap the oci resource spec in a swagger model
+// UpdateEntities is the body of a container update, the oci resource spec
+// given as its fields
 // swagger:model
 type UpdateEntities struct {
+	specs.LinuxResources
+}
+
+// PodUpdateEntities holds the attributes for updating a pod
+// swagger:model
+type PodUpdateEntities struct {
+	// Resources are merged into the pod's resource limits
 	Resources *specs.LinuxResources
+	// RestartPolicy of the pod and its containers
+	RestartPolicy string
+	// RestartRetries for the on-failure restart policy
+	RestartRetries *uint
 }
 
 type Info struct {
@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/containers/podman/v4/pkg/api/server/metrics"
 	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
 )
@@ -73,3 +74,64 @@ func loggingHandler() mux.MiddlewareFunc {
 		})
 	}
 }
+
+// metricsResponseWriter captures the status code sent to the client
+type metricsResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+
+func (m *metricsResponseWriter) WriteHeader(statusCode int) {
+	if m.statusCode == 0 {
+		m.statusCode = statusCode
+	}
+	m.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (m *metricsResponseWriter) Write(b []byte) (int, error) {
+	if m.statusCode == 0 {
+		m.statusCode = http.StatusOK
+	}
+	return m.ResponseWriter.Write(b)
+}
+
+func (m *metricsResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	if wrapped, ok := m.ResponseWriter.(http.Hijacker); ok {
+		if m.statusCode == 0 {
+			m.statusCode = http.StatusSwitchingProtocols
+		}
+		return wrapped.Hijack()
+	}
+
+	return nil, nil, errors.New("ResponseWriter does not support hijacking")
+}
+
+func (m *metricsResponseWriter) Flush() {
+	if wrapped, ok := m.ResponseWriter.(http.Flusher); ok {
+		wrapped.Flush()
+	}
+}
+
+// metricsHandler records latency and status code of every request, labeled with the
+// matched route template rather than the request path
+func metricsHandler(recorder *metrics.Recorder) mux.MiddlewareFunc {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route := "<N/A>"
+			if current := mux.CurrentRoute(r); current != nil {
+				if tmpl, err := current.GetPathTemplate(); err == nil {
+					route = tmpl
+				}
+			}
+
+			mw := &metricsResponseWriter{ResponseWriter: w}
+			start := time.Now()
+			h.ServeHTTP(mw, r)
+
+			if mw.statusCode == 0 {
+				mw.statusCode = http.StatusOK
+			}
+			recorder.Observe(r.Method, route, mw.statusCode, time.Since(start))
+		})
+	}
+}
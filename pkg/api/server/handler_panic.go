@@ -17,6 +17,11 @@ func panicHandler() mux.MiddlewareFunc {
 			// http.Server hides panics from handlers, we want to record them and fix the cause
 			defer func() {
 				err := recover()
+				// Handlers abort responses which cannot be completed
+				// on purpose, the server closes the connection.
+				if err == http.ErrAbortHandler {
+					panic(err)
+				}
 				if err != nil {
 					buf := make([]byte, 1<<20)
 					n := runtime.Stack(buf, true)
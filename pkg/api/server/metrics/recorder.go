@@ -0,0 +1,177 @@
+package metrics
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultBuckets are the upper bounds, in seconds, of the request latency histogram
+var DefaultBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10}
+
+type requestKey struct {
+	method string
+	route  string
+	code   int
+}
+
+type requestStats struct {
+	count   uint64
+	sum     float64
+	buckets []uint64
+}
+
+// Recorder collects API request latencies and error counts
+type Recorder struct {
+	buckets  []float64
+	mux      sync.Mutex // protect requests map
+	requests map[requestKey]*requestStats
+}
+
+// NewRecorder creates and initializes a new Recorder object using DefaultBuckets
+func NewRecorder() *Recorder {
+	return &Recorder{
+		buckets:  DefaultBuckets,
+		requests: make(map[requestKey]*requestStats),
+	}
+}
+
+// Observe records a served request. route is expected to be the route template and
+// not the request path to keep the cardinality of the series bounded.
+func (r *Recorder) Observe(method, route string, code int, duration time.Duration) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	key := requestKey{method: method, route: route, code: code}
+	stats, ok := r.requests[key]
+	if !ok {
+		stats = &requestStats{buckets: make([]uint64, len(r.buckets))}
+		r.requests[key] = stats
+	}
+
+	seconds := duration.Seconds()
+	stats.count++
+	stats.sum += seconds
+	for i, bound := range r.buckets {
+		if seconds <= bound {
+			stats.buckets[i]++
+		}
+	}
+}
+
+// WriteTo writes the recorded request metrics in the Prometheus text exposition format
+func (r *Recorder) WriteTo(w io.Writer) (int64, error) {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	keys := make([]requestKey, 0, len(r.requests))
+	for k := range r.requests {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].route != keys[j].route {
+			return keys[i].route < keys[j].route
+		}
+		if keys[i].method != keys[j].method {
+			return keys[i].method < keys[j].method
+		}
+		return keys[i].code < keys[j].code
+	})
+
+	e := NewEncoder(w)
+	e.Header("podman_api_requests_total", "counter", "Total number of API requests served.")
+	for _, k := range keys {
+		e.Sample("podman_api_requests_total", k.labels(), float64(r.requests[k].count))
+	}
+
+	e.Header("podman_api_request_errors_total", "counter", "Total number of API requests answered with a 4xx or 5xx status code.")
+	for _, k := range keys {
+		if k.code >= 400 {
+			e.Sample("podman_api_request_errors_total", k.labels(), float64(r.requests[k].count))
+		}
+	}
+
+	e.Header("podman_api_request_duration_seconds", "histogram", "Latency of API requests in seconds.")
+	for _, k := range keys {
+		stats := r.requests[k]
+		for i, bound := range r.buckets {
+			labels := append(k.labels(), Label{"le", strconv.FormatFloat(bound, 'g', -1, 64)})
+			e.Sample("podman_api_request_duration_seconds_bucket", labels, float64(stats.buckets[i]))
+		}
+		e.Sample("podman_api_request_duration_seconds_bucket", append(k.labels(), Label{"le", "+Inf"}), float64(stats.count))
+		e.Sample("podman_api_request_duration_seconds_sum", k.labels(), stats.sum)
+		e.Sample("podman_api_request_duration_seconds_count", k.labels(), float64(stats.count))
+	}
+	return e.Written(), e.Err()
+}
+
+func (k requestKey) labels() []Label {
+	return []Label{{"method", k.method}, {"route", k.route}, {"code", strconv.Itoa(k.code)}}
+}
+
+// labelEscaper escapes label values as required by the text exposition format
+var labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+// Label is a name/value pair attached to a sample
+type Label struct {
+	Name  string
+	Value string
+}
+
+// Encoder writes metric families in the Prometheus text exposition format.
+// The first write error is retained and all subsequent writes are skipped.
+type Encoder struct {
+	w       io.Writer
+	err     error
+	written int64
+}
+
+// NewEncoder returns an Encoder writing to w
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Header writes the HELP and TYPE lines of a metric family
+func (e *Encoder) Header(name, metricType, help string) {
+	e.printf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, metricType)
+}
+
+// Sample writes a single sample of a metric family
+func (e *Encoder) Sample(name string, labels []Label, value float64) {
+	var b strings.Builder
+	b.WriteString(name)
+	if len(labels) > 0 {
+		b.WriteByte('{')
+		for i, l := range labels {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			fmt.Fprintf(&b, "%s=\"%s\"", l.Name, labelEscaper.Replace(l.Value))
+		}
+		b.WriteByte('}')
+	}
+	e.printf("%s %s\n", b.String(), strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+// Err returns the first error encountered while writing
+func (e *Encoder) Err() error {
+	return e.err
+}
+
+// Written returns the number of bytes written so far
+func (e *Encoder) Written() int64 {
+	return e.written
+}
+
+func (e *Encoder) printf(format string, a ...interface{}) {
+	if e.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(e.w, format, a...)
+	e.written += int64(n)
+	e.err = err
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecorder(t *testing.T) {
+	r := NewRecorder()
+	r.Observe(http.MethodGet, "/v{version}/libpod/containers/json", http.StatusOK, 20*time.Millisecond)
+	r.Observe(http.MethodGet, "/v{version}/libpod/containers/json", http.StatusOK, 2*time.Second)
+	r.Observe(http.MethodGet, "/v{version}/libpod/containers/{name}/json", http.StatusNotFound, time.Millisecond)
+
+	var buf bytes.Buffer
+	n, err := r.WriteTo(&buf)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(buf.Len()), n)
+
+	out := buf.String()
+	assert.Contains(t, out, "# TYPE podman_api_request_duration_seconds histogram\n")
+	assert.Contains(t, out, `podman_api_requests_total{method="GET",route="/v{version}/libpod/containers/json",code="200"} 2`+"\n")
+	assert.Contains(t, out, `podman_api_request_errors_total{method="GET",route="/v{version}/libpod/containers/{name}/json",code="404"} 1`+"\n")
+	assert.NotContains(t, out, `podman_api_request_errors_total{method="GET",route="/v{version}/libpod/containers/json"`)
+	assert.Contains(t, out, `podman_api_request_duration_seconds_bucket{method="GET",route="/v{version}/libpod/containers/json",code="200",le="0.025"} 1`+"\n")
+	assert.Contains(t, out, `podman_api_request_duration_seconds_bucket{method="GET",route="/v{version}/libpod/containers/json",code="200",le="+Inf"} 2`+"\n")
+	assert.Contains(t, out, `podman_api_request_duration_seconds_count{method="GET",route="/v{version}/libpod/containers/json",code="200"} 2`+"\n")
+}
+
+func TestEncoderEscapesLabels(t *testing.T) {
+	var buf bytes.Buffer
+	e := NewEncoder(&buf)
+	e.Sample("test", []Label{{"name", "a\"b\\c\nd"}}, 1.5)
+	assert.NoError(t, e.Err())
+	assert.Equal(t, `test{name="a\"b\\c\nd"} 1.5`+"\n", buf.String())
+}
@@ -1513,6 +1513,10 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//    type: boolean
 	//    description:  import the restore from a checkpoint tar.gz
 	//  - in: query
+	//    name: importPrevious
+	//    type: boolean
+	//    description: the body is a tar archive of exported pre-checkpoints, in the order they were created, followed by the exported checkpoint. can only be used with import
+	//  - in: query
 	//    name: ignoreRootFS
 	//    type: boolean
 	//    description: do not include root file-system changes when exporting. can only be used with import
@@ -1650,16 +1654,42 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	// tags:
 	//   - containers
 	// summary: Update an existing containers cgroup configuration
-	// description: Update an existing containers cgroup configuration.
+	// description: |
+	//   Update an existing containers cgroup configuration, restart policy, health check, labels and auto-update policy.
+	//   The cgroup configuration is applied to the running container only, all other changes are persisted.
 	// parameters:
 	//  - in: path
 	//    name: name
 	//    type: string
 	//    required: true
 	//    description: Full or partial ID or full name of the container to update
+	//  - in: query
+	//    name: restartPolicy
+	//    type: string
+	//    description: New restart policy of the container.
+	//  - in: query
+	//    name: restartRetries
+	//    type: integer
+	//    description: New number of restart retries of the container, only allowed with the on-failure restart policy.
+	//  - in: query
+	//    name: healthConfig
+	//    type: string
+	//    description: JSON encoded health check configuration replacing the one of the container. A Test of ["NONE"] disables the health check.
+	//  - in: query
+	//    name: healthOnFailure
+	//    type: string
+	//    description: New action taken once the container turns unhealthy.
+	//  - in: query
+	//    name: labels
+	//    type: string
+	//    description: JSON encoded map of labels added to the container.
+	//  - in: query
+	//    name: autoUpdatePolicy
+	//    type: string
+	//    description: New auto-update policy of the container.
 	//  - in: body
 	//    name: resources
-	//    description: attributes for updating the container
+	//    description: new resource limits of the container, may be omitted when only updating other settings
 	//    schema:
 	//      $ref: "#/definitions/UpdateEntities"
 	// produces:
@@ -1668,10 +1698,81 @@ func (s *APIServer) registerContainersHandlers(r *mux.Router) error {
 	//   responses:
 	//     201:
 	//       $ref: "#/responses/containerUpdateResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
 	//   404:
 	//     $ref: "#/responses/containerNotFound"
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.HandleFunc(VersionedPath("/libpod/containers/{name}/update"), s.APIHandler(libpod.UpdateContainer)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/containers/{name}/ports/add libpod ContainerPortAddLibpod
+	// ---
+	// tags:
+	//   - containers
+	// summary: Publish ports of a container
+	// description: |
+	//   Publish additional ports of a container.  If the container is running, the ports are forwarded immediately.
+	//   The new ports are kept in the configuration of the container.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	//  - in: query
+	//    name: ports
+	//    type: array
+	//    items:
+	//      type: string
+	//    required: true
+	//    description: Ports to publish in the format of --publish, e.g. 8080:80/tcp. A random host port is assigned if none is given.
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/containerPortAddResponse"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/containerNotFound"
+	//   409:
+	//     $ref: "#/responses/conflictError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/ports/add"), s.APIHandler(libpod.AddContainerPorts)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/containers/{name}/ports/remove libpod ContainerPortRemoveLibpod
+	// ---
+	// tags:
+	//   - containers
+	// summary: Unpublish ports of a container
+	// description: |
+	//   Unpublish ports of a container.  If the container is running, the ports are no longer forwarded.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	//  - in: query
+	//    name: ports
+	//    type: array
+	//    items:
+	//      type: string
+	//    required: true
+	//    description: Ports to unpublish in the format of --publish, e.g. 8080:80/tcp. The host port is required.
+	// produces:
+	// - application/json
+	// responses:
+	//   204:
+	//     description: no error
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/containerNotFound"
+	//   409:
+	//     $ref: "#/responses/conflictError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.HandleFunc(VersionedPath("/libpod/containers/{name}/ports/remove"), s.APIHandler(libpod.RemoveContainerPorts)).Methods(http.MethodPost)
 	return nil
 }
@@ -20,6 +20,11 @@ func (s *APIServer) registerHealthCheckHandlers(r *mux.Router) error {
 	//    type: string
 	//    required: true
 	//    description: the name or ID of the container
+	//  - in: query
+	//    name: readiness
+	//    type: boolean
+	//    default: false
+	//    description: run the readiness check instead of the healthcheck, the status is then either "ready" or "not ready"
 	// produces:
 	// - application/json
 	// responses:
@@ -28,9 +33,60 @@ func (s *APIServer) registerHealthCheckHandlers(r *mux.Router) error {
 	//   404:
 	//     $ref: "#/responses/containerNotFound"
 	//   409:
-	//     description: container has no healthcheck or is not running
+	//     description: container has no healthcheck or readiness check, or is not running
 	//   500:
 	//     $ref: '#/responses/internalError'
 	r.Handle(VersionedPath("/libpod/containers/{name:.*}/healthcheck"), s.APIHandler(libpod.RunHealthCheck)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/containers/{name}/healthcheck/logs libpod ContainerHealthcheckLogsLibpod
+	// ---
+	// tags:
+	//  - containers
+	// summary: Get a container's healthcheck history
+	// description: |
+	//   Stream the past attempts of the container's healthcheck, oldest first, as a sequence of JSON objects.
+	//   Unlike the log in the inspect output, the history is kept across restarts of the container.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the container
+	//  - in: query
+	//    name: since
+	//    type: string
+	//    description: only return attempts started after the given timestamp or duration
+	//  - in: query
+	//    name: follow
+	//    type: boolean
+	//    default: false
+	//    description: keep the connection open and stream new attempts
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/healthCheckLogs"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/containerNotFound"
+	//   409:
+	//     description: container has no healthcheck
+	//   500:
+	//     $ref: '#/responses/internalError'
+	r.Handle(VersionedPath("/libpod/containers/{name:.*}/healthcheck/logs"), s.APIHandler(libpod.HealthCheckLogs)).Methods(http.MethodGet)
+	// swagger:operation GET /libpod/healthchecks/json libpod HealthcheckListLibpod
+	// ---
+	// tags:
+	//  - containers
+	// summary: List healthchecks
+	// description: Get the healthcheck status, failing streak and next run time of all containers with a healthcheck
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/healthCheckList"
+	//   500:
+	//     $ref: '#/responses/internalError'
+	r.Handle(VersionedPath("/libpod/healthchecks/json"), s.APIHandler(libpod.ListHealthChecks)).Methods(http.MethodGet)
 	return nil
 }
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/containers/podman/v4/pkg/api/handlers/libpod"
+	"github.com/gorilla/mux"
+)
+
+func (s *APIServer) registerMetricsHandlers(r *mux.Router) error {
+	if s.metrics == nil {
+		return nil
+	}
+	// swagger:operation GET /metrics libpod SystemMetrics
+	// ---
+	//   summary: Prometheus metrics
+	//   description: |
+	//     Return container, image, volume and API request metrics in the Prometheus text exposition format.
+	//     Only available when the service is started with `--metrics`.
+	//     The '/metrics' endpoint is not versioned.
+	//   tags:
+	//   - system
+	//   produces:
+	//   - text/plain
+	//   responses:
+	//     200:
+	//       description: Metrics in the Prometheus text exposition format
+	//       schema:
+	//         type: string
+	//     500:
+	//       $ref: "#/responses/internalError"
+	r.Handle("/metrics", s.APIHandler(libpod.Metrics)).Methods(http.MethodGet)
+	return nil
+}
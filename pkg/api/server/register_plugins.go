@@ -1,13 +1,112 @@
 package server
 
 import (
+	"net/http"
+
 	"github.com/containers/podman/v4/pkg/api/handlers/compat"
 	"github.com/gorilla/mux"
 )
 
 func (s *APIServer) registerPluginsHandlers(r *mux.Router) error {
-	r.Handle(VersionedPath("/plugins"), s.APIHandler(compat.UnsupportedHandler))
+	// swagger:operation GET /plugins compat PluginList
+	// ---
+	// tags:
+	//  - plugins (compat)
+	// summary: List plugins
+	// description: |
+	//   Returns the volume plugins set in containers.conf and the volume plugins
+	//   discovered in the Docker plugin directories.
+	// parameters:
+	//  - in: query
+	//    name: filters
+	//    type: string
+	//    description: |
+	//      JSON encoded value of the filters (a map[string][]string) to process on the plugin list. Available filters:
+	//        - `capability=<capability>` Only `volumedriver` is supported.
+	//        - `enable=<true|false>`
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/pluginList"
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/plugins"), s.APIHandler(compat.ListPlugins)).Methods(http.MethodGet)
 	// Added non version path to URI to support docker non versioned paths
-	r.Handle("/plugins", s.APIHandler(compat.UnsupportedHandler))
+	r.Handle("/plugins", s.APIHandler(compat.ListPlugins)).Methods(http.MethodGet)
+	// swagger:operation GET /plugins/{name}/json compat PluginInspect
+	// ---
+	// tags:
+	//  - plugins (compat)
+	// summary: Inspect a plugin
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the plugin
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     $ref: "#/responses/pluginInspect"
+	//   404:
+	//     $ref: "#/responses/pluginNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/plugins/{name}/json"), s.APIHandler(compat.InspectPlugin)).Methods(http.MethodGet)
+	r.Handle("/plugins/{name}/json", s.APIHandler(compat.InspectPlugin)).Methods(http.MethodGet)
+	// swagger:operation POST /plugins/{name}/enable compat PluginEnable
+	// ---
+	// tags:
+	//  - plugins (compat)
+	// summary: Enable a plugin
+	// description: Enable a volume plugin discovered in the Docker plugin directories. The plugin must be running.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the plugin
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     description: no error
+	//   404:
+	//     $ref: "#/responses/pluginNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/plugins/{name}/enable"), s.APIHandler(compat.EnablePlugin)).Methods(http.MethodPost)
+	r.Handle("/plugins/{name}/enable", s.APIHandler(compat.EnablePlugin)).Methods(http.MethodPost)
+	// swagger:operation POST /plugins/{name}/disable compat PluginDisable
+	// ---
+	// tags:
+	//  - plugins (compat)
+	// summary: Disable a plugin
+	// description: Disable a discovered volume plugin. Plugins set in containers.conf and plugins used by volumes cannot be disabled.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name of the plugin
+	// produces:
+	// - application/json
+	// responses:
+	//   200:
+	//     description: no error
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/pluginNotFound"
+	//   409:
+	//     $ref: "#/responses/conflictError"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/plugins/{name}/disable"), s.APIHandler(compat.DisablePlugin)).Methods(http.MethodPost)
+	r.Handle("/plugins/{name}/disable", s.APIHandler(compat.DisablePlugin)).Methods(http.MethodPost)
 	return nil
 }
@@ -285,6 +285,35 @@ func (s *APIServer) registerPodsHandlers(r *mux.Router) error {
 	//   500:
 	//     $ref: "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/pods/{name}/unpause"), s.APIHandler(libpod.PodUnpause)).Methods(http.MethodPost)
+	// swagger:operation POST /libpod/pods/{name}/update pods PodUpdateLibpod
+	// ---
+	// summary: Update a pod
+	// description: |
+	//   Update the resource limits of the pod cgroup and the restart policy of the pod and its containers.
+	//   The given resource limits are merged into the current limits of the pod.
+	// produces:
+	// - application/json
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the pod
+	//  - in: body
+	//    name: options
+	//    description: attributes for updating the pod
+	//    schema:
+	//      $ref: "#/definitions/PodUpdateEntities"
+	// responses:
+	//   200:
+	//     $ref: '#/responses/podUpdateResponse'
+	//   400:
+	//     $ref: "#/responses/badParamError"
+	//   404:
+	//     $ref: "#/responses/podNotFound"
+	//   500:
+	//     $ref: "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/pods/{name}/update"), s.APIHandler(libpod.PodUpdate)).Methods(http.MethodPost)
 	// swagger:operation GET /libpod/pods/{name}/top pods PodTopLibpod
 	// ---
 	// summary: List processes
@@ -110,6 +110,38 @@ func (s *APIServer) registerSecretHandlers(r *mux.Router) error {
 	//   '500':
 	//     "$ref": "#/responses/internalError"
 	r.Handle(VersionedPath("/libpod/secrets/{name}/exists"), s.APIHandler(libpod.SecretExists)).Methods(http.MethodGet)
+	// swagger:operation POST /libpod/secrets/{name}/update libpod SecretUpdateLibpod
+	// ---
+	// tags:
+	//  - secrets
+	// summary: Update a secret
+	// description: Replace the data of a secret while keeping its ID. Containers mounting the secret see the new data right away, containers consuming it as environment variable after they are restarted.
+	// parameters:
+	//  - in: path
+	//    name: name
+	//    type: string
+	//    required: true
+	//    description: the name or ID of the secret
+	//  - in: query
+	//    name: restartContainers
+	//    type: boolean
+	//    description: Restart the running containers referencing the secret
+	//    default: false
+	//  - in: body
+	//    name: request
+	//    description: Secret
+	//    schema:
+	//      type: string
+	// produces:
+	// - application/json
+	// responses:
+	//   '200':
+	//     $ref: "#/responses/SecretUpdateResponse"
+	//   '404':
+	//     "$ref": "#/responses/NoSuchSecret"
+	//   '500':
+	//     "$ref": "#/responses/internalError"
+	r.Handle(VersionedPath("/libpod/secrets/{name}/update"), s.APIHandler(libpod.UpdateSecret)).Methods(http.MethodPost)
 	// swagger:operation DELETE /libpod/secrets/{name} libpod SecretDeleteLibpod
 	// ---
 	// tags:
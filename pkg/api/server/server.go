@@ -2,6 +2,9 @@ package server
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -18,6 +21,7 @@ import (
 	"github.com/containers/podman/v4/libpod/shutdown"
 	"github.com/containers/podman/v4/pkg/api/handlers"
 	"github.com/containers/podman/v4/pkg/api/server/idle"
+	"github.com/containers/podman/v4/pkg/api/server/metrics"
 	"github.com/containers/podman/v4/pkg/api/types"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/coreos/go-systemd/v22/daemon"
@@ -27,15 +31,18 @@ import (
 )
 
 type APIServer struct {
-	http.Server                      // The  HTTP work happens here
-	net.Listener                     // mux for routing HTTP API calls to libpod routines
-	*libpod.Runtime                  // Where the real work happens
-	*schema.Decoder                  // Decoder for Query parameters to structs
-	context.CancelFunc               // Stop APIServer
-	context.Context                  // Context to carry objects to handlers
-	CorsHeaders        string        // Inject Cross-Origin Resource Sharing (CORS) headers
-	PProfAddr          string        // Binding network address for pprof profiles
-	idleTracker        *idle.Tracker // Track connections to support idle shutdown
+	http.Server                          // The  HTTP work happens here
+	net.Listener                         // mux for routing HTTP API calls to libpod routines
+	*libpod.Runtime                      // Where the real work happens
+	*schema.Decoder                      // Decoder for Query parameters to structs
+	context.CancelFunc                   // Stop APIServer
+	context.Context                      // Context to carry objects to handlers
+	CorsHeaders        string            // Inject Cross-Origin Resource Sharing (CORS) headers
+	PProfAddr          string            // Binding network address for pprof profiles
+	TLSCertFile        string            // TLS certificate, the service is served over TLS when set
+	TLSKeyFile         string            // Private key of the TLS certificate
+	idleTracker        *idle.Tracker     // Track connections to support idle shutdown
+	metrics            *metrics.Recorder // Record API requests, nil unless metrics are enabled
 }
 
 // Number of seconds to wait for next request, if exceeded shutdown server
@@ -85,19 +92,41 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 		CorsHeaders: opts.CorsHeaders,
 		Listener:    listener,
 		PProfAddr:   opts.PProfAddr,
+		TLSCertFile: opts.TLSCertFile,
+		TLSKeyFile:  opts.TLSKeyFile,
 		idleTracker: tracker,
 	}
 
+	if opts.Metrics {
+		server.metrics = metrics.NewRecorder()
+		logrus.Debug("API service exposing Prometheus metrics on /metrics")
+	}
+
+	if opts.TLSClientCAFile != "" {
+		tlsConfig, err := clientCATLSConfig(opts.TLSClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		server.TLSConfig = tlsConfig
+		logrus.Debugf("API service requires client certificates signed by %q", opts.TLSClientCAFile)
+	}
+
 	server.BaseContext = func(l net.Listener) context.Context {
 		ctx := context.WithValue(context.Background(), types.DecoderKey, handlers.NewAPIDecoder())
 		ctx = context.WithValue(ctx, types.RuntimeKey, runtime)
 		ctx = context.WithValue(ctx, types.IdleTrackerKey, tracker)
+		if server.metrics != nil {
+			ctx = context.WithValue(ctx, types.MetricsKey, server.metrics)
+		}
 		return ctx
 	}
 
 	// Capture panics and print stack traces for diagnostics,
 	// additionally process X-Reference-Id Header to support event correlation
 	router.Use(panicHandler(), referenceIDHandler())
+	if server.metrics != nil {
+		router.Use(metricsHandler(server.metrics))
+	}
 	router.NotFoundHandler = http.HandlerFunc(
 		func(w http.ResponseWriter, r *http.Request) {
 			// We can track user errors...
@@ -126,6 +155,7 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 		server.registerImagesHandlers,
 		server.registerInfoHandlers,
 		server.registerManifestHandlers,
+		server.registerMetricsHandlers,
 		server.registerMonitorHandlers,
 		server.registerNetworkHandlers,
 		server.registerPingHandlers,
@@ -164,6 +194,24 @@ func newServer(runtime *libpod.Runtime, listener net.Listener, opts entities.Ser
 	return &server, nil
 }
 
+// clientCATLSConfig returns a TLS configuration requiring clients to present a
+// certificate signed by one of the CAs in caFile
+func clientCATLSConfig(caFile string) (*tls.Config, error) {
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading client CA file: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no valid certificates found in client CA file %q", caFile)
+	}
+	return &tls.Config{
+		ClientCAs:  pool,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
+
 // setupSystemd notifies systemd API service is ready
 // If the NOTIFY_SOCKET is set, communicate the PID and readiness, and unset INVOCATION_ID
 // so conmon and containers are in the correct cgroup.
@@ -211,8 +259,14 @@ func (s *APIServer) Serve() error {
 	errChan := make(chan error, 1)
 	s.setupSystemd()
 	go func() {
-		err := s.Server.Serve(s.Listener)
-		if err != nil && err != http.ErrServerClosed {
+		var err error
+		if s.TLSCertFile != "" {
+			logrus.Debugf("API service serving TLS with certificate %q", s.TLSCertFile)
+			err = s.Server.ServeTLS(s.Listener, s.TLSCertFile, s.TLSKeyFile)
+		} else {
+			err = s.Server.Serve(s.Listener)
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
 			errChan <- fmt.Errorf("failed to start API service: %w", err)
 			return
 		}
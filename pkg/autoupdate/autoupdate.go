@@ -5,10 +5,14 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
+	"time"
 
 	"github.com/containers/common/libimage"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v4/libpod"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/libpod/events"
@@ -16,6 +20,7 @@ import (
 	"github.com/containers/podman/v4/pkg/systemd"
 	systemdDefine "github.com/containers/podman/v4/pkg/systemd/define"
 	"github.com/coreos/go-systemd/v22/dbus"
+	"github.com/opencontainers/go-digest"
 	"github.com/sirupsen/logrus"
 )
 
@@ -29,6 +34,9 @@ const (
 	PolicyRegistryImage = "registry"
 	// PolicyLocalImage is the policy to run auto-update based on a local image
 	PolicyLocalImage = "local"
+	// PolicySemverImage is the policy to update to the highest version on
+	// the registry that matches the version tag of the image.
+	PolicySemverImage = "semver"
 )
 
 // Map for easy lookups of supported policies.
@@ -38,6 +46,7 @@ var supportedPolicies = map[string]Policy{
 	"image":                     PolicyRegistryImage, // Deprecated in favor of PolicyRegistryImage
 	string(PolicyRegistryImage): PolicyRegistryImage,
 	string(PolicyLocalImage):    PolicyLocalImage,
+	string(PolicySemverImage):   PolicySemverImage,
 }
 
 // updater includes shared state for auto-updating one or more containers.
@@ -47,6 +56,8 @@ type updater struct {
 	unitToTasks      map[string][]*task          // Keeps track of tasks per unit
 	updatedRawImages map[string]bool             // Keeps track of updated images
 	runtime          *libpod.Runtime             // The libpod runtime
+	targets          map[string]*target          // Keeps track of resolved remote images
+	versionTags      map[string]string           // Keeps track of the latest version per raw image (see PolicySemverImage)
 }
 
 const (
@@ -55,20 +66,33 @@ const (
 	statusNotUpdated = "false"       // No update was needed
 	statusPending    = "pending"     // The update is pending (see options.DryRun)
 	statusRolledBack = "rolled back" // Rollback after a failed update
+	statusSkipped    = "skipped"     // Skipped after a failed update of a previous unit (see define.AutoUpdateOrderLabel)
 )
 
+// healthPollInterval is the interval in which the health of updated
+// containers is checked during the grace period.
+var healthPollInterval = time.Second
+
 // task includes data and state for updating a container
 type task struct {
 	authfile     string            // Container-specific authfile
 	auto         *updater          // Reverse pointer to the updater
 	container    *libpod.Container // Container to update
+	order        *int              // Position in the staged rollout (see define.AutoUpdateOrderLabel)
 	policy       Policy            // Update policy
 	image        *libimage.Image   // Original image before the update
 	rawImageName string            // The container's raw image name
 	status       string            // Auto-update status
+	target       *target           // Image to update to
 	unit         string            // Name of the systemd unit
 }
 
+// target is the image a task is updated to.
+type target struct {
+	digest    digest.Digest // Digest of the image
+	imageName string        // Name of the image to pull
+}
+
 // LookupPolicy looks up the corresponding Policy for the specified
 // string. If none is found, an errors is returned including the list of
 // supported policies.
@@ -104,6 +128,11 @@ func LookupPolicy(s string) (Policy, error) {
 // of a running container is different than the local one. If the image digests
 // differ, it restarts the systemd unit with the new image.
 //
+// If the policy is set to PolicySemverImage, it looks up the highest version
+// tag on the remote registry matching the version tag of the image.  If its
+// digest differs from the local one, it pulls the image, tags it with the
+// container's raw image name and restarts the systemd unit.
+//
 // It returns a slice of successfully restarted systemd units and a slice of
 // errors encountered during auto update.
 func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.AutoUpdateOptions) ([]*entities.AutoUpdateReport, []error) {
@@ -115,6 +144,8 @@ func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.A
 		options:          &options,
 		runtime:          runtime,
 		updatedRawImages: make(map[string]bool),
+		targets:          make(map[string]*target),
+		versionTags:      make(map[string]string),
 	}
 
 	// Find auto-update tasks and assemble them by unit.
@@ -138,10 +169,26 @@ func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.A
 	runtime.NewSystemEvent(events.AutoUpdate)
 
 	// Update all images/container according to their auto-update policy.
+	// Units with an update order are updated first, one after the other.
+	// Once the update of such a unit fails, the remaining ordered units
+	// are skipped.
 	var allReports []*entities.AutoUpdateReport
-	for unit, tasks := range auto.unitToTasks {
-		unitErrors := auto.updateUnit(ctx, unit, tasks)
-		allErrors = append(allErrors, unitErrors...)
+	failedUnit := ""
+	for _, unit := range auto.sortedUnits() {
+		tasks := auto.unitToTasks[unit]
+		_, ordered := unitOrder(tasks)
+		if ordered && failedUnit != "" {
+			logrus.Warnf("Skipping update of systemd unit %q: update of systemd unit %q failed", unit, failedUnit)
+			for _, task := range tasks {
+				task.status = statusSkipped
+			}
+		} else {
+			unitErrors := auto.updateUnit(ctx, unit, tasks)
+			allErrors = append(allErrors, unitErrors...)
+			if ordered && unitFailed(tasks) {
+				failedUnit = unit
+			}
+		}
 		for _, task := range tasks {
 			allReports = append(allReports, task.report())
 		}
@@ -150,6 +197,54 @@ func AutoUpdate(ctx context.Context, runtime *libpod.Runtime, options entities.A
 	return allReports, allErrors
 }
 
+// sortedUnits returns the units to update.  Units with an update order come
+// first sorted by their order, all other units follow sorted by name.
+func (u *updater) sortedUnits() []string {
+	units := make([]string, 0, len(u.unitToTasks))
+	for unit := range u.unitToTasks {
+		units = append(units, unit)
+	}
+	sort.Slice(units, func(i, j int) bool {
+		orderI, orderedI := unitOrder(u.unitToTasks[units[i]])
+		orderJ, orderedJ := unitOrder(u.unitToTasks[units[j]])
+		switch {
+		case orderedI != orderedJ:
+			return orderedI
+		case orderedI && orderI != orderJ:
+			return orderI < orderJ
+		default:
+			return units[i] < units[j]
+		}
+	})
+	return units
+}
+
+// unitOrder returns the lowest update order of the tasks of a unit and
+// whether any task has an update order at all.
+func unitOrder(tasks []*task) (int, bool) {
+	order, ordered := 0, false
+	for _, task := range tasks {
+		if task.order == nil {
+			continue
+		}
+		if !ordered || *task.order < order {
+			order = *task.order
+		}
+		ordered = true
+	}
+	return order, ordered
+}
+
+// unitFailed returns true if the update of any task of a unit has failed.
+func unitFailed(tasks []*task) bool {
+	for _, task := range tasks {
+		if task.status == statusFailed || task.status == statusRolledBack {
+			return true
+		}
+	}
+	return false
+}
+
 // updateUnit auto updates the tasks in the specified systemd unit.
 func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []error {
 	var errors []error
@@ -192,7 +287,14 @@ func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []
 		return errors
 	}
 
-	updateError := u.restartSystemdUnit(ctx, unit)
+	var updateError error
+	if err := u.restartSystemdUnit(ctx, unit); err != nil {
+		updateError = fmt.Errorf("restarting unit %s during update: %w", unit, err)
+	} else if u.options.HealthGracePeriod > 0 {
+		if err := u.waitHealthy(ctx, tasks); err != nil {
+			updateError = fmt.Errorf("checking health of unit %s after update: %w", unit, err)
+		}
+	}
 	for _, task := range tasks {
 		if updateError == nil {
 			task.status = statusUpdated
@@ -204,10 +306,11 @@ func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []
 	// Jump to the next unit on successful update or if rollbacks are disabled.
 	if updateError == nil || !u.options.Rollback {
 		if updateError != nil {
-			errors = append(errors, fmt.Errorf("restarting unit %s during update: %w", unit, updateError))
+			errors = append(errors, updateError)
 		}
 		return errors
 	}
+	logrus.Warnf("Rolling back systemd unit %q: %v", unit, updateError)
 
 	// The update has failed and rollbacks are enabled.
 	for _, task := range tasks {
@@ -233,11 +336,97 @@ func (u *updater) updateUnit(ctx context.Context, unit string, tasks []*task) []
 	return errors
 }
 
+// waitHealthy waits for the containers of the tasks to become healthy after
+// their unit has been restarted.  Containers with a health check must turn
+// healthy within the grace period, containers without one must keep running
+// for the entire grace period.  An error is returned as soon as a container
+// is unhealthy or not running anymore.
+func (u *updater) waitHealthy(ctx context.Context, tasks []*task) error {
+	deadline := time.Now().Add(u.options.HealthGracePeriod)
+	pending := tasks
+	for {
+		var stillPending []*task
+		for _, task := range pending {
+			status, err := task.healthStatus()
+			if err != nil {
+				return err
+			}
+			if status != define.HealthCheckHealthy {
+				stillPending = append(stillPending, task)
+			}
+		}
+		pending = stillPending
+
+		remaining := time.Until(deadline)
+		if len(pending) == 0 || remaining <= 0 {
+			break
+		}
+		if remaining > healthPollInterval {
+			remaining = healthPollInterval
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(remaining):
+		}
+	}
+
+	for _, task := range pending {
+		status, err := task.healthStatus()
+		if err != nil {
+			return err
+		}
+		if status == define.HealthCheckStarting {
+			return fmt.Errorf("container %s did not become healthy within %s", task.container.Name(), u.options.HealthGracePeriod)
+		}
+	}
+	return nil
+}
+
+// healthStatus returns the health status of the task's container after its
+// unit has been restarted, or an empty string if the container has no health
+// check.  An error is returned if the container is unhealthy or not running.
+func (t *task) healthStatus() (string, error) {
+	// Units usually create a new container, so look it up by name.
+	ctr, err := t.auto.runtime.LookupContainer(t.container.Name())
+	if err != nil {
+		return "", fmt.Errorf("looking up container %s after update: %w", t.container.Name(), err)
+	}
+	state, err := ctr.State()
+	if err != nil {
+		return "", err
+	}
+	if state != define.ContainerStateRunning {
+		return "", fmt.Errorf("container %s is %s after update", ctr.Name(), state)
+	}
+	if !ctr.HasHealthCheck() {
+		return "", nil
+	}
+	status, err := ctr.HealthCheckStatus()
+	if err != nil {
+		return "", err
+	}
+	switch status {
+	case define.HealthCheckHealthy:
+		return status, nil
+	case define.HealthCheckUnhealthy:
+		return "", fmt.Errorf("container %s is unhealthy after update", ctr.Name())
+	default:
+		// No health check has been run yet.
+		return define.HealthCheckStarting, nil
+	}
+}
+
 // report creates an auto-update report for the task.
 func (t *task) report() *entities.AutoUpdateReport {
+	imageDigest := t.image.Digest()
+	if t.target != nil {
+		imageDigest = t.target.digest
+	}
 	return &entities.AutoUpdateReport{
 		ContainerID:   t.container.ID(),
 		ContainerName: t.container.Name(),
+		Digest:        imageDigest.String(),
 		ImageName:     t.container.RawImageName(),
 		Policy:        string(t.policy),
 		SystemdUnit:   t.unit,
@@ -252,6 +441,8 @@ func (t *task) updateAvailable(ctx context.Context) (bool, error) {
 		return t.registryUpdateAvailable(ctx)
 	case PolicyLocalImage:
 		return t.localUpdateAvailable()
+	case PolicySemverImage:
+		return t.semverUpdateAvailable(ctx)
 	default:
 		return false, fmt.Errorf("unexpected auto-update policy %s for container %s", t.policy, t.container.ID())
 	}
@@ -265,6 +456,8 @@ func (t *task) update(ctx context.Context) error {
 	case PolicyLocalImage:
 		// Nothing to do as the image is already available in the local storage.
 		return nil
+	case PolicySemverImage:
+		return t.semverUpdate(ctx)
 	default:
 		return fmt.Errorf("unexpected auto-update policy %s for container %s", t.policy, t.container.ID())
 	}
@@ -275,7 +468,8 @@ func (t *task) registryUpdateAvailable(ctx context.Context) (bool, error) {
 	// The newer image has already been pulled for another task, so we know
 	// there's a newer one available.
 	if _, exists := t.auto.updatedRawImages[t.rawImageName]; exists {
-		return true, nil
+		_, err := t.resolveTarget(ctx, t.rawImageName)
+		return err == nil, err
 	}
 
 	remoteRef, err := docker.ParseReference("//" + t.rawImageName)
@@ -283,7 +477,16 @@ func (t *task) registryUpdateAvailable(ctx context.Context) (bool, error) {
 		return false, err
 	}
 	options := &libimage.HasDifferentDigestOptions{AuthFilePath: t.authfile}
-	return t.image.HasDifferentDigest(ctx, remoteRef, options)
+	updateAvailable, err := t.image.HasDifferentDigest(ctx, remoteRef, options)
+	if err != nil || !updateAvailable {
+		return false, err
+	}
+
+	// Resolve the digest to report the exact image the unit is updated to.
+	if _, err := t.resolveTarget(ctx, t.rawImageName); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
 // registryUpdate pulls down the image from the registry.
@@ -310,9 +513,119 @@ func (t *task) localUpdateAvailable() (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	t.target = &target{digest: localImg.Digest(), imageName: t.rawImageName}
 	return localImg.Digest().String() != t.image.Digest().String(), nil
 }
 
+// semverUpdateAvailable returns whether a higher or changed version matching
+// the version tag of the task's image is available on the registry.
+func (t *task) semverUpdateAvailable(ctx context.Context) (bool, error) {
+	ref, err := docker.ParseReference("//" + t.rawImageName)
+	if err != nil {
+		return false, err
+	}
+	named := ref.DockerReference()
+	tagged, ok := named.(reference.NamedTagged)
+	if !ok {
+		return false, fmt.Errorf("semver policy requires a version tag but image %s has none", t.rawImageName)
+	}
+
+	imageName, exists := t.auto.versionTags[t.rawImageName]
+	if !exists {
+		tags, err := docker.GetRepositoryTags(ctx, t.systemContext(), ref)
+		if err != nil {
+			return false, fmt.Errorf("listing tags of %s: %w", reference.TrimNamed(named), err)
+		}
+		latest, err := latestVersionTag(tagged.Tag(), tags)
+		if err != nil {
+			return false, err
+		}
+		latestNamed, err := reference.WithTag(reference.TrimNamed(named), latest)
+		if err != nil {
+			return false, err
+		}
+		imageName = latestNamed.String()
+		t.auto.versionTags[t.rawImageName] = imageName
+	}
+
+	target, err := t.resolveTarget(ctx, imageName)
+	if err != nil {
+		return false, err
+	}
+
+	// The newer image has already been pulled for another task.
+	if _, exists := t.auto.updatedRawImages[t.rawImageName]; exists {
+		return true, nil
+	}
+	for _, d := range t.image.Digests() {
+		if d == target.digest {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// semverUpdate pulls down the target image from the registry and tags it with
+// the raw image name, so that the restarted unit runs the new version.
+func (t *task) semverUpdate(ctx context.Context) error {
+	// The newer image has already been pulled for another task.
+	if _, exists := t.auto.updatedRawImages[t.rawImageName]; exists {
+		return nil
+	}
+
+	pullOptions := &libimage.PullOptions{}
+	pullOptions.AuthFilePath = t.authfile
+	pullOptions.Writer = os.Stderr
+	pulledImages, err := t.auto.runtime.LibimageRuntime().Pull(ctx, t.target.imageName, config.PullPolicyAlways, pullOptions)
+	if err != nil {
+		return err
+	}
+	if len(pulledImages) == 0 {
+		return fmt.Errorf("pulling %s: no image found", t.target.imageName)
+	}
+	if err := pulledImages[0].Tag(t.rawImageName); err != nil {
+		return err
+	}
+
+	t.auto.updatedRawImages[t.rawImageName] = true
+	return nil
+}
+
+// resolveTarget looks up the digest of the specified image on the registry
+// and sets it as the task's target.  Lookups are cached across tasks.
+func (t *task) resolveTarget(ctx context.Context, imageName string) (*target, error) {
+	if cached, exists := t.auto.targets[imageName]; exists {
+		t.target = cached
+		return cached, nil
+	}
+
+	ref, err := docker.ParseReference("//" + imageName)
+	if err != nil {
+		return nil, err
+	}
+	remoteDigest, err := docker.GetDigest(ctx, t.systemContext(), ref)
+	if err != nil {
+		return nil, fmt.Errorf("looking up digest of %s: %w", imageName, err)
+	}
+
+	t.target = &target{digest: remoteDigest, imageName: imageName}
+	t.auto.targets[imageName] = t.target
+	return t.target, nil
+}
+
+// systemContext returns the system context for contacting the registry of the
+// task's image.
+func (t *task) systemContext() *types.SystemContext {
+	sys := types.SystemContext{}
+	if runtimeSys := t.auto.runtime.SystemContext(); runtimeSys != nil {
+		sys = *runtimeSys
+	}
+	if t.authfile != "" {
+		sys.AuthFilePath = t.authfile
+	}
+	return &sys
+}
+
 // rollbackImage rolls back the task's image to the previous version before the update.
 func (t *task) rollbackImage() error {
 	// To fallback, simply retag the old image and restart the service.
@@ -439,6 +752,16 @@ func (u *updater) assembleTasks(ctx context.Context) []error {
 			continue
 		}
 
+		var order *int
+		if value, exists := labels[define.AutoUpdateOrderLabel]; exists {
+			o, err := strconv.Atoi(value)
+			if err != nil {
+				errors = append(errors, fmt.Errorf("auto-updating container %q: invalid %s label %q: %w", ctr.ID(), define.AutoUpdateOrderLabel, value, err))
+				continue
+			}
+			order = &o
+		}
+
 		rawImageName := ctr.RawImageName()
 		if rawImageName == "" {
 			errors = append(errors, fmt.Errorf("locally auto-updating container %q: raw-image name is empty", ctr.ID()))
@@ -449,6 +772,7 @@ func (u *updater) assembleTasks(ctx context.Context) []error {
 			authfile:     labels[define.AutoUpdateAuthfileLabel],
 			auto:         u,
 			container:    ctr,
+			order:        order,
 			policy:       policy,
 			image:        image,
 			unit:         unit,
@@ -0,0 +1,89 @@
+package autoupdate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// versionTagRegexp matches version tags of the form [v]MAJOR[.MINOR[.PATCH]].
+// Pre-release and build suffixes are not matched so that they are never
+// picked up by the semver policy.
+var versionTagRegexp = regexp.MustCompile(`^v?(\d+)(?:\.(\d+))?(?:\.(\d+))?$`)
+
+// parseVersionTag returns the numeric components of the version tag.  The
+// boolean is false if the tag is not a version tag.
+func parseVersionTag(tag string) ([]int, bool) {
+	matches := versionTagRegexp.FindStringSubmatch(tag)
+	if matches == nil {
+		return nil, false
+	}
+	version := []int{}
+	for _, m := range matches[1:] {
+		if m == "" {
+			break
+		}
+		n, err := strconv.Atoi(m)
+		if err != nil {
+			return nil, false
+		}
+		version = append(version, n)
+	}
+	return version, true
+}
+
+// latestVersionTag returns the highest tag among tags that matches the
+// tracked version tag.  The tracked tag is a prefix of the version, such that
+// "1.4" tracks all "1.4.x" and "1" all "1.x.y" tags.  Only complete
+// MAJOR.MINOR.PATCH tags are candidates; if there is none, the tracked tag
+// itself is returned.  A complete tracked tag only ever resolves to itself.
+func latestVersionTag(tracked string, tags []string) (string, error) {
+	trackedVersion, ok := parseVersionTag(tracked)
+	if !ok {
+		return "", fmt.Errorf("semver policy requires a version tag of the form [v]MAJOR[.MINOR[.PATCH]], got %q", tracked)
+	}
+	prefix := strings.HasPrefix(tracked, "v")
+
+	latest := tracked
+	var latestVersion []int
+	for _, tag := range tags {
+		if strings.HasPrefix(tag, "v") != prefix {
+			continue
+		}
+		version, ok := parseVersionTag(tag)
+		if !ok || len(version) != 3 || !versionHasPrefix(version, trackedVersion) {
+			continue
+		}
+		if latestVersion == nil || compareVersions(version, latestVersion) > 0 {
+			latest = tag
+			latestVersion = version
+		}
+	}
+	return latest, nil
+}
+
+// versionHasPrefix returns whether the leading components of version equal
+// prefix.
+func versionHasPrefix(version, prefix []int) bool {
+	if len(prefix) > len(version) {
+		return false
+	}
+	for i := range prefix {
+		if version[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// compareVersions returns a negative number if a is lower than b, zero if
+// both are equal and a positive number if a is higher than b.
+func compareVersions(a, b []int) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] - b[i]
+		}
+	}
+	return len(a) - len(b)
+}
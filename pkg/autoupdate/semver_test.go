@@ -0,0 +1,35 @@
+package autoupdate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLatestVersionTag(t *testing.T) {
+	tags := []string{"latest", "1", "1.4", "1.4.2", "1.4.10", "1.4.9", "1.4.11-rc1", "1.5.0", "2.0.0", "v1.4.12"}
+
+	tests := []struct {
+		tracked  string
+		expected string
+	}{
+		{"1.4", "1.4.10"},
+		{"1", "1.5.0"},
+		{"2", "2.0.0"},
+		{"1.4.2", "1.4.2"},
+		{"1.6", "1.6"},
+		{"v1.4", "v1.4.12"},
+		{"3", "3"},
+	}
+	for _, tt := range tests {
+		latest, err := latestVersionTag(tt.tracked, tags)
+		require.NoError(t, err, tt.tracked)
+		assert.Equal(t, tt.expected, latest, tt.tracked)
+	}
+
+	for _, tracked := range []string{"latest", "1.4.x", "1.4.2-rc1", "1.2.3.4"} {
+		_, err := latestVersionTag(tracked, tags)
+		assert.Error(t, err, tracked)
+	}
+}
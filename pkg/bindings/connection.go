@@ -2,6 +2,8 @@ package bindings
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"io"
@@ -85,6 +87,7 @@ func NewConnection(ctx context.Context, uri string) (context.Context, error) {
 //
 // A valid URI connection should be scheme://
 // For example tcp://localhost:<port>
+// or tcp+tls://localhost:<port>?tlsca=ca.pem&tlscert=cert.pem&tlskey=key.pem
 // or unix:///run/podman/podman.sock
 // or ssh://<user>@<host>[:port]/run/podman/podman.sock?secure=True
 func NewConnectionWithIdentity(ctx context.Context, uri string, identity string, machine bool) (context.Context, error) {
@@ -104,6 +107,17 @@ func NewConnectionWithIdentity(ctx context.Context, uri string, identity string,
 		return nil, fmt.Errorf("value of CONTAINER_HOST is not a valid url: %s: %w", uri, err)
 	}
 
+	// The TLS files are only used by tcp+tls connections, do not silently
+	// connect without TLS.
+	if _url.Scheme != "tcp+tls" {
+		query := _url.Query()
+		for _, param := range []string{"tlsca", "tlscert", "tlskey"} {
+			if query.Has(param) {
+				return nil, fmt.Errorf("the %s parameter is only supported for tcp+tls URIs, not %s", param, _url.Scheme)
+			}
+		}
+	}
+
 	// Now we set up the http Client to use the connection above
 	var connection Connection
 	switch _url.Scheme {
@@ -143,7 +157,20 @@ func NewConnectionWithIdentity(ctx context.Context, uri string, identity string,
 		if !strings.HasPrefix(uri, "tcp://") {
 			return nil, errors.New("tcp URIs should begin with tcp://")
 		}
-		conn, err := tcpClient(_url)
+		conn, err := tcpClient(_url, nil)
+		if err != nil {
+			return nil, newConnectError(err)
+		}
+		connection = conn
+	case "tcp+tls":
+		if !strings.HasPrefix(uri, "tcp+tls://") {
+			return nil, errors.New("tcp+tls URIs should begin with tcp+tls://")
+		}
+		tlsConfig, err := tlsClientConfig(_url)
+		if err != nil {
+			return nil, newConnectError(err)
+		}
+		conn, err := tcpClient(_url, tlsConfig)
 		if err != nil {
 			return nil, newConnectError(err)
 		}
@@ -161,7 +188,46 @@ func NewConnectionWithIdentity(ctx context.Context, uri string, identity string,
 	return ctx, nil
 }
 
-func tcpClient(_url *url.URL) (Connection, error) {
+// tlsClientConfig builds the TLS configuration for a tcp+tls:// URI. The CA
+// bundle used to verify the service and the client certificate presented to
+// it are given by the tlsca, tlscert and tlskey query parameters.
+func tlsClientConfig(_url *url.URL) (*tls.Config, error) {
+	query := _url.Query()
+	tlsConfig := &tls.Config{
+		ServerName: _url.Hostname(),
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if caFile := query.Get("tlsca"); caFile != "" {
+		caPEM, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading TLS CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no valid certificates found in TLS CA file %q", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	certFile, keyFile := query.Get("tlscert"), query.Get("tlskey")
+	if (certFile == "") != (keyFile == "") {
+		return nil, errors.New("tlscert and tlskey must be set together")
+	}
+	if certFile != "" {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading TLS client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// tcpClient returns a Connection talking to the service at _url.Host. When
+// tlsConfig is set the TLS handshake is done as part of dialing, so that
+// hijacked connections (attach, exec) are encrypted as well.
+func tcpClient(_url *url.URL, tlsConfig *tls.Config) (Connection, error) {
 	connection := Connection{
 		URI: _url,
 	}
@@ -193,6 +259,21 @@ func tcpClient(_url *url.URL) (Connection, error) {
 			}
 		}
 	}
+	if tlsConfig != nil {
+		dialTCP := dialContext
+		dialContext = func(ctx context.Context, network, address string) (net.Conn, error) {
+			conn, err := dialTCP(ctx, network, address)
+			if err != nil {
+				return nil, err
+			}
+			tlsConn := tls.Client(conn, tlsConfig)
+			if err := tlsConn.HandshakeContext(ctx); err != nil {
+				conn.Close()
+				return nil, err
+			}
+			return tlsConn, nil
+		}
+	}
 	connection.Client = &http.Client{
 		Transport: &http.Transport{
 			DialContext:        dialContext,
@@ -104,3 +104,40 @@ func Restore(ctx context.Context, nameOrID string, options *RestoreOptions) (*en
 
 	return &report, response.Process(&report)
 }
+
+// RestoreWithPrevious restores a container from a stream of exported
+// checkpoints. The stream is a tar archive holding the exported
+// pre-checkpoints in the order they were created, followed by the exported
+// checkpoint. The stream is read while the request is sent, such that
+// pre-checkpoints can be transferred while the next one is created.
+func RestoreWithPrevious(ctx context.Context, archives io.Reader, options *RestoreOptions) (*entities.RestoreReport, error) {
+	var report entities.RestoreReport
+	if options == nil {
+		options = new(RestoreOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range options.PublishPorts {
+		params.Add("publishPorts", p)
+	}
+
+	params.Del("ImportArchive")
+	params.Set("import", "true")
+	params.Set("importPrevious", "true")
+
+	// Hard-code the name since it will be ignored in any case.
+	response, err := conn.DoRequest(ctx, archives, http.MethodPost, "/containers/%s/restore", params, nil, "import")
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
@@ -2,10 +2,14 @@ package containers
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/bindings"
+	"github.com/containers/podman/v4/pkg/domain/entities"
 )
 
 // RunHealthCheck executes the container's healthcheck and returns the health status of the
@@ -14,15 +18,18 @@ func RunHealthCheck(ctx context.Context, nameOrID string, options *HealthCheckOp
 	if options == nil {
 		options = new(HealthCheckOptions)
 	}
-	_ = options
 	conn, err := bindings.GetClient(ctx)
 	if err != nil {
 		return nil, err
 	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
 	var (
 		status define.HealthCheckResults
 	)
-	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/containers/%s/healthcheck", nil, nil, nameOrID)
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/containers/%s/healthcheck", params, nil, nameOrID)
 	if err != nil {
 		return nil, err
 	}
@@ -30,3 +37,59 @@ func RunHealthCheck(ctx context.Context, nameOrID string, options *HealthCheckOp
 
 	return &status, response.Process(&status)
 }
+
+// HealthCheckLogs reads the health check history of the container and sends
+// the attempts to logChan, which is closed when done.  If follow is set,
+// new attempts are sent until the context is cancelled or the container is
+// removed.
+func HealthCheckLogs(ctx context.Context, nameOrID string, logChan chan define.HealthCheckLog, options *HealthCheckLogsOptions) error {
+	defer close(logChan)
+	if options == nil {
+		options = new(HealthCheckLogsOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/containers/%s/healthcheck/logs", params, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	if !response.IsSuccess() {
+		return response.Process(nil)
+	}
+
+	dec := json.NewDecoder(response.Body)
+	for {
+		var hcl define.HealthCheckLog
+		if err := dec.Decode(&hcl); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("unable to decode health check log response: %w", err)
+		}
+		logChan <- hcl
+	}
+}
+
+// ListHealthChecks returns the health check state of all containers with a
+// health check.
+func ListHealthChecks(ctx context.Context) ([]*entities.HealthCheckListReport, error) {
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var reports []*entities.HealthCheckListReport
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/healthchecks/json", nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return reports, response.Process(&reports)
+}
@@ -0,0 +1,55 @@
+package containers
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/containers/podman/v4/pkg/bindings"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+)
+
+// PortAdd publishes the given ports of a container.  The report contains the
+// published port mappings, with the randomly assigned host ports set.
+func PortAdd(ctx context.Context, nameOrID string, options *PortAddOptions) (*entities.ContainerPortReport, error) {
+	if options == nil {
+		options = new(PortAddOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/containers/%s/ports/add", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	report := new(entities.ContainerPortReport)
+	return report, response.Process(report)
+}
+
+// PortRemove unpublishes the given ports of a container.
+func PortRemove(ctx context.Context, nameOrID string, options *PortRemoveOptions) error {
+	if options == nil {
+		options = new(PortRemoveOptions)
+	}
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/containers/%s/ports/remove", params, nil, nameOrID)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return response.Process(nil)
+}
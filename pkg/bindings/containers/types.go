@@ -119,7 +119,21 @@ type ExecStartOptions struct {
 // the health of a container
 //
 //go:generate go run ../generator/generator.go HealthCheckOptions
-type HealthCheckOptions struct{}
+type HealthCheckOptions struct {
+	// Readiness runs the readiness check instead of the healthcheck
+	Readiness *bool
+}
+
+// HealthCheckLogsOptions are optional options for reading the
+// health check history of a container
+//
+//go:generate go run ../generator/generator.go HealthCheckLogsOptions
+type HealthCheckLogsOptions struct {
+	// Since only returns attempts started after the given time
+	Since *string
+	// Follow waits for new attempts
+	Follow *bool
+}
 
 // MountOptions are optional options for mounting
 // containers
@@ -264,6 +278,24 @@ type RenameOptions struct {
 	Name *string
 }
 
+// PortAddOptions are options for publishing ports of a container.
+// The Ports field is required.
+//
+//go:generate go run ../generator/generator.go PortAddOptions
+type PortAddOptions struct {
+	// Ports to publish in the format of --publish
+	Ports []string
+}
+
+// PortRemoveOptions are options for unpublishing ports of a container.
+// The Ports field is required.
+//
+//go:generate go run ../generator/generator.go PortRemoveOptions
+type PortRemoveOptions struct {
+	// Ports to unpublish in the format of --publish
+	Ports []string
+}
+
 // ResizeTTYOptions are optional options for resizing
 // container TTYs
 //
@@ -0,0 +1,48 @@
+// Code generated by go generate; DO NOT EDIT.
+package containers
+
+import (
+	"net/url"
+
+	"github.com/containers/podman/v4/pkg/bindings/internal/util"
+)
+
+// Changed returns true if named field has been set
+func (o *HealthCheckLogsOptions) Changed(fieldName string) bool {
+	return util.Changed(o, fieldName)
+}
+
+// ToParams formats struct fields to be passed to API service
+func (o *HealthCheckLogsOptions) ToParams() (url.Values, error) {
+	return util.ToParams(o)
+}
+
+// WithSince set field Since to given value
+func (o *HealthCheckLogsOptions) WithSince(value string) *HealthCheckLogsOptions {
+	o.Since = &value
+	return o
+}
+
+// GetSince returns value of field Since
+func (o *HealthCheckLogsOptions) GetSince() string {
+	if o.Since == nil {
+		var z string
+		return z
+	}
+	return *o.Since
+}
+
+// WithFollow set field Follow to given value
+func (o *HealthCheckLogsOptions) WithFollow(value bool) *HealthCheckLogsOptions {
+	o.Follow = &value
+	return o
+}
+
+// GetFollow returns value of field Follow
+func (o *HealthCheckLogsOptions) GetFollow() bool {
+	if o.Follow == nil {
+		var z bool
+		return z
+	}
+	return *o.Follow
+}
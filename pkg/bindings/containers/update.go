@@ -3,6 +3,8 @@ package containers
 import (
 	"context"
 	"net/http"
+	"net/url"
+	"strconv"
 	"strings"
 
 	"github.com/containers/podman/v4/pkg/bindings"
@@ -16,12 +18,44 @@ func Update(ctx context.Context, options *entities.ContainerUpdateOptions) (stri
 		return "", err
 	}
 
-	resources, err := jsoniter.MarshalToString(options.Specgen.ResourceLimits)
-	if err != nil {
-		return "", err
+	params := url.Values{}
+	if options.RestartPolicy != "" {
+		params.Set("restartPolicy", options.RestartPolicy)
+	}
+	if options.RestartRetries != nil {
+		params.Set("restartRetries", strconv.FormatUint(uint64(*options.RestartRetries), 10))
+	}
+	if options.HealthConfig != nil {
+		healthConfig, err := jsoniter.MarshalToString(options.HealthConfig)
+		if err != nil {
+			return "", err
+		}
+		params.Set("healthConfig", healthConfig)
+	}
+	if options.HealthOnFailure != "" {
+		params.Set("healthOnFailure", options.HealthOnFailure)
+	}
+	if len(options.Labels) > 0 {
+		labels, err := jsoniter.MarshalToString(options.Labels)
+		if err != nil {
+			return "", err
+		}
+		params.Set("labels", labels)
+	}
+	if options.AutoUpdatePolicy != "" {
+		params.Set("autoUpdatePolicy", options.AutoUpdatePolicy)
+	}
+
+	// A null body leaves the resource limits untouched.
+	resources := "null"
+	if options.Specgen != nil {
+		resources, err = jsoniter.MarshalToString(options.Specgen.ResourceLimits)
+		if err != nil {
+			return "", err
+		}
 	}
 	stringReader := strings.NewReader(resources)
-	response, err := conn.DoRequest(ctx, stringReader, http.MethodPost, "/containers/%s/update", nil, nil, options.NameOrID)
+	response, err := conn.DoRequest(ctx, stringReader, http.MethodPost, "/containers/%s/update", params, nil, options.NameOrID)
 	if err != nil {
 		return "", err
 	}
@@ -321,6 +321,34 @@ func Unpause(ctx context.Context, nameOrID string, options *UnpauseOptions) (*en
 	return &report, response.ProcessWithError(&report, &errorhandling.PodConflictErrorModel{})
 }
 
+// Update updates the resource limits and the restart policy of a pod.
+func Update(ctx context.Context, nameOrID string, options *UpdateOptions) (*entities.PodUpdateReport, error) {
+	if options == nil {
+		options = new(UpdateOptions)
+	}
+	var report entities.PodUpdateReport
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	body := handlers.PodUpdateEntities{
+		Resources:      options.Resources,
+		RestartPolicy:  options.GetRestartPolicy(),
+		RestartRetries: options.RestartRetries,
+	}
+	bodyJSON, err := jsoniter.MarshalToString(body)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, strings.NewReader(bodyJSON), http.MethodPost, "/pods/%s/update", nil, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &report, response.Process(&report)
+}
+
 // Stats display resource-usage statistics of one or more pods.
 func Stats(ctx context.Context, namesOrIDs []string, options *StatsOptions) ([]*entities.PodStatsReport, error) {
 	if options == nil {
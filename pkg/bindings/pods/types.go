@@ -1,5 +1,9 @@
 package pods
 
+import (
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
 // CreateOptions are optional options for creating pods
 //
 //go:generate go run ../generator/generator.go CreateOptions
@@ -90,3 +94,12 @@ type RemoveOptions struct {
 //go:generate go run ../generator/generator.go ExistsOptions
 type ExistsOptions struct {
 }
+
+// UpdateOptions are optional options for updating pods
+//
+//go:generate go run ../generator/generator.go UpdateOptions
+type UpdateOptions struct {
+	Resources      *specs.LinuxResources
+	RestartPolicy  *string
+	RestartRetries *uint
+}
@@ -0,0 +1,64 @@
+// Code generated by go generate; DO NOT EDIT.
+package pods
+
+import (
+	"net/url"
+
+	"github.com/containers/podman/v4/pkg/bindings/internal/util"
+	"github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// Changed returns true if named field has been set
+func (o *UpdateOptions) Changed(fieldName string) bool {
+	return util.Changed(o, fieldName)
+}
+
+// ToParams formats struct fields to be passed to API service
+func (o *UpdateOptions) ToParams() (url.Values, error) {
+	return util.ToParams(o)
+}
+
+// WithResources set field Resources to given value
+func (o *UpdateOptions) WithResources(value specs.LinuxResources) *UpdateOptions {
+	o.Resources = &value
+	return o
+}
+
+// GetResources returns value of field Resources
+func (o *UpdateOptions) GetResources() specs.LinuxResources {
+	if o.Resources == nil {
+		var z specs.LinuxResources
+		return z
+	}
+	return *o.Resources
+}
+
+// WithRestartPolicy set field RestartPolicy to given value
+func (o *UpdateOptions) WithRestartPolicy(value string) *UpdateOptions {
+	o.RestartPolicy = &value
+	return o
+}
+
+// GetRestartPolicy returns value of field RestartPolicy
+func (o *UpdateOptions) GetRestartPolicy() string {
+	if o.RestartPolicy == nil {
+		var z string
+		return z
+	}
+	return *o.RestartPolicy
+}
+
+// WithRestartRetries set field RestartRetries to given value
+func (o *UpdateOptions) WithRestartRetries(value uint) *UpdateOptions {
+	o.RestartRetries = &value
+	return o
+}
+
+// GetRestartRetries returns value of field RestartRetries
+func (o *UpdateOptions) GetRestartRetries() uint {
+	if o.RestartRetries == nil {
+		var z uint
+		return z
+	}
+	return *o.RestartRetries
+}
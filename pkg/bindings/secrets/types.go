@@ -28,3 +28,10 @@ type CreateOptions struct {
 	DriverOpts map[string]string
 	Labels     map[string]string
 }
+
+// UpdateOptions are optional options for updating secrets
+//
+//go:generate go run ../generator/generator.go UpdateOptions
+type UpdateOptions struct {
+	RestartContainers *bool
+}
@@ -0,0 +1,33 @@
+// Code generated by go generate; DO NOT EDIT.
+package secrets
+
+import (
+	"net/url"
+
+	"github.com/containers/podman/v4/pkg/bindings/internal/util"
+)
+
+// Changed returns true if named field has been set
+func (o *UpdateOptions) Changed(fieldName string) bool {
+	return util.Changed(o, fieldName)
+}
+
+// ToParams formats struct fields to be passed to API service
+func (o *UpdateOptions) ToParams() (url.Values, error) {
+	return util.ToParams(o)
+}
+
+// WithRestartContainers set field RestartContainers to given value
+func (o *UpdateOptions) WithRestartContainers(value bool) *UpdateOptions {
+	o.RestartContainers = &value
+	return o
+}
+
+// GetRestartContainers returns value of field RestartContainers
+func (o *UpdateOptions) GetRestartContainers() bool {
+	if o.RestartContainers == nil {
+		var z bool
+		return z
+	}
+	return *o.RestartContainers
+}
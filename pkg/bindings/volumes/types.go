@@ -43,3 +43,39 @@ type RemoveOptions struct {
 //go:generate go run ../generator/generator.go ExistsOptions
 type ExistsOptions struct {
 }
+
+// CloneOptions are optional options for cloning volumes
+//
+//go:generate go run ../generator/generator.go CloneOptions
+type CloneOptions struct {
+}
+
+// SnapshotCreateOptions are optional options for creating snapshots
+// of volumes
+//
+//go:generate go run ../generator/generator.go SnapshotCreateOptions
+type SnapshotCreateOptions struct {
+	// Name of the snapshot, a random name is generated if not set
+	Name *string
+}
+
+// SnapshotListOptions are optional options for listing snapshots
+// of volumes
+//
+//go:generate go run ../generator/generator.go SnapshotListOptions
+type SnapshotListOptions struct {
+}
+
+// SnapshotRestoreOptions are optional options for restoring snapshots
+// of volumes
+//
+//go:generate go run ../generator/generator.go SnapshotRestoreOptions
+type SnapshotRestoreOptions struct {
+}
+
+// SnapshotRemoveOptions are optional options for removing snapshots
+// of volumes
+//
+//go:generate go run ../generator/generator.go SnapshotRemoveOptions
+type SnapshotRemoveOptions struct {
+}
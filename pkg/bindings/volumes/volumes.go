@@ -3,6 +3,7 @@ package volumes
 import (
 	"context"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/containers/podman/v4/pkg/bindings"
@@ -139,3 +140,111 @@ func Exists(ctx context.Context, nameOrID string, options *ExistsOptions) (bool,
 
 	return response.IsSuccess(), nil
 }
+
+// Clone creates a new volume with the given name as a copy of the volume,
+// including its data.
+func Clone(ctx context.Context, nameOrID string, name string, options *CloneOptions) (*entities.VolumeConfigResponse, error) {
+	var (
+		v entities.VolumeConfigResponse
+	)
+	if options == nil {
+		options = new(CloneOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params := url.Values{}
+	params.Set("name", name)
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/volumes/%s/clone", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &v, response.Process(&v)
+}
+
+// SnapshotCreate copies the data of the volume into a new snapshot.
+func SnapshotCreate(ctx context.Context, nameOrID string, options *SnapshotCreateOptions) (*entities.VolumeSnapshotReport, error) {
+	var (
+		snapshot entities.VolumeSnapshotReport
+	)
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	params, err := options.ToParams()
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/volumes/%s/snapshots", params, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return &snapshot, response.Process(&snapshot)
+}
+
+// SnapshotList returns the snapshots of the volume.
+func SnapshotList(ctx context.Context, nameOrID string, options *SnapshotListOptions) ([]*entities.VolumeSnapshotReport, error) {
+	var (
+		snapshots []*entities.VolumeSnapshotReport
+	)
+	if options == nil {
+		options = new(SnapshotListOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodGet, "/volumes/%s/snapshots", nil, nil, nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	return snapshots, response.Process(&snapshots)
+}
+
+// SnapshotRestore replaces the data of the volume with the data of the
+// snapshot.
+func SnapshotRestore(ctx context.Context, nameOrID string, snapshot string, options *SnapshotRestoreOptions) error {
+	if options == nil {
+		options = new(SnapshotRestoreOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodPost, "/volumes/%s/snapshots/%s/restore", nil, nil, nameOrID, snapshot)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return response.Process(nil)
+}
+
+// SnapshotRemove removes the snapshot of the volume.
+func SnapshotRemove(ctx context.Context, nameOrID string, snapshot string, options *SnapshotRemoveOptions) error {
+	if options == nil {
+		options = new(SnapshotRemoveOptions)
+	}
+	_ = options
+	conn, err := bindings.GetClient(ctx)
+	if err != nil {
+		return err
+	}
+	response, err := conn.DoRequest(ctx, nil, http.MethodDelete, "/volumes/%s/snapshots/%s", nil, nil, nameOrID, snapshot)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	return response.Process(nil)
+}
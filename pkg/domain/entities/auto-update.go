@@ -1,5 +1,7 @@
 package entities
 
+import "time"
+
 // AutoUpdateOptions are the options for running auto-update.
 type AutoUpdateOptions struct {
 	// Authfile to use when contacting registries.
@@ -11,6 +13,12 @@ type AutoUpdateOptions struct {
 	// If restarting the service with the new image failed, restart it
 	// another time with the previous image.
 	Rollback bool
+	// After restarting the service with the new image, wait up to this
+	// duration for the containers to become healthy.  Containers without
+	// a health check must keep running for the duration.  The update
+	// fails if a container turns unhealthy or exits.  Zero disables the
+	// check.
+	HealthGracePeriod time.Duration
 }
 
 // AutoUpdateReport contains the results from running auto-update.
@@ -19,6 +27,10 @@ type AutoUpdateReport struct {
 	ContainerID string
 	// Name of the container *before* an update.
 	ContainerName string
+	// Digest of the image the container runs after the update.  If an
+	// update is pending (see DryRun), it is the digest of the image the
+	// container will be updated to.
+	Digest string
 	// Name of the image.
 	ImageName string
 	// The configured auto-update policy.
@@ -26,6 +38,6 @@ type AutoUpdateReport struct {
 	// SystemdUnit running a container configured for auto updates.
 	SystemdUnit string
 	// Indicates the update status: true, false, failed, pending (see
-	// DryRun).
+	// DryRun), rolled back or skipped.
 	Updated string
 }
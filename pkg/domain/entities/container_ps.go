@@ -55,6 +55,8 @@ type ListContainer struct {
 	PodName string
 	// Port mappings
 	Ports []types.PortMapping
+	// Readiness of the container, empty if it has no readiness check
+	Readiness string `json:",omitempty"`
 	// Restarts is how many times the container was restarted by its
 	// restart policy. This is NOT incremented by normal container restarts
 	// (only by restart policy).
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	nettypes "github.com/containers/common/libnetwork/types"
+	"github.com/containers/image/v5/manifest"
 	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/specgen"
@@ -221,7 +222,7 @@ type RestoreOptions struct {
 	Latest          bool
 	Name            string
 	TCPEstablished  bool
-	ImportPrevious  string
+	ImportPrevious  []string
 	PublishPorts    []string
 	Pod             string
 	PrintStats      bool
@@ -236,6 +237,25 @@ type RestoreReport struct {
 	CRIUStatistics  *define.CRIUCheckpointRestoreStatistics `json:"criu_statistics"`
 }
 
+// ContainerMigrateOptions describes the options for migrating a container to
+// the host of a system connection.
+type ContainerMigrateOptions struct {
+	// Number of pre-checkpoints transferred while the container keeps
+	// running. Each pre-checkpoint only holds the memory changed since
+	// the previous one.
+	PreCheckpoints uint
+	IgnoreRootFS   bool
+	IgnoreVolumes  bool
+	TCPEstablished bool
+	FileLocks      bool
+}
+
+// ContainerMigrateReport describes the container restored on the
+// destination of the migration.
+type ContainerMigrateReport struct {
+	Id string //nolint:revive,stylecheck
+}
+
 type ContainerCreateReport struct {
 	Id string //nolint:revive,stylecheck
 }
@@ -448,6 +468,12 @@ type ContainerPortReport struct {
 	Ports []nettypes.PortMapping
 }
 
+// ContainerPortUpdateOptions describes the ports to add to or remove
+// from a container, in the format of --publish
+type ContainerPortUpdateOptions struct {
+	Ports []string
+}
+
 // ContainerCpOptions describes input options for cp.
 type ContainerCpOptions struct {
 	// Pause the container while copying.
@@ -497,7 +523,22 @@ type ContainerCloneOptions struct {
 }
 
 // ContainerUpdateOptions containers options for updating an existing containers cgroup configuration
+// and configuration
 type ContainerUpdateOptions struct {
 	NameOrID string
-	Specgen  *specgen.SpecGenerator
+	// Specgen holds the resource limits, nil leaves them untouched
+	Specgen *specgen.SpecGenerator
+	// RestartPolicy of the container, empty leaves it untouched
+	RestartPolicy string
+	// RestartRetries for the on-failure restart policy
+	RestartRetries *uint
+	// HealthConfig replaces the health check, a test of ["NONE"] disables it
+	HealthConfig *manifest.Schema2HealthConfig
+	// HealthOnFailure is the action taken once the container turns
+	// unhealthy, empty leaves it untouched
+	HealthOnFailure string
+	// Labels are added to the labels of the container
+	Labels map[string]string
+	// AutoUpdatePolicy of the container, empty leaves it untouched
+	AutoUpdatePolicy string
 }
@@ -34,9 +34,12 @@ type ContainerEngine interface { //nolint:interfacebloat
 	ContainerList(ctx context.Context, options ContainerListOptions) ([]ListContainer, error)
 	ContainerListExternal(ctx context.Context) ([]ListContainer, error)
 	ContainerLogs(ctx context.Context, containers []string, options ContainerLogsOptions) error
+	ContainerMigrate(ctx context.Context, nameOrID string, destination string, options ContainerMigrateOptions) (*ContainerMigrateReport, error)
 	ContainerMount(ctx context.Context, nameOrIDs []string, options ContainerMountOptions) ([]*ContainerMountReport, error)
 	ContainerPause(ctx context.Context, namesOrIds []string, options PauseUnPauseOptions) ([]*PauseUnpauseReport, error)
 	ContainerPort(ctx context.Context, nameOrID string, options ContainerPortOptions) ([]*ContainerPortReport, error)
+	ContainerPortAdd(ctx context.Context, nameOrID string, options ContainerPortUpdateOptions) (*ContainerPortReport, error)
+	ContainerPortRemove(ctx context.Context, nameOrID string, options ContainerPortUpdateOptions) error
 	ContainerPrune(ctx context.Context, options ContainerPruneOptions) ([]*reports.PruneReport, error)
 	ContainerRename(ctr context.Context, nameOrID string, options ContainerRenameOptions) error
 	ContainerRestart(ctx context.Context, namesOrIds []string, options RestartOptions) ([]*RestartReport, error)
@@ -59,6 +62,8 @@ type ContainerEngine interface { //nolint:interfacebloat
 	GenerateSystemd(ctx context.Context, nameOrID string, opts GenerateSystemdOptions) (*GenerateSystemdReport, error)
 	GenerateKube(ctx context.Context, nameOrIDs []string, opts GenerateKubeOptions) (*GenerateKubeReport, error)
 	SystemPrune(ctx context.Context, options SystemPruneOptions) (*SystemPruneReport, error)
+	HealthCheckList(ctx context.Context) ([]*HealthCheckListReport, error)
+	HealthCheckLogs(ctx context.Context, nameOrID string, options HealthCheckLogsOptions) error
 	HealthCheckRun(ctx context.Context, nameOrID string, options HealthCheckOptions) (*define.HealthCheckResults, error)
 	Info(ctx context.Context) (*define.Info, error)
 	KubeApply(ctx context.Context, body io.Reader, opts ApplyOptions) error
@@ -91,24 +96,35 @@ type ContainerEngine interface { //nolint:interfacebloat
 	PodStop(ctx context.Context, namesOrIds []string, options PodStopOptions) ([]*PodStopReport, error)
 	PodTop(ctx context.Context, options PodTopOptions) (*StringSliceReport, error)
 	PodUnpause(ctx context.Context, namesOrIds []string, options PodunpauseOptions) ([]*PodUnpauseReport, error)
+	PodUpdate(ctx context.Context, options *PodUpdateOptions) (*PodUpdateReport, error)
 	SetupRootless(ctx context.Context, noMoveProcess bool) error
 	SecretCreate(ctx context.Context, name string, reader io.Reader, options SecretCreateOptions) (*SecretCreateReport, error)
 	SecretInspect(ctx context.Context, nameOrIDs []string) ([]*SecretInfoReport, []error, error)
 	SecretList(ctx context.Context, opts SecretListRequest) ([]*SecretInfoReport, error)
 	SecretRm(ctx context.Context, nameOrID []string, opts SecretRmOptions) ([]*SecretRmReport, error)
 	SecretExists(ctx context.Context, nameOrID string) (*BoolReport, error)
+	SecretUpdate(ctx context.Context, nameOrID string, reader io.Reader, options SecretUpdateOptions) (*SecretUpdateReport, error)
 	Shutdown(ctx context.Context)
 	SystemDf(ctx context.Context, options SystemDfOptions) (*SystemDfReport, error)
 	Unshare(ctx context.Context, args []string, options SystemUnshareOptions) error
 	Version(ctx context.Context) (*SystemVersionReport, error)
+	VolumeClone(ctx context.Context, nameOrID string, opts VolumeCloneOptions) (*IDOrNameResponse, error)
 	VolumeCreate(ctx context.Context, opts VolumeCreateOptions) (*IDOrNameResponse, error)
 	VolumeExists(ctx context.Context, namesOrID string) (*BoolReport, error)
 	VolumeMounted(ctx context.Context, namesOrID string) (*BoolReport, error)
 	VolumeInspect(ctx context.Context, namesOrIds []string, opts InspectOptions) ([]*VolumeInspectReport, []error, error)
 	VolumeList(ctx context.Context, opts VolumeListOptions) ([]*VolumeListReport, error)
 	VolumeMount(ctx context.Context, namesOrIds []string) ([]*VolumeMountReport, error)
+	VolumePluginDisable(ctx context.Context, name string) error
+	VolumePluginEnable(ctx context.Context, name string) error
+	VolumePluginInspect(ctx context.Context, names []string) ([]*VolumePluginReport, []error, error)
+	VolumePluginList(ctx context.Context) ([]*VolumePluginReport, error)
 	VolumePrune(ctx context.Context, options VolumePruneOptions) ([]*reports.PruneReport, error)
 	VolumeRm(ctx context.Context, namesOrIds []string, opts VolumeRmOptions) ([]*VolumeRmReport, error)
+	VolumeSnapshotCreate(ctx context.Context, nameOrID string, opts VolumeSnapshotCreateOptions) (*VolumeSnapshotReport, error)
+	VolumeSnapshotList(ctx context.Context, nameOrID string) ([]*VolumeSnapshotReport, error)
+	VolumeSnapshotRestore(ctx context.Context, nameOrID string, snapshot string) error
+	VolumeSnapshotRm(ctx context.Context, nameOrID string, snapshots []string) ([]*VolumeSnapshotRmReport, error)
 	VolumeUnmount(ctx context.Context, namesOrIds []string) ([]*VolumeUnmountReport, error)
 	VolumeReload(ctx context.Context) (*VolumeReloadReport, error)
 }
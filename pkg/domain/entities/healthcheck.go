@@ -1,3 +1,38 @@
 package entities
 
-type HealthCheckOptions struct{}
+import (
+	"time"
+
+	"github.com/containers/podman/v4/libpod/define"
+)
+
+type HealthCheckOptions struct {
+	// Readiness runs the readiness check instead of the healthcheck
+	Readiness bool
+}
+
+// HealthCheckLogsOptions describe the options for reading the health check
+// history of a container
+type HealthCheckLogsOptions struct {
+	// Since only returns attempts started after the given time
+	Since string
+	// Follow waits for new attempts
+	Follow bool
+	// LogChan receives the attempts, it is closed when done
+	LogChan chan *define.HealthCheckLog
+}
+
+// HealthCheckListReport describes the health check state of a container
+type HealthCheckListReport struct {
+	// ID of the container
+	ID string
+	// Name of the container
+	Name string
+	// Status is starting, healthy or unhealthy
+	Status string
+	// FailingStreak is the number of consecutive failed health checks
+	FailingStreak int
+	// NextRun is the time the health check is expected to run next, zero
+	// if the container is not running
+	NextRun time.Time
+}
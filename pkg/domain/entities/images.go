@@ -2,7 +2,6 @@ package entities
 
 import (
 	"io"
-	"net/url"
 	"time"
 
 	"github.com/containers/common/pkg/config"
@@ -376,8 +375,6 @@ type ImageSaveOptions struct {
 type ImageScpOptions struct {
 	// Remote determines if this entity is operating on a remote machine
 	Remote bool `json:"remote,omitempty"`
-	// File is the input/output file for the save and load Operation
-	File string `json:"file,omitempty"`
 	// Quiet Determines if the save and load operation will be done quietly
 	Quiet bool `json:"quiet,omitempty"`
 	// Image is the image the user is providing to save and load
@@ -388,16 +385,6 @@ type ImageScpOptions struct {
 	Tag string `json:"tag,omitempty"`
 }
 
-// ImageScpConnections provides the ssh related information used in remote image transfer
-type ImageScpConnections struct {
-	// Connections holds the raw string values for connections (ssh or unix)
-	Connections []string
-	// URI contains the ssh connection URLs to be used by the client
-	URI []*url.URL
-	// Identities contains ssh identity keys to be used by the client
-	Identities []string
-}
-
 // ImageTreeOptions provides options for ImageEngine.Tree()
 type ImageTreeOptions struct {
 	WhatRequires bool // Show all child images and layers of the specified image
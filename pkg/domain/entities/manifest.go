@@ -37,6 +37,16 @@ type ManifestAddOptions struct {
 	Username string `json:"-" schema:"-"`
 	// Images is an optional list of images to add to manifest list
 	Images []string `json:"images" schema:"images"`
+	// Artifact adds the files given instead of images as OCI artifacts
+	Artifact bool `json:"-" schema:"-"`
+	// ArtifactType is the artifact type of the added artifacts
+	ArtifactType string `json:"-" schema:"-"`
+	// ArtifactLayerType is the media type of the files added as artifacts
+	ArtifactLayerType string `json:"-" schema:"-"`
+	// ArtifactSubject is the image or instance digest the artifacts refer to
+	ArtifactSubject string `json:"-" schema:"-"`
+	// ArtifactAnnotations are set on the manifests of the artifacts
+	ArtifactAnnotations map[string]string `json:"-" schema:"-"`
 }
 
 // ManifestAnnotateOptions provides model for annotating manifest list
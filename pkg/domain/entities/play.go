@@ -92,6 +92,27 @@ type PlayKubeVolume struct {
 	Name string
 }
 
+// PlayKubeCronJob represents the systemd timer created by play kube to run
+// the job of a CronJob.
+type PlayKubeCronJob struct {
+	// Name - Name of the CronJob.
+	Name string
+	// Timer - Name of the systemd timer running the job.
+	Timer string
+	// OnCalendar - Schedule of the CronJob in systemd calendar format, one
+	// entry for each OnCalendar= line of the timer.
+	OnCalendar []string
+	// Enabled - false if the CronJob is suspended.
+	Enabled bool
+}
+
+// PlayKubeCronJobRmReport contains the result of removing the systemd timer
+// of a CronJob.
+type PlayKubeCronJobRmReport struct {
+	Name string
+	Err  error
+}
+
 // PlayKubeReport contains the results of running play kube.
 type PlayKubeReport struct {
 	// Pods - pods created by play kube.
@@ -101,6 +122,8 @@ type PlayKubeReport struct {
 	PlayKubeTeardown
 	// Secrets - secrets created by play kube
 	Secrets []PlaySecret
+	// CronJobs - systemd timers created by play kube
+	CronJobs []PlayKubeCronJob
 	// ServiceContainerID - ID of the service container if one is created
 	ServiceContainerID string
 	// If set, exit with the specified exit code.
@@ -117,10 +140,11 @@ type PlayKubeDownOptions struct {
 
 // PlayKubeDownReport contains the results of tearing down play kube
 type PlayKubeTeardown struct {
-	StopReport     []*PodStopReport
-	RmReport       []*PodRmReport
-	VolumeRmReport []*VolumeRmReport
-	SecretRmReport []*SecretRmReport
+	StopReport      []*PodStopReport
+	RmReport        []*PodRmReport
+	VolumeRmReport  []*VolumeRmReport
+	SecretRmReport  []*SecretRmReport
+	CronJobRmReport []*PlayKubeCronJobRmReport
 }
 
 type PlaySecret struct {
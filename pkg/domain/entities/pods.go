@@ -255,6 +255,7 @@ type ContainerCreateOptions struct {
 	ReadOnly           bool
 	ReadWriteTmpFS     bool
 	Restart            string
+	RestartDependents  bool
 	Replace            bool
 	Requires           []string
 	Rm                 bool
@@ -326,6 +327,22 @@ type PodCloneReport struct {
 	Id string //nolint:revive,stylecheck
 }
 
+// PodUpdateOptions contains the options for updating an existing pod's
+// resource limits and restart policy
+type PodUpdateOptions struct {
+	NameOrID string
+	// Specgen holds the resource limits to update, nil leaves them untouched
+	Specgen *specgen.SpecGenerator
+	// RestartPolicy of the pod and its containers, empty leaves it untouched
+	RestartPolicy string
+	// RestartRetries for the on-failure restart policy
+	RestartRetries *uint
+}
+
+type PodUpdateReport struct {
+	Id string //nolint:revive,stylecheck
+}
+
 func (p *PodCreateOptions) CPULimits() *specs.LinuxCPU {
 	cpu := &specs.LinuxCPU{}
 	hasLimits := false
@@ -37,6 +37,20 @@ type SecretRmReport struct {
 	Err error
 }
 
+type SecretUpdateOptions struct {
+	// RestartContainers restarts the running containers referencing the secret
+	RestartContainers bool
+}
+
+type SecretUpdateReport struct {
+	// ID of the updated secret
+	ID string
+	// Containers lists the IDs of all containers referencing the secret
+	Containers []string
+	// Restarted lists the IDs of the containers that were restarted
+	Restarted []string `json:",omitempty"`
+}
+
 type SecretInfoReport struct {
 	ID        string
 	CreatedAt time.Time
@@ -85,6 +99,15 @@ type SwagSecretCreateResponse struct {
 	}
 }
 
+// Secret update response
+// swagger:response SecretUpdateResponse
+type SwagSecretUpdateResponse struct {
+	// in:body
+	Body struct {
+		SecretUpdateReport
+	}
+}
+
 // Secret list response
 // swagger:response SecretListResponse
 type SwagSecretListResponse struct {
@@ -10,10 +10,14 @@ import (
 
 // ServiceOptions provides the input for starting an API and sidecar pprof services
 type ServiceOptions struct {
-	CorsHeaders string        // Cross-Origin Resource Sharing (CORS) headers
-	PProfAddr   string        // Network address to bind pprof profiles service
-	Timeout     time.Duration // Duration of inactivity the service should wait before shutting down
-	URI         string        // Path to unix domain socket service should listen on
+	CorsHeaders     string        // Cross-Origin Resource Sharing (CORS) headers
+	Metrics         bool          // Expose Prometheus metrics on /metrics
+	PProfAddr       string        // Network address to bind pprof profiles service
+	Timeout         time.Duration // Duration of inactivity the service should wait before shutting down
+	TLSCertFile     string        // Path to the TLS certificate served by a tcp service
+	TLSKeyFile      string        // Path to the private key of the TLS certificate
+	TLSClientCAFile string        // Path to the CA bundle used to verify client certificates
+	URI             string        // Path to unix domain socket service should listen on
 }
 
 // SystemPruneOptions provides options to prune system.
@@ -37,7 +41,15 @@ type SystemPruneReport struct {
 // SystemMigrateOptions describes the options needed for the
 // cli to migrate runtimes of containers
 type SystemMigrateOptions struct {
-	NewRuntime string
+	NewRuntime      string
+	DatabaseBackend string
+}
+
+// SystemMigrateReport describes the result of a system migrate
+type SystemMigrateReport struct {
+	// DatabaseBackup is the path the old database was moved to, if the
+	// database was migrated to a new backend
+	DatabaseBackup string
 }
 
 // SystemDfOptions describes the options for getting df information
@@ -2,6 +2,7 @@ package entities
 
 import (
 	"net/url"
+	"time"
 
 	"github.com/containers/podman/v4/libpod/define"
 )
@@ -61,6 +62,40 @@ type VolumeReloadReport struct {
 	define.VolumeReload
 }
 
+// VolumePluginReport describes a volume plugin
+type VolumePluginReport struct {
+	define.VolumePlugin
+}
+
+// VolumeCloneOptions describes the options to clone a volume
+type VolumeCloneOptions struct {
+	// Name of the new volume
+	Name string
+}
+
+// VolumeSnapshotCreateOptions describes the options to create a snapshot
+// of a volume
+type VolumeSnapshotCreateOptions struct {
+	// Name of the snapshot, a random name is generated if empty
+	Name string
+}
+
+// VolumeSnapshotReport describes a snapshot of a volume
+type VolumeSnapshotReport struct {
+	// Volume is the name of the snapshotted volume
+	Volume string
+	// Name of the snapshot
+	Name string
+	// CreatedAt is the time the snapshot was created
+	CreatedAt time.Time
+}
+
+// VolumeSnapshotRmReport describes the response from removing a snapshot
+type VolumeSnapshotRmReport struct {
+	Err  error
+	Name string
+}
+
 /*
  * Docker API compatibility types
  */
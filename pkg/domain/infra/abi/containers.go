@@ -11,12 +11,14 @@ import (
 	"time"
 
 	"github.com/containers/buildah"
+	nettypes "github.com/containers/common/libnetwork/types"
 	"github.com/containers/common/pkg/cgroups"
 	"github.com/containers/common/pkg/config"
 	"github.com/containers/image/v5/manifest"
 	"github.com/containers/podman/v4/libpod"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/libpod/logs"
+	"github.com/containers/podman/v4/pkg/autoupdate"
 	"github.com/containers/podman/v4/pkg/checkpoint"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/containers/podman/v4/pkg/domain/entities/reports"
@@ -1501,6 +1503,60 @@ func (ic *ContainerEngine) ContainerPort(ctx context.Context, nameOrID string, o
 	return reports, nil
 }
 
+// parsePortUpdate parses the ports in the format of --publish and normalizes
+// them the same way as on container creation.
+func parsePortUpdate(ports []string) ([]nettypes.PortMapping, error) {
+	if len(ports) == 0 {
+		return nil, fmt.Errorf("no ports given: %w", define.ErrInvalidArg)
+	}
+	portMappings, err := specgenutil.CreatePortBindings(ports)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, define.ErrInvalidArg)
+	}
+	return portMappings, nil
+}
+
+func (ic *ContainerEngine) ContainerPortAdd(ctx context.Context, nameOrID string, options entities.ContainerPortUpdateOptions) (*entities.ContainerPortReport, error) {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	portMappings, err := parsePortUpdate(options.Ports)
+	if err != nil {
+		return nil, err
+	}
+	// Host ports which are not given are assigned randomly.
+	portMappings, err = generate.ParsePortMapping(portMappings, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", err, define.ErrInvalidArg)
+	}
+	if err := ctr.AddPortMappings(portMappings); err != nil {
+		return nil, err
+	}
+	return &entities.ContainerPortReport{Id: ctr.ID(), Ports: portMappings}, nil
+}
+
+func (ic *ContainerEngine) ContainerPortRemove(ctx context.Context, nameOrID string, options entities.ContainerPortUpdateOptions) error {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+	portMappings, err := parsePortUpdate(options.Ports)
+	if err != nil {
+		return err
+	}
+	for _, port := range portMappings {
+		if port.HostPort == 0 {
+			return fmt.Errorf("the host port of container port %d must be given to remove it: %w", port.ContainerPort, define.ErrInvalidArg)
+		}
+	}
+	portMappings, err = generate.ParsePortMapping(portMappings, nil)
+	if err != nil {
+		return fmt.Errorf("%v: %w", err, define.ErrInvalidArg)
+	}
+	return ctr.RemovePortMappings(portMappings)
+}
+
 // Shutdown Libpod engine
 func (ic *ContainerEngine) Shutdown(_ context.Context) {
 	shutdownSync.Do(func() {
@@ -1726,16 +1782,46 @@ func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts enti
 	return &entities.ContainerCreateReport{Id: ctr.ID()}, nil
 }
 
-// ContainerUpdate finds and updates the given container's cgroup config with the specified options
+// ContainerUpdate finds and updates the given container's cgroup config and configuration with the specified options
 func (ic *ContainerEngine) ContainerUpdate(ctx context.Context, updateOptions *entities.ContainerUpdateOptions) (string, error) {
-	err := specgen.WeightDevices(updateOptions.Specgen)
-	if err != nil {
-		return "", err
+	options := &libpod.ContainerUpdateOptions{
+		HealthCheckConfig: updateOptions.HealthConfig,
 	}
-	err = specgen.FinishThrottleDevices(updateOptions.Specgen)
-	if err != nil {
-		return "", err
+	if updateOptions.Specgen != nil {
+		err := specgen.WeightDevices(updateOptions.Specgen)
+		if err != nil {
+			return "", err
+		}
+		err = specgen.FinishThrottleDevices(updateOptions.Specgen)
+		if err != nil {
+			return "", err
+		}
+		options.Resources = updateOptions.Specgen.ResourceLimits
+	}
+	if updateOptions.RestartPolicy != "" {
+		options.RestartPolicy = &updateOptions.RestartPolicy
+	}
+	options.RestartRetries = updateOptions.RestartRetries
+	if updateOptions.HealthOnFailure != "" {
+		action, err := define.ParseHealthCheckOnFailureAction(updateOptions.HealthOnFailure)
+		if err != nil {
+			return "", err
+		}
+		options.HealthCheckOnFailureAction = &action
+	}
+	if len(updateOptions.Labels) > 0 || updateOptions.AutoUpdatePolicy != "" {
+		options.Labels = make(map[string]string, len(updateOptions.Labels)+1)
+		for k, v := range updateOptions.Labels {
+			options.Labels[k] = v
+		}
+		if updateOptions.AutoUpdatePolicy != "" {
+			if _, err := autoupdate.LookupPolicy(updateOptions.AutoUpdatePolicy); err != nil {
+				return "", err
+			}
+			options.Labels[define.AutoUpdateLabel] = updateOptions.AutoUpdatePolicy
+		}
 	}
+
 	containers, err := getContainers(ic.Libpod, getContainersOptions{names: []string{updateOptions.NameOrID}})
 	if err != nil {
 		return "", err
@@ -1744,7 +1830,7 @@ func (ic *ContainerEngine) ContainerUpdate(ctx context.Context, updateOptions *e
 		return "", fmt.Errorf("container not found")
 	}
 
-	if err = containers[0].Update(updateOptions.Specgen.ResourceLimits); err != nil {
+	if err = containers[0].Update(options); err != nil {
 		return "", err
 	}
 	return containers[0].ID(), nil
@@ -0,0 +1,209 @@
+package abi
+
+import (
+	"archive/tar"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/common/pkg/config"
+	"github.com/containers/podman/v4/libpod"
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/pkg/bindings"
+	"github.com/containers/podman/v4/pkg/bindings/containers"
+	"github.com/containers/podman/v4/pkg/bindings/images"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/containers/storage/pkg/archive"
+	"github.com/sirupsen/logrus"
+)
+
+// ContainerMigrate moves a running container to the host of the given system
+// connection. Pre-checkpoints are created and streamed to the destination
+// while the container keeps running, followed by the final checkpoint which
+// only holds the memory changed since the last pre-checkpoint. The container
+// is restored on the destination and removed locally on success. Otherwise,
+// the container is restored locally.
+func (ic *ContainerEngine) ContainerMigrate(ctx context.Context, nameOrID string, destination string, options entities.ContainerMigrateOptions) (*entities.ContainerMigrateReport, error) {
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	state, err := ctr.State()
+	if err != nil {
+		return nil, err
+	}
+	if state != define.ContainerStateRunning {
+		return nil, fmt.Errorf("container %s is %s, only running containers can be migrated: %w", ctr.ID(), state, define.ErrCtrStateInvalid)
+	}
+
+	cfg, err := config.ReadCustomConfig()
+	if err != nil {
+		return nil, err
+	}
+	dest, ok := cfg.Engine.ServiceDestinations[destination]
+	if !ok {
+		return nil, fmt.Errorf("system connection %q not found: %w", destination, define.ErrInvalidArg)
+	}
+	connCtx, err := bindings.NewConnectionWithIdentity(ctx, dest.URI, dest.Identity, dest.IsMachine)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to %s: %w", destination, err)
+	}
+
+	dir, err := os.MkdirTemp("", "podman-migrate")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ic.migrateImage(ctx, connCtx, ctr, dir); err != nil {
+		return nil, err
+	}
+
+	// Stream the checkpoints to the destination while they are created.
+	reader, writer := io.Pipe()
+	type restoreResult struct {
+		report *entities.RestoreReport
+		err    error
+	}
+	restoreDone := make(chan restoreResult, 1)
+	go func() {
+		restoreOptions := new(containers.RestoreOptions).
+			WithIgnoreRootfs(options.IgnoreRootFS).
+			WithIgnoreVolumes(options.IgnoreVolumes).
+			WithTCPEstablished(options.TCPEstablished).
+			WithFileLocks(options.FileLocks)
+		report, err := containers.RestoreWithPrevious(connCtx, reader, restoreOptions)
+		// Unblock the writer if the destination failed early.
+		reader.CloseWithError(err)
+		restoreDone <- restoreResult{report: report, err: err}
+	}()
+
+	checkpointed := false
+	tw := tar.NewWriter(writer)
+	err = func() error {
+		for i := uint(1); i <= options.PreCheckpoints; i++ {
+			logrus.Debugf("Creating pre-checkpoint %d of container %s", i, ctr.ID())
+			checkpointOptions := libpod.ContainerCheckpointOptions{
+				PreCheckPoint: true,
+				WithPrevious:  i > 1,
+				IgnoreRootfs:  true,
+				IgnoreVolumes: true,
+				Compression:   archive.Zstd,
+				TargetFile:    filepath.Join(dir, fmt.Sprintf("pre-checkpoint-%d.tar.zst", i)),
+			}
+			if _, _, err := ctr.Checkpoint(ctx, checkpointOptions); err != nil {
+				return fmt.Errorf("creating pre-checkpoint %d: %w", i, err)
+			}
+			if err := addFileToTar(tw, checkpointOptions.TargetFile); err != nil {
+				return err
+			}
+		}
+
+		logrus.Debugf("Creating checkpoint of container %s", ctr.ID())
+		checkpointOptions := libpod.ContainerCheckpointOptions{
+			// Keep the checkpoint to restore the container locally
+			// if the migration fails.
+			Keep:           true,
+			WithPrevious:   options.PreCheckpoints > 0,
+			IgnoreRootfs:   options.IgnoreRootFS,
+			IgnoreVolumes:  options.IgnoreVolumes,
+			TCPEstablished: options.TCPEstablished,
+			FileLocks:      options.FileLocks,
+			Compression:    archive.Zstd,
+			TargetFile:     filepath.Join(dir, "checkpoint.tar.zst"),
+		}
+		if _, _, err := ctr.Checkpoint(ctx, checkpointOptions); err != nil {
+			return fmt.Errorf("creating checkpoint: %w", err)
+		}
+		checkpointed = true
+		if err := addFileToTar(tw, checkpointOptions.TargetFile); err != nil {
+			return err
+		}
+		return tw.Close()
+	}()
+	writer.CloseWithError(err)
+	result := <-restoreDone
+
+	if err == nil && result.err != nil {
+		err = fmt.Errorf("restoring container on %s: %w", destination, result.err)
+	}
+	if err != nil {
+		if checkpointed {
+			restoreOptions := libpod.ContainerCheckpointOptions{
+				TCPEstablished: options.TCPEstablished,
+				FileLocks:      options.FileLocks,
+			}
+			if _, _, restoreErr := ctr.Restore(ctx, restoreOptions); restoreErr != nil {
+				logrus.Errorf("Restoring container %s after failed migration: %v", ctr.ID(), restoreErr)
+			}
+		}
+		return nil, err
+	}
+
+	if err := ic.Libpod.RemoveContainer(ctx, ctr, true, false, nil); err != nil {
+		return nil, fmt.Errorf("removing container %s after migration: %w", ctr.ID(), err)
+	}
+	return &entities.ContainerMigrateReport{Id: result.report.Id}, nil
+}
+
+// migrateImage transfers the image of the container to the destination
+// unless it is already present there.
+func (ic *ContainerEngine) migrateImage(ctx, connCtx context.Context, ctr *libpod.Container, dir string) error {
+	imageID, imageName := ctr.Image()
+	exists, err := images.Exists(connCtx, imageID, nil)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return nil
+	}
+
+	// The name is required to find the image when restoring the container.
+	if imageName == "" {
+		imageName = imageID
+	}
+	path := filepath.Join(dir, "image.tar")
+	if err := ic.Libpod.LibimageRuntime().Save(ctx, []string{imageName}, "docker-archive", path, nil); err != nil {
+		return fmt.Errorf("saving image %s: %w", imageName, err)
+	}
+	defer os.Remove(path)
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if _, err := images.Load(connCtx, f); err != nil {
+		return fmt.Errorf("loading image %s on destination: %w", imageName, err)
+	}
+	return nil
+}
+
+// addFileToTar adds the file to the tar stream and removes it afterwards.
+func addFileToTar(tw *tar.Writer, path string) error {
+	defer os.Remove(path)
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	header := &tar.Header{
+		Name:     filepath.Base(path),
+		Mode:     0600,
+		Size:     info.Size(),
+		Typeflag: tar.TypeReg,
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err = io.Copy(tw, f)
+	return err
+}
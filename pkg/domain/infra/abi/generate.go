@@ -220,27 +220,12 @@ func (ic *ContainerEngine) GenerateKube(ctx context.Context, nameOrIDs []string,
 			content = append(content, []byte(warning))
 		}
 
-		// Create a pod or deployment kind depending on what Type was requested by the user
-		switch options.Type {
-		case define.K8sKindDeployment:
-			dep, err := libpod.GenerateForKubeDeployment(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
-			if err != nil {
-				return nil, err
-			}
-			b, err := generateKubeYAML(dep)
-			if err != nil {
-				return nil, err
-			}
-			typeContent = append(typeContent, b)
-		case define.K8sKindPod:
-			b, err := generateKubeYAML(libpod.ConvertV1PodToYAMLPod(po))
-			if err != nil {
-				return nil, err
-			}
-			typeContent = append(typeContent, b)
-		default:
-			return nil, fmt.Errorf("invalid generation type - only pods and deployments are currently supported")
+		// Create a pod, deployment, daemonset or job kind depending on what Type was requested by the user
+		b, err := generateKubeKind(ctx, po, options)
+		if err != nil {
+			return nil, err
 		}
+		typeContent = append(typeContent, b)
 
 		if options.Service {
 			svc, err := libpod.GenerateKubeServiceFromV1Pod(po, []k8sAPI.ServicePort{})
@@ -278,26 +263,11 @@ func getKubePods(ctx context.Context, pods []*libpod.Pod, options entities.Gener
 			return nil, nil, err
 		}
 
-		switch options.Type {
-		case define.K8sKindDeployment:
-			dep, err := libpod.GenerateForKubeDeployment(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
-			if err != nil {
-				return nil, nil, err
-			}
-			b, err := generateKubeYAML(dep)
-			if err != nil {
-				return nil, nil, err
-			}
-			out = append(out, b)
-		case define.K8sKindPod:
-			b, err := generateKubeYAML(libpod.ConvertV1PodToYAMLPod(po))
-			if err != nil {
-				return nil, nil, err
-			}
-			out = append(out, b)
-		default:
-			return nil, nil, fmt.Errorf("invalid generation type - only pods and deployments are currently supported")
+		b, err := generateKubeKind(ctx, po, options)
+		if err != nil {
+			return nil, nil, err
 		}
+		out = append(out, b)
 
 		if options.Service {
 			svc, err := libpod.GenerateKubeServiceFromV1Pod(po, sp)
@@ -315,6 +285,35 @@ func getKubePods(ctx context.Context, pods []*libpod.Pod, options entities.Gener
 	return out, svcs, nil
 }
 
+// generateKubeKind returns the YAML of the kube kind requested by the user
+// wrapping the given pod.
+func generateKubeKind(ctx context.Context, po *k8sAPI.Pod, options entities.GenerateKubeOptions) ([]byte, error) {
+	switch options.Type {
+	case define.K8sKindDeployment:
+		dep, err := libpod.GenerateForKubeDeployment(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
+		if err != nil {
+			return nil, err
+		}
+		return generateKubeYAML(dep)
+	case define.K8sKindDaemonSet:
+		ds, err := libpod.GenerateForKubeDaemonSet(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
+		if err != nil {
+			return nil, err
+		}
+		return generateKubeYAML(ds)
+	case define.K8sKindJob:
+		job, err := libpod.GenerateForKubeJob(ctx, libpod.ConvertV1PodToYAMLPod(po), options)
+		if err != nil {
+			return nil, err
+		}
+		return generateKubeYAML(job)
+	case define.K8sKindPod:
+		return generateKubeYAML(libpod.ConvertV1PodToYAMLPod(po))
+	default:
+		return nil, fmt.Errorf("invalid generation type - only pods, deployments, daemonsets and jobs are currently supported")
+	}
+}
+
 // getKubePVCs returns kube persistent volume claim YAML files from podman volumes.
 func getKubePVCs(volumes []*libpod.Volume) ([][]byte, error) {
 	pvs := [][]byte{}
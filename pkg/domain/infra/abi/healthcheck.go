@@ -2,12 +2,30 @@ package abi
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/containers/podman/v4/libpod"
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/containers/podman/v4/pkg/util"
 )
 
 func (ic *ContainerEngine) HealthCheckRun(ctx context.Context, nameOrID string, options entities.HealthCheckOptions) (*define.HealthCheckResults, error) {
+	if options.Readiness {
+		if _, err := ic.Libpod.ReadinessCheck(ctx, nameOrID); err != nil {
+			return nil, err
+		}
+		ctr, err := ic.Libpod.LookupContainer(nameOrID)
+		if err != nil {
+			return nil, err
+		}
+		readiness, err := ctr.ReadinessStatus()
+		if err != nil {
+			return nil, err
+		}
+		return &define.HealthCheckResults{Status: readiness}, nil
+	}
 	status, err := ic.Libpod.HealthCheck(ctx, nameOrID)
 	if err != nil {
 		return nil, err
@@ -23,3 +41,78 @@ func (ic *ContainerEngine) HealthCheckRun(ctx context.Context, nameOrID string,
 	}
 	return &report, nil
 }
+
+// HealthCheckLogs sends the health check history of the container to the log
+// channel.  If options.Follow is set, new attempts are sent until the context
+// is cancelled or the container is removed.
+func (ic *ContainerEngine) HealthCheckLogs(ctx context.Context, nameOrID string, options entities.HealthCheckLogsOptions) error {
+	defer close(options.LogChan)
+	ctr, err := ic.Libpod.LookupContainer(nameOrID)
+	if err != nil {
+		return err
+	}
+	var since time.Time
+	if options.Since != "" {
+		since, err = util.ParseInputTime(options.Since, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	for {
+		history, err := ctr.HealthCheckHistory(since)
+		if err != nil {
+			if options.Follow && (errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved)) {
+				return nil
+			}
+			return err
+		}
+		for i := range history {
+			if start, err := time.Parse(time.RFC3339Nano, history[i].Start); err == nil {
+				since = start
+			}
+			select {
+			case <-ctx.Done():
+				return nil
+			case options.LogChan <- &history[i]:
+			}
+		}
+		if !options.Follow {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// HealthCheckList returns the health check state of all containers with a
+// health check.
+func (ic *ContainerEngine) HealthCheckList(ctx context.Context) ([]*entities.HealthCheckListReport, error) {
+	ctrs, err := ic.Libpod.GetContainers(false, func(c *libpod.Container) bool {
+		return c.HasHealthCheck()
+	})
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*entities.HealthCheckListReport, 0, len(ctrs))
+	for _, ctr := range ctrs {
+		results, nextRun, err := ctr.HealthCheckState()
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+				continue
+			}
+			return nil, err
+		}
+		reports = append(reports, &entities.HealthCheckListReport{
+			ID:            ctr.ID(),
+			Name:          ctr.Name(),
+			Status:        results.Status,
+			FailingStreak: results.FailingStreak,
+			NextRun:       nextRun,
+		})
+	}
+	return reports, nil
+}
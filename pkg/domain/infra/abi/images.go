@@ -7,17 +7,12 @@ import (
 	"io/fs"
 	"net/url"
 	"os"
-	"os/exec"
-	"os/user"
 	"path"
 	"path/filepath"
 	"strconv"
-	"strings"
-	"syscall"
 
 	"github.com/containers/common/libimage"
 	"github.com/containers/common/pkg/config"
-	"github.com/containers/common/pkg/ssh"
 	"github.com/containers/image/v5/docker"
 	"github.com/containers/image/v5/docker/reference"
 	"github.com/containers/image/v5/manifest"
@@ -25,7 +20,6 @@ import (
 	"github.com/containers/image/v5/signature"
 	"github.com/containers/image/v5/transports"
 	"github.com/containers/image/v5/transports/alltransports"
-	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/containers/podman/v4/pkg/domain/entities/reports"
 	domainUtils "github.com/containers/podman/v4/pkg/domain/utils"
@@ -88,6 +82,10 @@ func (ir *ImageEngine) Prune(ctx context.Context, opts entities.ImagePruneOption
 		numPreviouslyRemovedImages = numRemovedImages
 	}
 
+	// Remove the artifacts of the pruned manifest lists, and of lists
+	// removed by other tools.
+	pruneManifestArtifacts(ir.Libpod)
+
 	return pruneReports, nil
 }
 
@@ -586,6 +584,7 @@ func (ir *ImageEngine) Remove(ctx context.Context, images []string, opts entitie
 	for _, r := range libimageReport {
 		if r.Removed {
 			report.Deleted = append(report.Deleted, r.ID)
+			removeManifestArtifacts(ir.Libpod, r.ID)
 		}
 		report.Untagged = append(report.Untagged, r.Untagged...)
 	}
@@ -693,188 +692,6 @@ func (ir *ImageEngine) Sign(ctx context.Context, names []string, options entitie
 	return nil, nil
 }
 
-func (ir *ImageEngine) Scp(ctx context.Context, src, dst string, parentFlags []string, quiet bool, sshMode ssh.EngineMode) error {
-	rep, source, dest, flags, err := domainUtils.ExecuteTransfer(src, dst, parentFlags, quiet, sshMode)
-	if err != nil {
-		return err
-	}
-	if (rep == nil && err == nil) && (source != nil && dest != nil) { // we need to execute the transfer
-		err := Transfer(ctx, *source, *dest, flags)
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func Transfer(ctx context.Context, source entities.ImageScpOptions, dest entities.ImageScpOptions, parentFlags []string) error {
-	if source.User == "" {
-		return fmt.Errorf("you must define a user when transferring from root to rootless storage: %w", define.ErrInvalidArg)
-	}
-	podman, err := os.Executable()
-	if err != nil {
-		return err
-	}
-	if rootless.IsRootless() && (len(dest.User) == 0 || dest.User == "root") { // if we are rootless and do not have a destination user we can just use sudo
-		return transferRootless(source, dest, podman, parentFlags)
-	}
-	return transferRootful(source, dest, podman, parentFlags)
-}
-
-// TransferRootless creates new podman processes using exec.Command and sudo, transferring images between the given source and destination users
-func transferRootless(source entities.ImageScpOptions, dest entities.ImageScpOptions, podman string, parentFlags []string) error {
-	var cmdSave *exec.Cmd
-	saveCommand, loadCommand := parentFlags, parentFlags
-	saveCommand = append(saveCommand, []string{"save"}...)
-	loadCommand = append(loadCommand, []string{"load"}...)
-	if source.Quiet {
-		saveCommand = append(saveCommand, "-q")
-		loadCommand = append(loadCommand, "-q")
-	}
-
-	saveCommand = append(saveCommand, []string{"--output", source.File, source.Image}...)
-
-	loadCommand = append(loadCommand, []string{"--input", dest.File}...)
-
-	if source.User == "root" {
-		cmdSave = exec.Command("sudo", podman)
-	} else {
-		cmdSave = exec.Command(podman)
-	}
-	cmdSave = domainUtils.CreateSCPCommand(cmdSave, saveCommand)
-	logrus.Debugf("Executing save command: %q", cmdSave)
-	err := cmdSave.Run()
-	if err != nil {
-		return err
-	}
-
-	var cmdLoad *exec.Cmd
-	if source.User != "root" {
-		cmdLoad = exec.Command("sudo", podman)
-	} else {
-		cmdLoad = exec.Command(podman)
-	}
-	cmdLoad = domainUtils.CreateSCPCommand(cmdLoad, loadCommand)
-	logrus.Debugf("Executing load command: %q", cmdLoad)
-	if len(dest.Tag) > 0 {
-		return domainUtils.ScpTag(cmdLoad, podman, dest)
-	}
-	return cmdLoad.Run()
-}
-
-// transferRootful creates new podman processes using exec.Command and a new uid/gid alongside a cleared environment
-func transferRootful(source entities.ImageScpOptions, dest entities.ImageScpOptions, podman string, parentFlags []string) error {
-	basicCommand := make([]string, 0, len(parentFlags)+1)
-	basicCommand = append(basicCommand, podman)
-	basicCommand = append(basicCommand, parentFlags...)
-
-	saveCommand := make([]string, 0, len(basicCommand)+4)
-	saveCommand = append(saveCommand, basicCommand...)
-	saveCommand = append(saveCommand, "save")
-
-	loadCommand := make([]string, 0, len(basicCommand)+3)
-	loadCommand = append(loadCommand, basicCommand...)
-	loadCommand = append(loadCommand, "load")
-	if source.Quiet {
-		saveCommand = append(saveCommand, "-q")
-		loadCommand = append(loadCommand, "-q")
-	}
-	saveCommand = append(saveCommand, []string{"--output", source.File, source.Image}...)
-	loadCommand = append(loadCommand, []string{"--input", dest.File}...)
-
-	// if executing using sudo or transferring between two users, the TransferRootless approach will not work, the new process needs to be set up
-	// with the proper uid and gid as well as environmental variables.
-	var uSave *user.User
-	var uLoad *user.User
-	var err error
-	source.User = strings.Split(source.User, ":")[0] // split in case provided with uid:gid
-	dest.User = strings.Split(dest.User, ":")[0]
-	uSave, err = lookupUser(source.User)
-	if err != nil {
-		return err
-	}
-	switch {
-	case dest.User != "": // if we are given a destination user, check that first
-		uLoad, err = lookupUser(dest.User)
-		if err != nil {
-			return err
-		}
-	case uSave.Name != "root": // else if we have no destination user, and source is not root that means we should be root
-		uLoad, err = user.LookupId("0")
-		if err != nil {
-			return err
-		}
-	default: // else if we have no dest user, and source user IS root, we want to be the default user.
-		uString := os.Getenv("SUDO_USER")
-		if uString == "" {
-			return errors.New("$SUDO_USER must be defined to find the default rootless user")
-		}
-		uLoad, err = user.Lookup(uString)
-		if err != nil {
-			return err
-		}
-	}
-	_, err = execTransferPodman(uSave, saveCommand, false)
-	if err != nil {
-		return err
-	}
-	out, err := execTransferPodman(uLoad, loadCommand, (len(dest.Tag) > 0))
-	if err != nil {
-		return err
-	}
-	if out != nil {
-		image := domainUtils.ExtractImage(out)
-		_, err := execTransferPodman(uLoad, []string{podman, "tag", image, dest.Tag}, false)
-		return err
-	}
-	return nil
-}
-
-func lookupUser(u string) (*user.User, error) {
-	if u, err := user.LookupId(u); err == nil {
-		return u, nil
-	}
-	return user.Lookup(u)
-}
-
-func execTransferPodman(execUser *user.User, command []string, needToTag bool) ([]byte, error) {
-	cmdLogin, err := domainUtils.LoginUser(execUser.Username)
-	if err != nil {
-		return nil, err
-	}
-
-	defer func() {
-		_ = cmdLogin.Process.Kill()
-		_ = cmdLogin.Wait()
-	}()
-
-	cmd := exec.Command(command[0], command[1:]...)
-	cmd.Env = []string{"PATH=" + os.Getenv("PATH"), "TERM=" + os.Getenv("TERM")}
-	cmd.Stderr = os.Stderr
-	cmd.Stdout = os.Stdout
-	uid, err := strconv.ParseInt(execUser.Uid, 10, 32)
-	if err != nil {
-		return nil, err
-	}
-	gid, err := strconv.ParseInt(execUser.Gid, 10, 32)
-	if err != nil {
-		return nil, err
-	}
-	cmd.SysProcAttr = &syscall.SysProcAttr{
-		Credential: &syscall.Credential{
-			Uid:         uint32(uid),
-			Gid:         uint32(gid),
-			Groups:      nil,
-			NoSetGroups: false,
-		},
-	}
-	if needToTag {
-		cmd.Stdout = nil
-		return cmd.Output()
-	}
-	return nil, cmd.Run()
-}
-
 func getSigFilename(sigStoreDirPath string) (string, error) {
 	sigFileSuffix := 1
 	sigFiles, err := os.ReadDir(sigStoreDirPath)
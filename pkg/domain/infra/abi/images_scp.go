@@ -0,0 +1,156 @@
+package abi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/common/libimage"
+	"github.com/containers/common/pkg/config"
+	dockerArchiveTransport "github.com/containers/image/v5/docker/archive"
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	domainUtils "github.com/containers/podman/v4/pkg/domain/utils"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sys/unix"
+)
+
+func (ir *ImageEngine) Scp(ctx context.Context, src, dst string, quiet bool) (*entities.ImageLoadReport, error) {
+	var progress io.Writer
+	if !quiet {
+		progress = os.Stderr
+	}
+	return domainUtils.ExecuteTransfer(ctx, src, dst, &scpLocalStorage{ir: ir}, progress)
+}
+
+// scpLocalStorage is the local storage on one side of an image transfer.
+// c/image only reads and writes archives by path, so the archive is streamed
+// through a named pipe.  Loading a docker archive needs random access to it, as
+// its manifest follows the layers, so c/image spools the loaded archive to a
+// temporary file in $TMPDIR, /var/tmp by default.
+type scpLocalStorage struct {
+	ir *ImageEngine
+}
+
+// scpPipe creates a named pipe in a new temporary directory.  The caller must
+// remove the directory when done.
+func scpPipe() (string, string, error) {
+	pipeDir, err := os.MkdirTemp("", "scp")
+	if err != nil {
+		return "", "", err
+	}
+	pipePath := filepath.Join(pipeDir, "archive")
+	if err := unix.Mkfifo(pipePath, 0600); err != nil {
+		removeScpPipe(pipeDir)
+		return "", "", fmt.Errorf("creating named pipe: %w", err)
+	}
+	return pipeDir, pipePath, nil
+}
+
+func removeScpPipe(pipeDir string) {
+	if err := os.RemoveAll(pipeDir); err != nil {
+		logrus.Errorf("Removing named pipe: %q", err)
+	}
+}
+
+func (s *scpLocalStorage) Save(ctx context.Context, image string, w io.Writer) error {
+	return s.ir.SaveToWriter(ctx, image, nil, w, entities.ImageSaveOptions{Format: define.V2s2Archive, Quiet: true})
+}
+
+// SaveToWriter saves the images as Save does, but writes the archive to w.
+// c/image only writes archives to a path, which is a named pipe, so the
+// archive is streamed without a temporary file.  Only the docker-archive format
+// is written sequentially and supports this.  The Output of options is ignored.
+func (ir *ImageEngine) SaveToWriter(ctx context.Context, nameOrID string, tags []string, w io.Writer, options entities.ImageSaveOptions) error {
+	if options.Format != define.V2s2Archive {
+		return fmt.Errorf("only %s archives can be streamed, not %s: %w", define.V2s2Archive, options.Format, define.ErrInvalidArg)
+	}
+	pipeDir, pipePath, err := scpPipe()
+	if err != nil {
+		return err
+	}
+	defer removeScpPipe(pipeDir)
+
+	// Opening the read end does not wait for a writer.  Keep a writer open
+	// until the archive has been written, as the reader sees EOF whenever
+	// the pipe has no writers.
+	reader, err := os.OpenFile(pipePath, os.O_RDONLY|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return err
+	}
+	writer, err := os.OpenFile(pipePath, os.O_WRONLY, 0)
+	if err != nil {
+		reader.Close()
+		return err
+	}
+	errc := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(w, reader)
+		// Make the save fail instead of blocking if w stops reading.
+		reader.Close()
+		errc <- err
+	}()
+
+	options.Output = pipePath
+	saveErr := ir.Save(ctx, nameOrID, tags, options)
+	writer.Close()
+	// The save fails as well if w stopped reading, but the copy has the
+	// cause.
+	if err := <-errc; err != nil {
+		return err
+	}
+	return saveErr
+}
+
+func (s *scpLocalStorage) Load(ctx context.Context, r io.Reader) (string, error) {
+	pipeDir, pipePath, err := scpPipe()
+	if err != nil {
+		return "", err
+	}
+	defer removeScpPipe(pipeDir)
+
+	// Keep a reader open so that opening the write end does not wait for
+	// c/image to open the archive.
+	reader, err := os.OpenFile(pipePath, os.O_RDONLY|unix.O_NONBLOCK, 0)
+	if err != nil {
+		return "", err
+	}
+	writer, err := os.OpenFile(pipePath, os.O_WRONLY, 0)
+	if err != nil {
+		reader.Close()
+		return "", err
+	}
+	errc := make(chan error, 1)
+	go func() {
+		_, err := io.Copy(writer, r)
+		writer.Close()
+		errc <- err
+	}()
+
+	// libimage.Load tries the OCI archive transport first, which would
+	// consume the stream, so pull from the docker archive directly.
+	images, err := s.ir.Libpod.LibimageRuntime().Pull(ctx, dockerArchiveTransport.Transport.Name()+":"+pipePath, config.PullPolicyAlways, &libimage.PullOptions{})
+	// Closing the last reader makes the copy fail instead of blocking if
+	// the archive has not been read completely.
+	reader.Close()
+	if err != nil {
+		return "", err
+	}
+	if err := <-errc; err != nil {
+		return "", err
+	}
+	if len(images) == 0 {
+		return "", errors.New("no image was loaded")
+	}
+	if names := images[0].Names(); len(names) > 0 {
+		return names[0], nil
+	}
+	return images[0].ID(), nil
+}
+
+func (s *scpLocalStorage) Tag(ctx context.Context, image, tag string) error {
+	return s.ir.Tag(ctx, image, []string{tag}, entities.ImageTagOptions{})
+}
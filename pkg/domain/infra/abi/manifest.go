@@ -85,7 +85,7 @@ func (ir *ImageEngine) ManifestInspect(ctx context.Context, name string, opts en
 		return nil, err
 	}
 
-	schema2List, err := manifestList.Inspect()
+	schema2List, err := ir.inspectManifestList(manifestList)
 	if err != nil {
 		return nil, err
 	}
@@ -201,6 +201,17 @@ func (ir *ImageEngine) ManifestAdd(ctx context.Context, name string, images []st
 		return "", err
 	}
 
+	if opts.Artifact {
+		annotations, err := manifestAnnotations(opts.ManifestAnnotateOptions)
+		if err != nil {
+			return "", err
+		}
+		if err := ir.manifestAddArtifacts(ctx, manifestList.ID(), images, annotations, opts); err != nil {
+			return "", err
+		}
+		return manifestList.ID(), nil
+	}
+
 	addOptions := &libimage.ManifestListAddOptions{
 		All:                   opts.All,
 		AuthFilePath:          opts.Authfile,
@@ -223,18 +234,11 @@ func (ir *ImageEngine) ManifestAdd(ctx context.Context, name string, images []st
 			OSVersion:    opts.OSVersion,
 			Variant:      opts.Variant,
 		}
-		if len(opts.Annotation) != 0 {
-			annotations := make(map[string]string)
-			for _, annotationSpec := range opts.Annotation {
-				spec := strings.SplitN(annotationSpec, "=", 2)
-				if len(spec) != 2 {
-					return "", fmt.Errorf("no value given for annotation %q", spec[0])
-				}
-				annotations[spec[0]] = spec[1]
-			}
-			opts.Annotations = envLib.Join(opts.Annotations, annotations)
+		annotations, err := manifestAnnotations(opts.ManifestAnnotateOptions)
+		if err != nil {
+			return "", err
 		}
-		annotateOptions.Annotations = opts.Annotations
+		annotateOptions.Annotations = annotations
 
 		if err := manifestList.AnnotateInstance(instanceDigest, annotateOptions); err != nil {
 			return "", err
@@ -243,6 +247,23 @@ func (ir *ImageEngine) ManifestAdd(ctx context.Context, name string, images []st
 	return manifestList.ID(), nil
 }
 
+// manifestAnnotations returns the annotations given by map merged with the
+// ones given as key=value pairs.
+func manifestAnnotations(opts entities.ManifestAnnotateOptions) (map[string]string, error) {
+	if len(opts.Annotation) == 0 {
+		return opts.Annotations, nil
+	}
+	annotations := make(map[string]string)
+	for _, annotationSpec := range opts.Annotation {
+		spec := strings.SplitN(annotationSpec, "=", 2)
+		if len(spec) != 2 {
+			return nil, fmt.Errorf("no value given for annotation %q", spec[0])
+		}
+		annotations[spec[0]] = spec[1]
+	}
+	return envLib.Join(opts.Annotations, annotations), nil
+}
+
 // ManifestAnnotate updates an entry of the manifest list
 func (ir *ImageEngine) ManifestAnnotate(ctx context.Context, name, image string, opts entities.ManifestAnnotateOptions) (string, error) {
 	instanceDigest, err := digest.Parse(image)
@@ -262,18 +283,11 @@ func (ir *ImageEngine) ManifestAnnotate(ctx context.Context, name, image string,
 		OSVersion:    opts.OSVersion,
 		Variant:      opts.Variant,
 	}
-	if len(opts.Annotation) != 0 {
-		annotations := make(map[string]string)
-		for _, annotationSpec := range opts.Annotation {
-			spec := strings.SplitN(annotationSpec, "=", 2)
-			if len(spec) != 2 {
-				return "", fmt.Errorf("no value given for annotation %q", spec[0])
-			}
-			annotations[spec[0]] = spec[1]
-		}
-		opts.Annotations = envLib.Join(opts.Annotations, annotations)
+	annotations, err := manifestAnnotations(opts)
+	if err != nil {
+		return "", err
 	}
-	annotateOptions.Annotations = opts.Annotations
+	annotateOptions.Annotations = annotations
 
 	if err := manifestList.AnnotateInstance(instanceDigest, annotateOptions); err != nil {
 		return "", err
@@ -374,6 +388,7 @@ func (ir *ImageEngine) ManifestPush(ctx context.Context, name, destination strin
 		if _, rmErrors := ir.Libpod.LibimageRuntime().RemoveImages(ctx, []string{manifestList.ID()}, rmOpts); len(rmErrors) > 0 {
 			return "", fmt.Errorf("removing manifest after push: %w", rmErrors[0])
 		}
+		removeManifestArtifacts(ir.Libpod, manifestList.ID())
 	}
 
 	return manDigest.String(), err
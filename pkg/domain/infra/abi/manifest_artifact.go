@@ -0,0 +1,355 @@
+package abi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/containers/common/libimage"
+	"github.com/containers/common/libimage/manifests"
+	"github.com/containers/image/v5/oci/layout"
+	"github.com/containers/image/v5/pkg/blobinfocache/none"
+	"github.com/containers/image/v5/types"
+	"github.com/containers/podman/v4/libpod"
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/containers/storage"
+	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	imgspecv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// artifactConfigMediaType is the media type of the empty config of
+	// artifact manifests as defined by the OCI image spec 1.1.
+	artifactConfigMediaType = "application/vnd.oci.empty.v1+json"
+	// defaultArtifactType is the artifact type of artifacts added without
+	// --artifact-type.
+	defaultArtifactType = "application/vnd.unknown.artifact.v1"
+	// defaultArtifactLayerType is the media type of files added without
+	// --artifact-layer-type.
+	defaultArtifactLayerType = "application/octet-stream"
+)
+
+// artifactConfig is the content of the empty config of artifact manifests.
+var artifactConfig = []byte("{}")
+
+// manifestListDescriptor adds the artifact type, which libimage does not
+// report, to the instances of an inspected manifest list.
+type manifestListDescriptor struct {
+	libimage.ManifestListDescriptor
+	ArtifactType string `json:"artifactType,omitempty"`
+}
+
+// manifestListData is libimage.ManifestListData with the artifact types of
+// the instances.
+type manifestListData struct {
+	SchemaVersion int                      `json:"schemaVersion"`
+	MediaType     string                   `json:"mediaType"`
+	Manifests     []manifestListDescriptor `json:"manifests"`
+	Annotations   map[string]string        `json:"annotations,omitempty"`
+}
+
+// manifestArtifactsPath returns the OCI layout holding the artifacts added to
+// the manifest list with the specified ID.
+func manifestArtifactsPath(rt *libpod.Runtime, listID string) string {
+	return filepath.Join(rt.ManifestArtifactsDir(), listID)
+}
+
+// removeManifestArtifacts removes the artifacts added to a removed manifest
+// list. Errors are only logged as the list itself is already gone.
+func removeManifestArtifacts(rt *libpod.Runtime, listID string) {
+	if err := os.RemoveAll(manifestArtifactsPath(rt, listID)); err != nil {
+		logrus.Errorf("Removing artifacts of manifest list %s: %v", listID, err)
+	}
+}
+
+// pruneManifestArtifacts removes the artifacts of manifest lists which no
+// longer exist, e.g. because they were removed by another tool.
+func pruneManifestArtifacts(rt *libpod.Runtime) {
+	entries, err := os.ReadDir(rt.ManifestArtifactsDir())
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logrus.Errorf("Reading artifacts of manifest lists: %v", err)
+		}
+		return
+	}
+	store := rt.GetStore()
+	for _, entry := range entries {
+		if _, err := store.Image(entry.Name()); errors.Is(err, storage.ErrImageUnknown) {
+			removeManifestArtifacts(rt, entry.Name())
+		}
+	}
+}
+
+// manifestAddArtifacts adds an OCI artifact manifest for each of the files to
+// the manifest list. The artifacts are written to an OCI layout next to the
+// list, from where they are copied when the list is pushed.
+func (ir *ImageEngine) manifestAddArtifacts(ctx context.Context, listID string, files []string, indexAnnotations map[string]string, opts entities.ManifestAddOptions) error {
+	store := ir.Libpod.GetStore()
+	locker, err := manifests.LockerForImage(store, listID)
+	if err != nil {
+		return err
+	}
+	locker.Lock()
+	defer locker.Unlock()
+
+	_, list, err := manifests.LoadFromImage(store, listID)
+	if err != nil {
+		return err
+	}
+
+	var subject *imgspecv1.Descriptor
+	if opts.ArtifactSubject != "" {
+		subject, err = ir.artifactSubject(ctx, list, opts.ArtifactSubject)
+		if err != nil {
+			return err
+		}
+	}
+	artifactType := opts.ArtifactType
+	if artifactType == "" {
+		artifactType = defaultArtifactType
+	}
+	layerType := opts.ArtifactLayerType
+	if layerType == "" {
+		layerType = defaultArtifactLayerType
+	}
+
+	layoutPath := manifestArtifactsPath(ir.Libpod, listID)
+	if err := os.MkdirAll(layoutPath, 0700); err != nil {
+		return fmt.Errorf("creating artifact store %s: %w", layoutPath, err)
+	}
+	for _, file := range files {
+		ref, desc, err := ir.writeArtifact(ctx, layoutPath, file, artifactType, layerType, subject, opts.ArtifactAnnotations)
+		if err != nil {
+			return err
+		}
+		indexRef, err := newArtifactIndexReference(ref, desc)
+		if err != nil {
+			return err
+		}
+		// The list records the reference of the artifact in the
+		// layout, so it is pushed from there.
+		if _, err := list.Add(ctx, ir.Libpod.SystemContext(), indexRef, true); err != nil {
+			return err
+		}
+		// Artifacts are not specific to a platform.
+		index := list.OCIv1()
+		for i := range index.Manifests {
+			if index.Manifests[i].Digest == desc.Digest {
+				index.Manifests[i].ArtifactType = artifactType
+				index.Manifests[i].Platform = nil
+			}
+		}
+		// The title annotation also makes sure that the list is stored as
+		// an OCI index, which is required for artifact types.
+		annotations := map[string]string{imgspecv1.AnnotationTitle: filepath.Base(file)}
+		for k, v := range indexAnnotations {
+			annotations[k] = v
+		}
+		if err := list.SetAnnotations(&desc.Digest, annotations); err != nil {
+			return err
+		}
+	}
+
+	_, err = list.SaveToImage(store, listID, nil, "")
+	return err
+}
+
+// artifactIndexReference presents an artifact in an OCI layout as an index
+// holding only the artifact.  manifests.List.Add reads the platform of an
+// image from its config, which artifacts do not have, unless the image is
+// added from an index.  The index is never written, Add records the name of
+// the artifact in the layout.
+type artifactIndexReference struct {
+	types.ImageReference
+	index []byte
+}
+
+// artifactIndexSource returns the index of an artifactIndexReference as its
+// manifest.
+type artifactIndexSource struct {
+	types.ImageSource
+	index []byte
+}
+
+// newArtifactIndexReference returns an artifactIndexReference for the artifact
+// with the descriptor in the layout.
+func newArtifactIndexReference(ref types.ImageReference, desc *imgspecv1.Descriptor) (*artifactIndexReference, error) {
+	instance := *desc
+	// Add requires a platform to not read the config, it is removed
+	// from the list afterwards.
+	instance.Platform = &imgspecv1.Platform{OS: "unknown", Architecture: "unknown"}
+	index, err := json.Marshal(imgspecv1.Index{
+		Versioned: specs.Versioned{SchemaVersion: 2},
+		MediaType: imgspecv1.MediaTypeImageIndex,
+		Manifests: []imgspecv1.Descriptor{instance},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &artifactIndexReference{ImageReference: ref, index: index}, nil
+}
+
+func (r *artifactIndexReference) NewImageSource(ctx context.Context, sys *types.SystemContext) (types.ImageSource, error) {
+	src, err := r.ImageReference.NewImageSource(ctx, sys)
+	if err != nil {
+		return nil, err
+	}
+	return &artifactIndexSource{ImageSource: src, index: r.index}, nil
+}
+
+func (s *artifactIndexSource) GetManifest(ctx context.Context, instanceDigest *digest.Digest) ([]byte, string, error) {
+	if instanceDigest == nil {
+		return s.index, imgspecv1.MediaTypeImageIndex, nil
+	}
+	return s.ImageSource.GetManifest(ctx, instanceDigest)
+}
+
+// artifactSubject returns the descriptor of the manifest the artifacts refer
+// to, which is either an instance digest of the list or a local image.
+func (ir *ImageEngine) artifactSubject(ctx context.Context, list manifests.List, subject string) (*imgspecv1.Descriptor, error) {
+	if instanceDigest, err := digest.Parse(subject); err == nil {
+		for _, instance := range list.OCIv1().Manifests {
+			if instance.Digest == instanceDigest {
+				return &imgspecv1.Descriptor{
+					MediaType: instance.MediaType,
+					Digest:    instance.Digest,
+					Size:      instance.Size,
+				}, nil
+			}
+		}
+	}
+
+	img, _, err := ir.Libpod.LibimageRuntime().LookupImage(subject, nil)
+	if err != nil {
+		return nil, fmt.Errorf("looking up artifact subject %s: %w", subject, err)
+	}
+	rawManifest, mimeType, err := img.Manifest(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("reading manifest of artifact subject %s: %w", subject, err)
+	}
+	return &imgspecv1.Descriptor{
+		MediaType: mimeType,
+		Digest:    digest.FromBytes(rawManifest),
+		Size:      int64(len(rawManifest)),
+	}, nil
+}
+
+// writeArtifact writes an artifact manifest with the file as its only layer to
+// the OCI layout. It returns the reference of the artifact in the layout and
+// the descriptor of its manifest.
+func (ir *ImageEngine) writeArtifact(ctx context.Context, layoutPath, file, artifactType, layerType string, subject *imgspecv1.Descriptor, annotations map[string]string) (types.ImageReference, *imgspecv1.Descriptor, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening artifact: %w", err)
+	}
+	defer f.Close()
+
+	// The digest of the file is needed for the manifest, which is needed
+	// to name the artifact before any blob can be written.
+	digester := digest.Canonical.Digester()
+	size, err := io.Copy(digester.Hash(), f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading artifact %s: %w", file, err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return nil, nil, err
+	}
+
+	config := imgspecv1.Descriptor{
+		MediaType: artifactConfigMediaType,
+		Digest:    digest.FromBytes(artifactConfig),
+		Size:      int64(len(artifactConfig)),
+	}
+	layer := imgspecv1.Descriptor{
+		MediaType:   layerType,
+		Digest:      digester.Digest(),
+		Size:        size,
+		Annotations: map[string]string{imgspecv1.AnnotationTitle: filepath.Base(file)},
+	}
+	artifactManifest := imgspecv1.Manifest{
+		Versioned:    specs.Versioned{SchemaVersion: 2},
+		MediaType:    imgspecv1.MediaTypeImageManifest,
+		ArtifactType: artifactType,
+		Config:       config,
+		Layers:       []imgspecv1.Descriptor{layer},
+		Subject:      subject,
+		Annotations:  annotations,
+	}
+	manifestBytes, err := json.Marshal(artifactManifest)
+	if err != nil {
+		return nil, nil, err
+	}
+	manifestDigest := digest.FromBytes(manifestBytes)
+
+	ref, err := layout.NewReference(layoutPath, manifestDigest.Encoded())
+	if err != nil {
+		return nil, nil, err
+	}
+	dest, err := ref.NewImageDestination(ctx, ir.Libpod.SystemContext())
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating artifact store %s: %w", layoutPath, err)
+	}
+	defer dest.Close()
+
+	if _, err := dest.PutBlob(ctx, bytes.NewReader(artifactConfig), types.BlobInfo{Digest: config.Digest, Size: config.Size}, none.NoCache, true); err != nil {
+		return nil, nil, fmt.Errorf("writing artifact config: %w", err)
+	}
+	// Writing the blob verifies its digest, the file must not change
+	// after it has been hashed.
+	if _, err := dest.PutBlob(ctx, f, types.BlobInfo{Digest: layer.Digest, Size: layer.Size}, none.NoCache, false); err != nil {
+		return nil, nil, fmt.Errorf("writing artifact %s: %w", file, err)
+	}
+	if err := dest.PutManifest(ctx, manifestBytes, nil); err != nil {
+		return nil, nil, fmt.Errorf("writing manifest of artifact %s: %w", file, err)
+	}
+	if err := dest.Commit(ctx, nil); err != nil {
+		return nil, nil, fmt.Errorf("committing artifact %s: %w", file, err)
+	}
+
+	return ref, &imgspecv1.Descriptor{
+		MediaType:    imgspecv1.MediaTypeImageManifest,
+		Digest:       manifestDigest,
+		Size:         int64(len(manifestBytes)),
+		ArtifactType: artifactType,
+	}, nil
+}
+
+// inspectManifestList returns the inspect data of the manifest list including
+// the artifact types of its instances.
+func (ir *ImageEngine) inspectManifestList(manifestList *libimage.ManifestList) (*manifestListData, error) {
+	schema2List, err := manifestList.Inspect()
+	if err != nil {
+		return nil, err
+	}
+	_, list, err := manifests.LoadFromImage(ir.Libpod.GetStore(), manifestList.ID())
+	if err != nil {
+		return nil, err
+	}
+	artifactTypes := make(map[digest.Digest]string)
+	for _, instance := range list.OCIv1().Manifests {
+		if instance.ArtifactType != "" {
+			artifactTypes[instance.Digest] = instance.ArtifactType
+		}
+	}
+
+	data := &manifestListData{
+		SchemaVersion: schema2List.SchemaVersion,
+		MediaType:     schema2List.MediaType,
+		Manifests:     make([]manifestListDescriptor, 0, len(schema2List.Manifests)),
+		Annotations:   schema2List.Annotations,
+	}
+	for _, instance := range schema2List.Manifests {
+		data.Manifests = append(data.Manifests, manifestListDescriptor{
+			ManifestListDescriptor: instance,
+			ArtifactType:           artifactTypes[instance.Digest],
+		})
+	}
+	return data, nil
+}
@@ -98,6 +98,27 @@ func VolumeOptions(opts map[string]string) ([]libpod.VolumeCreateOption, error)
 			if len(finalVal) > 0 {
 				volumeOptions[key] = strings.Join(finalVal, ",")
 			}
+		case "size":
+			size, err := units.FromHumanSize(value)
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert size %s to integer: %w", value, err)
+			}
+			libpodOptions = append(libpodOptions, libpod.WithVolumeSize(uint64(size)))
+			volumeOptions["SIZE"] = value
+		case "inodes":
+			inodes, err := strconv.ParseUint(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert inodes %s to integer: %w", value, err)
+			}
+			libpodOptions = append(libpodOptions, libpod.WithVolumeInodes(inodes))
+			volumeOptions["INODES"] = value
+		case "quota_warning":
+			percent, err := strconv.ParseUint(strings.TrimSuffix(value, "%"), 10, 0)
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert quota warning %s to a percentage: %w", value, err)
+			}
+			libpodOptions = append(libpodOptions, libpod.WithVolumeQuotaWarning(uint(percent)))
+			volumeOptions["QUOTA_WARNING"] = value
 		default:
 			volumeOptions[key] = value
 		}
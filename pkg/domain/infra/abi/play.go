@@ -23,7 +23,9 @@ import (
 	"github.com/containers/podman/v4/libpod/define"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	v1apps "github.com/containers/podman/v4/pkg/k8s.io/api/apps/v1"
+	v1batch "github.com/containers/podman/v4/pkg/k8s.io/api/batch/v1"
 	v1 "github.com/containers/podman/v4/pkg/k8s.io/api/core/v1"
+	"github.com/containers/podman/v4/pkg/k8s.io/apimachinery/pkg/util/intstr"
 	"github.com/containers/podman/v4/pkg/specgen"
 	"github.com/containers/podman/v4/pkg/specgen/generate"
 	"github.com/containers/podman/v4/pkg/specgen/generate/kube"
@@ -165,6 +167,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 	ipIndex := 0
 
 	var configMaps []v1.ConfigMap
+	var services []v1.Service
 
 	ranContainers := false
 	// FIXME: both, the service container and the proxies, should ideally
@@ -195,7 +198,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 		}
 
 		// TODO: create constants for the various "kinds" of yaml files.
-		if options.ServiceContainer && serviceContainer == nil && (kind == "Pod" || kind == "Deployment") {
+		if options.ServiceContainer && serviceContainer == nil && (kind == "Pod" || kind == "Deployment" || kind == "Job") {
 			ctr, err := ic.createServiceContainer(ctx, k8sName(content, "service"), options)
 			if err != nil {
 				return nil, err
@@ -240,7 +243,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 				podYAML.Annotations[name] = val
 			}
 
-			r, proxies, err := ic.playKubePod(ctx, podTemplateSpec.ObjectMeta.Name, &podTemplateSpec, options, &ipIndex, podYAML.Annotations, configMaps, serviceContainer)
+			r, proxies, err := ic.playKubePod(ctx, podTemplateSpec.ObjectMeta.Name, &podTemplateSpec, options, &ipIndex, podYAML.Annotations, configMaps, services, nil, serviceContainer)
 			if err != nil {
 				return nil, err
 			}
@@ -256,7 +259,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 				return nil, fmt.Errorf("unable to read YAML as Kube Deployment: %w", err)
 			}
 
-			r, proxies, err := ic.playKubeDeployment(ctx, &deploymentYAML, options, &ipIndex, configMaps, serviceContainer)
+			r, proxies, err := ic.playKubeDeployment(ctx, &deploymentYAML, options, &ipIndex, configMaps, services, serviceContainer)
 			if err != nil {
 				return nil, err
 			}
@@ -265,6 +268,43 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 			report.Pods = append(report.Pods, r.Pods...)
 			validKinds++
 			ranContainers = true
+		case "Job":
+			var jobYAML v1batch.Job
+
+			if err := yaml.Unmarshal(document, &jobYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube Job: %w", err)
+			}
+
+			r, proxies, err := ic.playKubeJob(ctx, &jobYAML, options, &ipIndex, configMaps, services, serviceContainer)
+			if err != nil {
+				return nil, err
+			}
+			notifyProxies = append(notifyProxies, proxies...)
+
+			report.Pods = append(report.Pods, r.Pods...)
+			validKinds++
+			ranContainers = len(r.Pods) > 0 || ranContainers
+		case "CronJob":
+			var cronJobYAML v1batch.CronJob
+
+			if err := yaml.Unmarshal(document, &cronJobYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube CronJob: %w", err)
+			}
+
+			r, err := ic.playKubeCronJob(ctx, &cronJobYAML, configMaps, services)
+			if err != nil {
+				return nil, err
+			}
+
+			report.CronJobs = append(report.CronJobs, *r)
+			validKinds++
+		case "Service":
+			var serviceYAML v1.Service
+
+			if err := yaml.Unmarshal(document, &serviceYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube Service: %w", err)
+			}
+			services = append(services, serviceYAML)
 		case "PersistentVolumeClaim":
 			var pvcYAML v1.PersistentVolumeClaim
 
@@ -366,7 +406,7 @@ func (ic *ContainerEngine) PlayKube(ctx context.Context, body io.Reader, options
 	return report, nil
 }
 
-func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAML *v1apps.Deployment, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
+func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAML *v1apps.Deployment, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, services []v1.Service, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
 	var (
 		deploymentName string
 		podSpec        v1.PodTemplateSpec
@@ -388,7 +428,46 @@ func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAM
 	podSpec = deploymentYAML.Spec.Template
 
 	podName := fmt.Sprintf("%s-pod", deploymentName)
-	podReport, proxies, err := ic.playKubePod(ctx, podName, &podSpec, options, ipIndex, deploymentYAML.Annotations, configMaps, serviceContainer)
+	podReport, proxies, err := ic.playKubePod(ctx, podName, &podSpec, options, ipIndex, deploymentYAML.Annotations, configMaps, services, nil, serviceContainer)
+	if err != nil {
+		return nil, nil, fmt.Errorf("encountered while bringing up pod %s: %w", podName, err)
+	}
+	report.Pods = podReport.Pods
+
+	return &report, proxies, nil
+}
+
+// playKubeJob runs the pod of a job to completion.  Failed containers are
+// restarted up to the backoff limit of the job.
+func (ic *ContainerEngine) playKubeJob(ctx context.Context, jobYAML *v1batch.Job, options entities.PlayKubeOptions, ipIndex *int, configMaps []v1.ConfigMap, services []v1.Service, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
+	var report entities.PlayKubeReport
+
+	jobName := jobYAML.ObjectMeta.Name
+	if jobName == "" {
+		return nil, nil, errors.New("job does not have a name")
+	}
+	if jobYAML.Spec.Suspend != nil && *jobYAML.Spec.Suspend {
+		logrus.Infof("Job %s is suspended, not creating its pod", jobName)
+		return &report, nil, nil
+	}
+	if (jobYAML.Spec.Parallelism != nil && *jobYAML.Spec.Parallelism > 1) ||
+		(jobYAML.Spec.Completions != nil && *jobYAML.Spec.Completions > 1) {
+		logrus.Warnf("Limiting parallelism and completions to 1, running more than one pod of a job is not supported by Podman")
+	}
+
+	podSpec := jobYAML.Spec.Template
+	retries, err := jobRestartPolicy(jobYAML)
+	if err != nil {
+		return nil, nil, err
+	}
+	if retries == 0 {
+		podSpec.Spec.RestartPolicy = v1.RestartPolicyNever
+	} else {
+		podSpec.Spec.RestartPolicy = v1.RestartPolicyOnFailure
+	}
+
+	podName := fmt.Sprintf("%s-pod", jobName)
+	podReport, proxies, err := ic.playKubePod(ctx, podName, &podSpec, options, ipIndex, jobYAML.Annotations, configMaps, services, &retries, serviceContainer)
 	if err != nil {
 		return nil, nil, fmt.Errorf("encountered while bringing up pod %s: %w", podName, err)
 	}
@@ -397,7 +476,26 @@ func (ic *ContainerEngine) playKubeDeployment(ctx context.Context, deploymentYAM
 	return &report, proxies, nil
 }
 
-func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podYAML *v1.PodTemplateSpec, options entities.PlayKubeOptions, ipIndex *int, annotations map[string]string, configMaps []v1.ConfigMap, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
+// jobRestartPolicy returns the number of times failed containers of the job
+// are restarted.
+func jobRestartPolicy(jobYAML *v1batch.Job) (uint, error) {
+	switch jobYAML.Spec.Template.Spec.RestartPolicy {
+	case "", v1.RestartPolicyNever, v1.RestartPolicyOnFailure:
+	default:
+		return 0, fmt.Errorf("invalid restart policy %q for job %s, only %q and %q are supported", jobYAML.Spec.Template.Spec.RestartPolicy, jobYAML.Name, v1.RestartPolicyNever, v1.RestartPolicyOnFailure)
+	}
+	// Kubernetes defaults to six retries
+	backoffLimit := int32(6)
+	if jobYAML.Spec.BackoffLimit != nil {
+		backoffLimit = *jobYAML.Spec.BackoffLimit
+	}
+	if backoffLimit < 0 {
+		return 0, fmt.Errorf("invalid backoff limit %d for job %s", backoffLimit, jobYAML.Name)
+	}
+	return uint(backoffLimit), nil
+}
+
+func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podYAML *v1.PodTemplateSpec, options entities.PlayKubeOptions, ipIndex *int, annotations map[string]string, configMaps []v1.ConfigMap, services []v1.Service, restartRetries *uint, serviceContainer *libpod.Container) (*entities.PlayKubeReport, []*notifyproxy.NotifyProxy, error) {
 	var (
 		writer      io.Writer
 		playKubePod entities.PlayKubePod
@@ -501,6 +599,16 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 	}
 	*ipIndex++
 
+	// Ports of matching services take precedence over the host ports of the
+	// pod, ports published on the command line take precedence over both
+	servicePorts, err := servicePortMappings(services, podYAML)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(servicePorts) > 0 {
+		mergePublishPorts(&podOpt, servicePorts)
+	}
+
 	if len(options.PublishPorts) > 0 {
 		publishPorts, err := specgenutil.CreatePortBindings(options.PublishPorts)
 		if err != nil {
@@ -612,6 +720,9 @@ func (ic *ContainerEngine) playKubePod(ctx context.Context, podName string, podY
 	default: // Default to Always
 		podSpec.PodSpecGen.RestartPolicy = define.RestartPolicyAlways
 	}
+	if restartRetries != nil && *restartRetries > 0 {
+		podSpec.PodSpecGen.RestartRetries = restartRetries
+	}
 
 	if podOpt.Infra {
 		infraImage := util.DefaultContainerConfig().Engine.InfraImage
@@ -1101,6 +1212,92 @@ func mergePublishPorts(p *entities.PodCreateOptions, publishPortsOption []nettyp
 	p.Net.PublishPorts = publishPortsOption
 }
 
+// servicePortMappings returns the ports to publish for all NodePort and
+// LoadBalancer services selecting the pod.  A NodePort service publishes its
+// node port, or a random host port if none is set, a LoadBalancer service
+// publishes its port.
+func servicePortMappings(services []v1.Service, podYAML *v1.PodTemplateSpec) ([]nettypes.PortMapping, error) {
+	var ports []nettypes.PortMapping
+	for i := range services {
+		service := &services[i]
+		if !serviceSelectsPod(service, podYAML) {
+			continue
+		}
+		for j := range service.Spec.Ports {
+			servicePort := &service.Spec.Ports[j]
+			var hostPort int32
+			switch service.Spec.Type {
+			case v1.ServiceTypeNodePort:
+				hostPort = servicePort.NodePort
+			case v1.ServiceTypeLoadBalancer:
+				hostPort = servicePort.Port
+			default:
+				logrus.Infof("Service %s of type %q does not publish ports, only NodePort and LoadBalancer services are supported", service.Name, service.Spec.Type)
+				continue
+			}
+
+			containerPort, err := serviceTargetPort(servicePort, podYAML)
+			if err != nil {
+				return nil, fmt.Errorf("service %s: %w", service.Name, err)
+			}
+			if hostPort < 0 || hostPort > 65535 {
+				return nil, fmt.Errorf("service %s: invalid port %d", service.Name, hostPort)
+			}
+
+			protocol := servicePort.Protocol
+			if protocol == "" {
+				protocol = v1.ProtocolTCP
+			}
+			ports = append(ports, nettypes.PortMapping{
+				HostPort:      uint16(hostPort),
+				ContainerPort: containerPort,
+				Protocol:      strings.ToLower(string(protocol)),
+			})
+		}
+	}
+	return ports, nil
+}
+
+// serviceSelectsPod returns true if all labels of the service selector are
+// set on the pod.  A service without selector does not select any pod.
+func serviceSelectsPod(service *v1.Service, podYAML *v1.PodTemplateSpec) bool {
+	if len(service.Spec.Selector) == 0 {
+		return false
+	}
+	for key, value := range service.Spec.Selector {
+		if podValue, ok := podYAML.Labels[key]; !ok || podValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// serviceTargetPort resolves the target port of the service port, named
+// ports are looked up in the ports of the containers of the pod.
+func serviceTargetPort(servicePort *v1.ServicePort, podYAML *v1.PodTemplateSpec) (uint16, error) {
+	port := servicePort.Port
+	switch {
+	case servicePort.TargetPort.Type == intstr.String && servicePort.TargetPort.StrVal != "":
+		port = 0
+		for _, ctr := range podYAML.Spec.Containers {
+			for _, ctrPort := range ctr.Ports {
+				if ctrPort.Name == servicePort.TargetPort.StrVal {
+					port = ctrPort.ContainerPort
+				}
+			}
+		}
+		if port == 0 {
+			return 0, fmt.Errorf("no container port named %q", servicePort.TargetPort.StrVal)
+		}
+	case servicePort.TargetPort.Type == intstr.Int && servicePort.TargetPort.IntVal != 0:
+		port = servicePort.TargetPort.IntVal
+	}
+	if port < 1 || port > 65535 {
+		return 0, fmt.Errorf("invalid target port %d", port)
+	}
+	return uint16(port), nil
+}
+
 func portAlreadyPublished(port nettypes.PortMapping, publishedPorts []nettypes.PortMapping) bool {
 	for _, publishedPort := range publishedPorts {
 		if port.ContainerPort >= publishedPort.ContainerPort &&
@@ -1249,7 +1446,7 @@ func sortKubeKinds(documentList [][]byte) ([][]byte, error) {
 		}
 
 		switch kind {
-		case "Pod", "Deployment":
+		case "Pod", "Deployment", "Job", "CronJob":
 			sortedDocumentList = append(sortedDocumentList, document)
 		default:
 			sortedDocumentList = append([][]byte{document}, sortedDocumentList...)
@@ -1306,9 +1503,10 @@ func getBuildFile(imageName string, cwd string) (string, error) {
 
 func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, options entities.PlayKubeDownOptions) (*entities.PlayKubeReport, error) {
 	var (
-		podNames    []string
-		volumeNames []string
-		secretNames []string
+		podNames     []string
+		volumeNames  []string
+		secretNames  []string
+		cronJobNames []string
 	)
 	reports := new(entities.PlayKubeReport)
 
@@ -1359,6 +1557,28 @@ func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, opt
 			}
 			podName := fmt.Sprintf("%s-pod", deploymentName)
 			podNames = append(podNames, podName)
+		case "Job":
+			var jobYAML v1batch.Job
+			if err := yaml.Unmarshal(document, &jobYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube Job: %w", err)
+			}
+			if jobYAML.Spec.Suspend != nil && *jobYAML.Spec.Suspend {
+				continue
+			}
+			podNames = append(podNames, fmt.Sprintf("%s-pod", jobYAML.Name))
+		case "CronJob":
+			var cronJobYAML v1batch.CronJob
+			if err := yaml.Unmarshal(document, &cronJobYAML); err != nil {
+				return nil, fmt.Errorf("unable to read YAML as Kube CronJob: %w", err)
+			}
+			cronJobNames = append(cronJobNames, cronJobYAML.Name)
+			// The pod only exists if the timer fired at least once
+			podName := fmt.Sprintf("%s-pod", cronJobYAML.Name)
+			if exists, err := ic.Libpod.HasPod(podName); err != nil {
+				return nil, err
+			} else if exists {
+				podNames = append(podNames, podName)
+			}
 		case "PersistentVolumeClaim":
 			var pvcYAML v1.PersistentVolumeClaim
 			if err := yaml.Unmarshal(document, &pvcYAML); err != nil {
@@ -1393,6 +1613,14 @@ func (ic *ContainerEngine) PlayKubeDown(ctx context.Context, body io.Reader, opt
 		serviceCtrIDs = append(serviceCtrIDs, ctr.ID())
 	}
 
+	// Remove the timers first so that they do not recreate the pods
+	for _, name := range cronJobNames {
+		reports.CronJobRmReport = append(reports.CronJobRmReport, &entities.PlayKubeCronJobRmReport{
+			Name: name,
+			Err:  ic.removeKubeCronJob(ctx, name),
+		})
+	}
+
 	// Add the reports
 	reports.StopReport, err = ic.PodStop(ctx, podNames, entities.PodStopOptions{})
 	if err != nil {
@@ -0,0 +1,489 @@
+package abi
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/containers/podman/v4/pkg/domain/entities"
+	v1batch "github.com/containers/podman/v4/pkg/k8s.io/api/batch/v1"
+	v1 "github.com/containers/podman/v4/pkg/k8s.io/api/core/v1"
+	metav1 "github.com/containers/podman/v4/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
+	"github.com/containers/podman/v4/pkg/rootless"
+	"github.com/containers/podman/v4/pkg/specgenutil"
+	"github.com/containers/podman/v4/pkg/systemd"
+	"github.com/containers/podman/v4/pkg/systemd/parser"
+	"github.com/containers/podman/v4/pkg/util"
+	"github.com/containers/podman/v4/version"
+	"github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+)
+
+// cronJobUnitPrefix is the prefix of the systemd units running CronJobs.
+const cronJobUnitPrefix = "podman-kube-cronjob-"
+
+// playKubeCronJob creates a systemd timer running the job of the CronJob on
+// its schedule.  The job is stored as YAML next to the other static files of
+// Podman and played via `podman kube play --replace` each time the timer
+// fires.
+func (ic *ContainerEngine) playKubeCronJob(ctx context.Context, cronJobYAML *v1batch.CronJob, configMaps []v1.ConfigMap, services []v1.Service) (*entities.PlayKubeCronJob, error) {
+	name := cronJobYAML.Name
+	if name == "" {
+		return nil, errors.New("cronjob does not have a name")
+	}
+
+	onCalendar, err := cronToOnCalendar(cronJobYAML.Spec.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("cronjob %s: %w", name, err)
+	}
+	if cronJobYAML.Spec.TimeZone != nil && *cronJobYAML.Spec.TimeZone != "" {
+		for i := range onCalendar {
+			onCalendar[i] += " " + *cronJobYAML.Spec.TimeZone
+		}
+	}
+	if policy := cronJobYAML.Spec.ConcurrencyPolicy; policy != "" && policy != v1batch.ReplaceConcurrent {
+		logrus.Warnf("Concurrency policy %q of cronjob %s is not supported, a running job is replaced when the next one starts", policy, name)
+	}
+
+	job := v1batch.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: cronJobYAML.Spec.JobTemplate.ObjectMeta,
+		Spec:       cronJobYAML.Spec.JobTemplate.Spec,
+	}
+	job.Name = name
+	// Every run creates a new pod, so a run is never suspended on its own
+	job.Spec.Suspend = nil
+
+	content, err := cronJobDocuments(&job, configMaps, services)
+	if err != nil {
+		return nil, err
+	}
+
+	jobPath, err := ic.cronJobPath(name)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(jobPath), 0700); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(jobPath, content, 0600); err != nil {
+		return nil, fmt.Errorf("writing job of cronjob %s: %w", name, err)
+	}
+
+	unitDir, err := systemdUnitDir()
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(unitDir, 0755); err != nil {
+		return nil, err
+	}
+	podman, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	cfg, err := ic.Libpod.GetConfigNoCopy()
+	if err != nil {
+		return nil, err
+	}
+	// The job is played with the storage and configuration of this
+	// Podman, which may differ from the defaults.
+	command := []string{podman}
+	command = append(command, specgenutil.CreateGlobalArgs(ic.Libpod.StorageConfig(), cfg)...)
+	command = append(command, "kube", "play", "--replace", jobPath)
+
+	unitName := cronJobUnitPrefix + name
+	service, timer, err := cronJobUnits(unitName, name, command, onCalendar)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filepath.Join(unitDir, unitName+".service"), []byte(service), 0644); err != nil {
+		return nil, err
+	}
+	timerPath := filepath.Join(unitDir, unitName+".timer")
+	if err := os.WriteFile(timerPath, []byte(timer), 0644); err != nil {
+		return nil, err
+	}
+
+	enabled := cronJobYAML.Spec.Suspend == nil || !*cronJobYAML.Spec.Suspend
+	if err := startCronJobTimer(ctx, unitName+".timer", enabled); err != nil {
+		return nil, fmt.Errorf("starting timer of cronjob %s: %w", name, err)
+	}
+
+	return &entities.PlayKubeCronJob{
+		Name:       name,
+		Timer:      unitName + ".timer",
+		OnCalendar: onCalendar,
+		Enabled:    enabled,
+	}, nil
+}
+
+// cronJobUnits returns the service running the command of the CronJob and the
+// timer starting it.
+func cronJobUnits(unitName, name string, command, onCalendar []string) (string, string, error) {
+	serviceUnit := parser.NewUnitFile()
+	serviceUnit.Add("Unit", "Description", "Podman kube CronJob "+name)
+	serviceUnit.Add("Unit", "Wants", "network-online.target")
+	serviceUnit.Add("Unit", "After", "network-online.target")
+	serviceUnit.Add("Service", "Type", "oneshot")
+	serviceUnit.AddCmdline("Service", "ExecStart", command)
+	serviceUnit.PrependComment("", unitName+".service", "autogenerated by Podman "+version.Version.String())
+	service, err := serviceUnit.ToString()
+	if err != nil {
+		return "", "", err
+	}
+
+	timerUnit := parser.NewUnitFile()
+	timerUnit.Add("Unit", "Description", "Podman kube CronJob "+name+" timer")
+	for _, calendar := range onCalendar {
+		timerUnit.Add("Timer", "OnCalendar", calendar)
+	}
+	timerUnit.Add("Install", "WantedBy", "timers.target")
+	timerUnit.PrependComment("", unitName+".timer", "autogenerated by Podman "+version.Version.String())
+	timer, err := timerUnit.ToString()
+	if err != nil {
+		return "", "", err
+	}
+	return service, timer, nil
+}
+
+// cronJobDocuments returns the multi-document YAML of the job along with the
+// config maps and services it may refer to.
+func cronJobDocuments(job *v1batch.Job, configMaps []v1.ConfigMap, services []v1.Service) ([]byte, error) {
+	var docs [][]byte
+	for _, cm := range configMaps {
+		cm.Kind, cm.APIVersion = "ConfigMap", "v1"
+		doc, err := yaml.Marshal(cm)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+	for _, service := range services {
+		service.Kind, service.APIVersion = "Service", "v1"
+		doc, err := yaml.Marshal(service)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+	doc, err := yaml.Marshal(job)
+	if err != nil {
+		return nil, err
+	}
+	docs = append(docs, doc)
+	return bytes.Join(docs, []byte("---\n")), nil
+}
+
+// removeKubeCronJob stops and removes the systemd timer of the CronJob and
+// the stored job.
+func (ic *ContainerEngine) removeKubeCronJob(ctx context.Context, name string) error {
+	unitDir, err := systemdUnitDir()
+	if err != nil {
+		return err
+	}
+	unitName := cronJobUnitPrefix + name
+
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return fmt.Errorf("unable to get systemd connection to remove cronjob: %w", err)
+	}
+	defer conn.Close()
+
+	stopChan := make(chan string)
+	if _, err := conn.StopUnitContext(ctx, unitName+".timer", "replace", stopChan); err != nil {
+		if !strings.HasSuffix(err.Error(), ".timer not loaded.") {
+			return fmt.Errorf("stopping timer %s.timer: %w", unitName, err)
+		}
+	} else if msg := <-stopChan; msg != "done" {
+		return fmt.Errorf("stopping timer %s.timer: expected %q but received %q", unitName, "done", msg)
+	}
+	if _, err := conn.DisableUnitFilesContext(ctx, []string{unitName + ".timer"}, false); err != nil {
+		logrus.Debugf("Disabling timer %s.timer: %v", unitName, err)
+	}
+
+	for _, path := range []string{
+		filepath.Join(unitDir, unitName+".timer"),
+		filepath.Join(unitDir, unitName+".service"),
+	} {
+		if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+	if err := conn.ReloadContext(ctx); err != nil {
+		return err
+	}
+
+	jobPath, err := ic.cronJobPath(name)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(jobPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// cronJobPath returns the path of the YAML file holding the job of the
+// CronJob.
+func (ic *ContainerEngine) cronJobPath(name string) (string, error) {
+	cfg, err := ic.Libpod.GetConfigNoCopy()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cfg.Engine.StaticDir, "kube-cronjobs", name+".yaml"), nil
+}
+
+// startCronJobTimer reloads systemd to pick up the timer and, if enabled,
+// enables and (re)starts it.
+func startCronJobTimer(ctx context.Context, timer string, enabled bool) error {
+	conn, err := systemd.ConnectToDBUS()
+	if err != nil {
+		return fmt.Errorf("unable to get systemd connection to add cronjob: %w", err)
+	}
+	defer conn.Close()
+
+	if err := conn.ReloadContext(ctx); err != nil {
+		return err
+	}
+	if !enabled {
+		// The timer may still be active from a previous play of the
+		// CronJob before it was suspended
+		if _, err := conn.DisableUnitFilesContext(ctx, []string{timer}, false); err != nil {
+			logrus.Debugf("Disabling timer %s: %v", timer, err)
+		}
+		if _, err := conn.StopUnitContext(ctx, timer, "replace", nil); err != nil {
+			logrus.Debugf("Stopping timer %s: %v", timer, err)
+		}
+		return nil
+	}
+	if _, _, err := conn.EnableUnitFilesContext(ctx, []string{timer}, false, true); err != nil {
+		return err
+	}
+	startChan := make(chan string)
+	if _, err := conn.RestartUnitContext(ctx, timer, "replace", startChan); err != nil {
+		return err
+	}
+	if msg := <-startChan; msg != "done" {
+		return fmt.Errorf("expected %q but received %q", "done", msg)
+	}
+	return nil
+}
+
+// systemdUnitDir returns the directory for systemd units of the user running
+// Podman.
+func systemdUnitDir() (string, error) {
+	if !rootless.IsRootless() {
+		return "/etc/systemd/system", nil
+	}
+	configHome, err := util.GetRootlessConfigHomeDir()
+	if err != nil {
+		return "", err
+	}
+	if configHome == "" {
+		return "", errors.New("unable to determine the systemd user unit directory, set XDG_CONFIG_HOME")
+	}
+	return filepath.Join(configHome, "systemd", "user"), nil
+}
+
+var (
+	cronMonthNames = []string{"jan", "feb", "mar", "apr", "may", "jun", "jul", "aug", "sep", "oct", "nov", "dec"}
+	cronDayNames   = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+	calendarDays   = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+)
+
+// cronToOnCalendar converts a cron schedule to the calendar event format of
+// systemd timers, see systemd.time(7).
+func cronToOnCalendar(schedule string) ([]string, error) {
+	switch strings.TrimSpace(schedule) {
+	case "@yearly", "@annually":
+		return []string{"*-01-01 00:00:00"}, nil
+	case "@monthly":
+		return []string{"*-*-01 00:00:00"}, nil
+	case "@weekly":
+		return []string{"Sun *-*-* 00:00:00"}, nil
+	case "@daily", "@midnight":
+		return []string{"*-*-* 00:00:00"}, nil
+	case "@hourly":
+		return []string{"*-*-* *:00:00"}, nil
+	}
+
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q: expected 5 fields, got %d", schedule, len(fields))
+	}
+	minute, err := cronFieldToCalendar(fields[0], 0, 59, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid minute in schedule %q: %w", schedule, err)
+	}
+	hour, err := cronFieldToCalendar(fields[1], 0, 23, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid hour in schedule %q: %w", schedule, err)
+	}
+	day, err := cronFieldToCalendar(fields[2], 1, 31, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid day of month in schedule %q: %w", schedule, err)
+	}
+	month, err := cronFieldToCalendar(fields[3], 1, 12, cronMonthNames)
+	if err != nil {
+		return nil, fmt.Errorf("invalid month in schedule %q: %w", schedule, err)
+	}
+	weekday, err := cronWeekdayToCalendar(fields[4])
+	if err != nil {
+		return nil, fmt.Errorf("invalid day of week in schedule %q: %w", schedule, err)
+	}
+
+	// cron runs a job on days matching either the day of month or the day
+	// of week if both are restricted, while systemd requires both to
+	// match. Use one calendar event for each of them then.
+	if weekday != "" && !strings.HasPrefix(fields[2], "*") && !strings.HasPrefix(fields[4], "*") {
+		return []string{
+			fmt.Sprintf("*-%s-%s %s:%s:00", month, day, hour, minute),
+			fmt.Sprintf("%s *-%s-* %s:%s:00", weekday, month, hour, minute),
+		}, nil
+	}
+	onCalendar := fmt.Sprintf("*-%s-%s %s:%s:00", month, day, hour, minute)
+	if weekday != "" {
+		onCalendar = weekday + " " + onCalendar
+	}
+	return []string{onCalendar}, nil
+}
+
+// cronFieldToCalendar converts a single field of a cron schedule.  Lists and
+// ranges map directly to systemd, ranges with steps are expanded.
+func cronFieldToCalendar(field string, lowest, highest int, names []string) (string, error) {
+	if field == "*" {
+		return "*", nil
+	}
+	items := strings.Split(field, ",")
+	for i, item := range items {
+		base, step, hasStep := strings.Cut(item, "/")
+		stepValue := 1
+		if hasStep {
+			var err error
+			stepValue, err = strconv.Atoi(step)
+			if err != nil || stepValue < 1 {
+				return "", fmt.Errorf("invalid step %q", step)
+			}
+		}
+
+		switch {
+		case base == "*":
+			items[i] = fmt.Sprintf("%d/%d", lowest, stepValue)
+		case strings.Contains(base, "-"):
+			start, end, _ := strings.Cut(base, "-")
+			startValue, err := cronValue(start, lowest, highest, names)
+			if err != nil {
+				return "", err
+			}
+			endValue, err := cronValue(end, lowest, highest, names)
+			if err != nil {
+				return "", err
+			}
+			if startValue > endValue {
+				return "", fmt.Errorf("invalid range %q", base)
+			}
+			if !hasStep {
+				items[i] = fmt.Sprintf("%d..%d", startValue, endValue)
+				continue
+			}
+			values := []string{}
+			for v := startValue; v <= endValue; v += stepValue {
+				values = append(values, strconv.Itoa(v))
+			}
+			items[i] = strings.Join(values, ",")
+		default:
+			value, err := cronValue(base, lowest, highest, names)
+			if err != nil {
+				return "", err
+			}
+			items[i] = strconv.Itoa(value)
+			if hasStep {
+				items[i] += "/" + strconv.Itoa(stepValue)
+			}
+		}
+	}
+	return strings.Join(items, ","), nil
+}
+
+// cronWeekdayToCalendar converts the day of week field of a cron schedule to
+// the list of weekdays of systemd.  An empty string is returned for any day.
+func cronWeekdayToCalendar(field string) (string, error) {
+	if field == "*" || field == "?" {
+		return "", nil
+	}
+	var days [7]bool
+	for _, item := range strings.Split(field, ",") {
+		base, step, hasStep := strings.Cut(item, "/")
+		stepValue := 1
+		if hasStep {
+			var err error
+			stepValue, err = strconv.Atoi(step)
+			if err != nil || stepValue < 1 {
+				return "", fmt.Errorf("invalid step %q", step)
+			}
+		}
+
+		start, end := 0, 6
+		switch {
+		case base == "*":
+		case strings.Contains(base, "-"):
+			first, last, _ := strings.Cut(base, "-")
+			var err error
+			if start, err = cronValue(first, 0, 7, cronDayNames); err != nil {
+				return "", err
+			}
+			if end, err = cronValue(last, 0, 7, cronDayNames); err != nil {
+				return "", err
+			}
+			if start > end {
+				return "", fmt.Errorf("invalid range %q", base)
+			}
+		default:
+			var err error
+			if start, err = cronValue(base, 0, 7, cronDayNames); err != nil {
+				return "", err
+			}
+			end = start
+			if hasStep {
+				end = 6
+			}
+		}
+		for v := start; v <= end; v += stepValue {
+			// both 0 and 7 are Sunday
+			days[v%7] = true
+		}
+	}
+
+	// systemd weeks start on Monday
+	var list []string
+	for i := 1; i <= 7; i++ {
+		if days[i%7] {
+			list = append(list, calendarDays[i%7])
+		}
+	}
+	return strings.Join(list, ","), nil
+}
+
+// cronValue parses a single value of a cron field, names are matched case
+// insensitively and map to the lowest value onwards.
+func cronValue(value string, lowest, highest int, names []string) (int, error) {
+	for i, name := range names {
+		if strings.EqualFold(value, name) {
+			return lowest + i, nil
+		}
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil || v < lowest || v > highest {
+		return 0, fmt.Errorf("invalid value %q, must be between %d and %d", value, lowest, highest)
+	}
+	return v, nil
+}
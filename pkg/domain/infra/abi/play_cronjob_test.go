@@ -0,0 +1,65 @@
+package abi
+
+import (
+	"testing"
+
+	"github.com/containers/podman/v4/pkg/systemd/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronToOnCalendar(t *testing.T) {
+	tests := []struct {
+		schedule    string
+		expectError bool
+		expected    []string
+	}{
+		{"@hourly", false, []string{"*-*-* *:00:00"}},
+		{"@weekly", false, []string{"Sun *-*-* 00:00:00"}},
+		{"* * * * *", false, []string{"*-*-* *:*:00"}},
+		{"*/15 * * * *", false, []string{"*-*-* *:0/15:00"}},
+		{"30 2 * * *", false, []string{"*-*-* 2:30:00"}},
+		{"0 8-18 * * 1-5", false, []string{"Mon,Tue,Wed,Thu,Fri *-*-* 8..18:0:00"}},
+		{"0 0-12/6 1,15 * *", false, []string{"*-*-1,15 0,6,12:0:00"}},
+		{"0 0 * jan,jul sun", false, []string{"Sun *-1,7-* 0:0:00"}},
+		{"0 0 * * 0,7", false, []string{"Sun *-*-* 0:0:00"}},
+		{"0 0 * * 5-7", false, []string{"Fri,Sat,Sun *-*-* 0:0:00"}},
+		{"*/2 * */3 * *", false, []string{"*-*-1/3 *:0/2:00"}},
+		{"0 0 1,15 * mon", false, []string{"*-*-1,15 0:0:00", "Mon *-*-* 0:0:00"}},
+		{"0 0 */2 * mon", false, []string{"Mon *-*-1/2 0:0:00"}},
+		{"* * * *", true, nil},
+		{"60 * * * *", true, nil},
+		{"* * 0 * *", true, nil},
+		{"* 5-2 * * *", true, nil},
+		{"*/0 * * * *", true, nil},
+		{"* * * * mon-foo", true, nil},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.schedule, func(t *testing.T) {
+			onCalendar, err := cronToOnCalendar(test.schedule)
+			if test.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, onCalendar)
+			}
+		})
+	}
+}
+
+func TestCronJobUnits(t *testing.T) {
+	command := []string{"/usr/bin/podman", "--root", "/var/lib/my containers", "kube", "play", "--replace", "/etc/jobs/a \"b\".yaml"}
+	service, timer, err := cronJobUnits("podman-kube-cronjob-test", "test", command, []string{"*-*-* 2:30:00", "Mon *-*-* 0:0:00"})
+	assert.NoError(t, err)
+
+	unit := parser.NewUnitFile()
+	assert.NoError(t, unit.Parse(service))
+	execStart, ok := unit.LookupLastArgs("Service", "ExecStart")
+	assert.True(t, ok)
+	assert.Equal(t, command, execStart)
+
+	unit = parser.NewUnitFile()
+	assert.NoError(t, unit.Parse(timer))
+	assert.Equal(t, []string{"*-*-* 2:30:00", "Mon *-*-* 0:0:00"}, unit.LookupAll("Timer", "OnCalendar"))
+}
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"testing"
 
+	nettypes "github.com/containers/common/libnetwork/types"
 	v1 "github.com/containers/podman/v4/pkg/k8s.io/api/core/v1"
 	v12 "github.com/containers/podman/v4/pkg/k8s.io/apimachinery/pkg/apis/meta/v1"
+	"github.com/containers/podman/v4/pkg/k8s.io/apimachinery/pkg/util/intstr"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -262,3 +264,133 @@ kind: Pod
 		})
 	}
 }
+
+func TestServicePortMappings(t *testing.T) {
+	pod := &v1.PodTemplateSpec{
+		ObjectMeta: v12.ObjectMeta{
+			Labels: map[string]string{"app": "web", "tier": "frontend"},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "web",
+				Ports: []v1.ContainerPort{
+					{Name: "http", ContainerPort: 8080},
+				},
+			}},
+		},
+	}
+
+	tests := []struct {
+		name             string
+		service          v1.Service
+		expectError      bool
+		expectedErrorMsg string
+		expected         []nettypes.PortMapping
+	}{
+		{
+			"NodePort",
+			v1.Service{
+				Spec: v1.ServiceSpec{
+					Type:     v1.ServiceTypeNodePort,
+					Selector: map[string]string{"app": "web"},
+					Ports:    []v1.ServicePort{{Port: 80, TargetPort: intstr.FromInt(8080), NodePort: 30080}},
+				},
+			},
+			false,
+			"",
+			[]nettypes.PortMapping{{HostPort: 30080, ContainerPort: 8080, Protocol: "tcp"}},
+		},
+		{
+			"NodePortRandomHostPort",
+			v1.Service{
+				Spec: v1.ServiceSpec{
+					Type:     v1.ServiceTypeNodePort,
+					Selector: map[string]string{"app": "web"},
+					Ports:    []v1.ServicePort{{Port: 8080, Protocol: v1.ProtocolUDP}},
+				},
+			},
+			false,
+			"",
+			[]nettypes.PortMapping{{HostPort: 0, ContainerPort: 8080, Protocol: "udp"}},
+		},
+		{
+			"LoadBalancerNamedTargetPort",
+			v1.Service{
+				Spec: v1.ServiceSpec{
+					Type:     v1.ServiceTypeLoadBalancer,
+					Selector: map[string]string{"app": "web", "tier": "frontend"},
+					Ports:    []v1.ServicePort{{Port: 80, TargetPort: intstr.FromString("http")}},
+				},
+			},
+			false,
+			"",
+			[]nettypes.PortMapping{{HostPort: 80, ContainerPort: 8080, Protocol: "tcp"}},
+		},
+		{
+			"ClusterIP",
+			v1.Service{
+				Spec: v1.ServiceSpec{
+					Type:     v1.ServiceTypeClusterIP,
+					Selector: map[string]string{"app": "web"},
+					Ports:    []v1.ServicePort{{Port: 80}},
+				},
+			},
+			false,
+			"",
+			nil,
+		},
+		{
+			"SelectorMismatch",
+			v1.Service{
+				Spec: v1.ServiceSpec{
+					Type:     v1.ServiceTypeNodePort,
+					Selector: map[string]string{"app": "db"},
+					Ports:    []v1.ServicePort{{Port: 5432}},
+				},
+			},
+			false,
+			"",
+			nil,
+		},
+		{
+			"NoSelector",
+			v1.Service{
+				Spec: v1.ServiceSpec{
+					Type:  v1.ServiceTypeNodePort,
+					Ports: []v1.ServicePort{{Port: 80}},
+				},
+			},
+			false,
+			"",
+			nil,
+		},
+		{
+			"UnknownNamedTargetPort",
+			v1.Service{
+				ObjectMeta: v12.ObjectMeta{Name: "web"},
+				Spec: v1.ServiceSpec{
+					Type:     v1.ServiceTypeLoadBalancer,
+					Selector: map[string]string{"app": "web"},
+					Ports:    []v1.ServicePort{{Port: 80, TargetPort: intstr.FromString("https")}},
+				},
+			},
+			true,
+			`service web: no container port named "https"`,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			ports, err := servicePortMappings([]v1.Service{test.service}, pod)
+			if test.expectError {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedErrorMsg)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, test.expected, ports)
+			}
+		})
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/containers/podman/v4/pkg/signal"
 	"github.com/containers/podman/v4/pkg/specgen"
 	"github.com/containers/podman/v4/pkg/specgen/generate"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/sirupsen/logrus"
 )
 
@@ -311,6 +312,28 @@ func (ic *ContainerEngine) PodCreate(ctx context.Context, specg entities.PodSpec
 	return &entities.PodCreateReport{Id: pod.ID()}, nil
 }
 
+// PodUpdate updates the resource limits and the restart policy of the given pod
+func (ic *ContainerEngine) PodUpdate(ctx context.Context, options *entities.PodUpdateOptions) (*entities.PodUpdateReport, error) {
+	var resources *specs.LinuxResources
+	if options.Specgen != nil {
+		if err := specgen.WeightDevices(options.Specgen); err != nil {
+			return nil, err
+		}
+		if err := specgen.FinishThrottleDevices(options.Specgen); err != nil {
+			return nil, err
+		}
+		resources = options.Specgen.ResourceLimits
+	}
+	pod, err := ic.Libpod.LookupPod(options.NameOrID)
+	if err != nil {
+		return nil, err
+	}
+	if err := pod.Update(ctx, resources, options.RestartPolicy, options.RestartRetries); err != nil {
+		return nil, err
+	}
+	return &entities.PodUpdateReport{Id: pod.ID()}, nil
+}
+
 func (ic *ContainerEngine) PodClone(ctx context.Context, podClone entities.PodCloneOptions) (*entities.PodCloneReport, error) {
 	spec := specgen.NewPodSpecGenerator()
 	p, err := generate.PodConfigToSpec(ic.Libpod, spec, &podClone.InfraOptions, podClone.ID)
@@ -9,6 +9,8 @@ import (
 	"strings"
 
 	"github.com/containers/common/pkg/secrets"
+	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/libpod/events"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/containers/podman/v4/pkg/domain/utils"
 )
@@ -52,6 +54,7 @@ func (ic *ContainerEngine) SecretCreate(ctx context.Context, name string, reader
 	if err != nil {
 		return nil, err
 	}
+	ic.Libpod.NewSecretEvent(events.Create, secretID, name)
 
 	return &entities.SecretCreateReport{
 		ID: secretID,
@@ -152,7 +155,15 @@ func (ic *ContainerEngine) SecretRm(ctx context.Context, nameOrIDs []string, opt
 		}
 	}
 	for _, nameOrID := range toRemove {
+		// Look up the name first so the remove event carries it
+		var name string
+		if secr, err := manager.Lookup(nameOrID); err == nil {
+			name = secr.Name
+		}
 		deletedID, err := manager.Delete(nameOrID)
+		if err == nil {
+			ic.Libpod.NewSecretEvent(events.Remove, deletedID, name)
+		}
 		if err == nil || strings.Contains(err.Error(), "no such secret") {
 			reports = append(reports, &entities.SecretRmReport{
 				Err: err,
@@ -167,6 +178,51 @@ func (ic *ContainerEngine) SecretRm(ctx context.Context, nameOrIDs []string, opt
 	return reports, nil
 }
 
+func (ic *ContainerEngine) SecretUpdate(ctx context.Context, nameOrID string, reader io.Reader, options entities.SecretUpdateOptions) (*entities.SecretUpdateReport, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := ic.Libpod.UpdateSecret(nameOrID, data)
+	if err != nil {
+		return nil, err
+	}
+	ic.Libpod.NewSecretEvent(events.Update, secret.ID, secret.Name)
+
+	ctrs, err := ic.Libpod.SecretContainers(secret.ID)
+	if err != nil {
+		return nil, err
+	}
+	report := &entities.SecretUpdateReport{
+		ID:         secret.ID,
+		Containers: make([]string, 0, len(ctrs)),
+	}
+	for _, ctr := range ctrs {
+		report.Containers = append(report.Containers, ctr.ID())
+	}
+	if !options.RestartContainers {
+		return report, nil
+	}
+
+	for _, ctr := range ctrs {
+		state, err := ctr.State()
+		if err != nil {
+			if errors.Is(err, define.ErrNoSuchCtr) || errors.Is(err, define.ErrCtrRemoved) {
+				continue
+			}
+			return nil, err
+		}
+		if state != define.ContainerStateRunning {
+			continue
+		}
+		if err := ctr.RestartWithTimeout(ctx, ctr.StopTimeout()); err != nil {
+			return nil, fmt.Errorf("restarting container %s: %w", ctr.ID(), err)
+		}
+		report.Restarted = append(report.Restarted, ctr.ID())
+	}
+	return report, nil
+}
+
 func (ic *ContainerEngine) SecretExists(ctx context.Context, nameOrID string) (*entities.BoolReport, error) {
 	manager, err := ic.Libpod.SecretsManager()
 	if err != nil {
@@ -378,8 +378,8 @@ func (se *SystemEngine) Renumber(ctx context.Context, flags *pflag.FlagSet, conf
 	return nil
 }
 
-func (se SystemEngine) Migrate(ctx context.Context, flags *pflag.FlagSet, config *entities.PodmanConfig, options entities.SystemMigrateOptions) error {
-	return nil
+func (se SystemEngine) Migrate(ctx context.Context, flags *pflag.FlagSet, config *entities.PodmanConfig, options entities.SystemMigrateOptions) (*entities.SystemMigrateReport, error) {
+	return &entities.SystemMigrateReport{DatabaseBackup: se.Libpod.MigratedDatabaseBackup()}, nil
 }
 
 func (se SystemEngine) Shutdown(ctx context.Context) {
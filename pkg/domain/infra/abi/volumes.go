@@ -45,6 +45,19 @@ func (ic *ContainerEngine) VolumeCreate(ctx context.Context, opts entities.Volum
 	return &entities.IDOrNameResponse{IDOrName: vol.Name()}, nil
 }
 
+// VolumeClone creates a new volume as a copy of the given volume.
+func (ic *ContainerEngine) VolumeClone(ctx context.Context, nameOrID string, opts entities.VolumeCloneOptions) (*entities.IDOrNameResponse, error) {
+	src, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	vol, err := ic.Libpod.CloneVolume(ctx, src, opts.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.IDOrNameResponse{IDOrName: vol.Name()}, nil
+}
+
 func (ic *ContainerEngine) VolumeRm(ctx context.Context, namesOrIds []string, opts entities.VolumeRmOptions) ([]*entities.VolumeRmReport, error) {
 	var (
 		err     error
@@ -222,3 +235,91 @@ func (ic *ContainerEngine) VolumeReload(ctx context.Context) (*entities.VolumeRe
 	report := ic.Libpod.UpdateVolumePlugins(ctx)
 	return &entities.VolumeReloadReport{VolumeReload: *report}, nil
 }
+
+func (ic *ContainerEngine) VolumePluginList(ctx context.Context) ([]*entities.VolumePluginReport, error) {
+	plugins, err := ic.Libpod.VolumePlugins()
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*entities.VolumePluginReport, 0, len(plugins))
+	for _, p := range plugins {
+		reports = append(reports, &entities.VolumePluginReport{VolumePlugin: *p})
+	}
+	return reports, nil
+}
+
+func (ic *ContainerEngine) VolumePluginInspect(ctx context.Context, names []string) ([]*entities.VolumePluginReport, []error, error) {
+	var errs []error
+	reports := make([]*entities.VolumePluginReport, 0, len(names))
+	for _, name := range names {
+		p, err := ic.Libpod.VolumePlugin(name)
+		if err != nil {
+			if errors.Is(err, define.ErrMissingPlugin) {
+				errs = append(errs, fmt.Errorf("no such volume plugin %s", name))
+				continue
+			}
+			return nil, nil, fmt.Errorf("inspecting volume plugin %s: %w", name, err)
+		}
+		reports = append(reports, &entities.VolumePluginReport{VolumePlugin: *p})
+	}
+	return reports, errs, nil
+}
+
+func (ic *ContainerEngine) VolumePluginEnable(ctx context.Context, name string) error {
+	return ic.Libpod.EnableVolumePlugin(name)
+}
+
+func (ic *ContainerEngine) VolumePluginDisable(ctx context.Context, name string) error {
+	return ic.Libpod.DisableVolumePlugin(name)
+}
+
+func (ic *ContainerEngine) VolumeSnapshotCreate(ctx context.Context, nameOrID string, opts entities.VolumeSnapshotCreateOptions) (*entities.VolumeSnapshotReport, error) {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	snapshot, err := vol.CreateSnapshot(opts.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.VolumeSnapshotReport{Volume: vol.Name(), Name: snapshot.Name, CreatedAt: snapshot.CreatedTime}, nil
+}
+
+func (ic *ContainerEngine) VolumeSnapshotList(ctx context.Context, nameOrID string) ([]*entities.VolumeSnapshotReport, error) {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	snapshots, err := vol.Snapshots()
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*entities.VolumeSnapshotReport, 0, len(snapshots))
+	for _, s := range snapshots {
+		reports = append(reports, &entities.VolumeSnapshotReport{Volume: vol.Name(), Name: s.Name, CreatedAt: s.CreatedTime})
+	}
+	return reports, nil
+}
+
+func (ic *ContainerEngine) VolumeSnapshotRestore(ctx context.Context, nameOrID string, snapshot string) error {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return err
+	}
+	return vol.RestoreSnapshot(snapshot)
+}
+
+func (ic *ContainerEngine) VolumeSnapshotRm(ctx context.Context, nameOrID string, snapshots []string) ([]*entities.VolumeSnapshotRmReport, error) {
+	vol, err := ic.Libpod.LookupVolume(nameOrID)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]*entities.VolumeSnapshotRmReport, 0, len(snapshots))
+	for _, name := range snapshots {
+		reports = append(reports, &entities.VolumeSnapshotRmReport{
+			Err:  vol.RemoveSnapshot(name),
+			Name: name,
+		})
+	}
+	return reports, nil
+}
@@ -59,7 +59,11 @@ func NewSystemEngine(setup entities.EngineSetup, facts *entities.PodmanConfig) (
 			if flagErr != nil {
 				return nil, flagErr
 			}
-			r, err = GetRuntimeMigrate(context.Background(), facts.FlagSet, facts, name)
+			dbBackend, flagErr := facts.FlagSet.GetString("database-backend")
+			if flagErr != nil {
+				return nil, flagErr
+			}
+			r, err = GetRuntimeMigrate(context.Background(), facts.FlagSet, facts, name, dbBackend)
 		case entities.NoFDsMode:
 			r, err = GetRuntimeDisableFDs(context.Background(), facts.FlagSet, facts)
 		}
@@ -32,25 +32,27 @@ var (
 )
 
 type engineOpts struct {
-	name     string
-	renumber bool
-	migrate  bool
-	noStore  bool
-	withFDS  bool
-	reset    bool
-	config   *entities.PodmanConfig
+	name      string
+	dbBackend string
+	renumber  bool
+	migrate   bool
+	noStore   bool
+	withFDS   bool
+	reset     bool
+	config    *entities.PodmanConfig
 }
 
 // GetRuntimeMigrate gets a libpod runtime that will perform a migration of existing containers
-func GetRuntimeMigrate(ctx context.Context, fs *flag.FlagSet, cfg *entities.PodmanConfig, newRuntime string) (*libpod.Runtime, error) {
+func GetRuntimeMigrate(ctx context.Context, fs *flag.FlagSet, cfg *entities.PodmanConfig, newRuntime, newDBBackend string) (*libpod.Runtime, error) {
 	return getRuntime(ctx, fs, &engineOpts{
-		name:     newRuntime,
-		renumber: false,
-		migrate:  true,
-		noStore:  false,
-		withFDS:  true,
-		reset:    false,
-		config:   cfg,
+		name:      newRuntime,
+		dbBackend: newDBBackend,
+		renumber:  false,
+		migrate:   true,
+		noStore:   false,
+		withFDS:   true,
+		reset:     false,
+		config:    cfg,
 	})
 }
 
@@ -179,6 +181,9 @@ func getRuntime(ctx context.Context, fs *flag.FlagSet, opts *engineOpts) (*libpo
 		if opts.name != "" {
 			options = append(options, libpod.WithMigrateRuntime(opts.name))
 		}
+		if opts.dbBackend != "" {
+			options = append(options, libpod.WithMigrateDatabaseBackend(opts.dbBackend))
+		}
 	}
 
 	if opts.reset {
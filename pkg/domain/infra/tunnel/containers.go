@@ -413,7 +413,7 @@ func (ic *ContainerEngine) ContainerCheckpoint(ctx context.Context, namesOrIds [
 }
 
 func (ic *ContainerEngine) ContainerRestore(ctx context.Context, namesOrIds []string, opts entities.RestoreOptions) ([]*entities.RestoreReport, error) {
-	if opts.ImportPrevious != "" {
+	if len(opts.ImportPrevious) > 0 {
 		return nil, fmt.Errorf("--import-previous is not supported on the remote client")
 	}
 
@@ -1028,6 +1028,14 @@ func (ic *ContainerEngine) ContainerPort(ctx context.Context, nameOrID string, o
 	return reports, nil
 }
 
+func (ic *ContainerEngine) ContainerPortAdd(ctx context.Context, nameOrID string, options entities.ContainerPortUpdateOptions) (*entities.ContainerPortReport, error) {
+	return containers.PortAdd(ic.ClientCtx, nameOrID, new(containers.PortAddOptions).WithPorts(options.Ports))
+}
+
+func (ic *ContainerEngine) ContainerPortRemove(ctx context.Context, nameOrID string, options entities.ContainerPortUpdateOptions) error {
+	return containers.PortRemove(ic.ClientCtx, nameOrID, new(containers.PortRemoveOptions).WithPorts(options.Ports))
+}
+
 func (ic *ContainerEngine) ContainerCopyFromArchive(ctx context.Context, nameOrID, path string, reader io.Reader, options entities.CopyOptions) (entities.ContainerCopyFunc, error) {
 	copyOptions := new(containers.CopyOptions).WithChown(options.Chown).WithRename(options.Rename).WithNoOverwriteDirNonDir(options.NoOverwriteDirNonDir)
 	return containers.CopyFromArchiveWithOptions(ic.ClientCtx, nameOrID, path, reader, copyOptions)
@@ -1066,15 +1074,21 @@ func (ic *ContainerEngine) ContainerClone(ctx context.Context, ctrCloneOpts enti
 	return nil, errors.New("cloning a container is not supported on the remote client")
 }
 
-// ContainerUpdate finds and updates the given container's cgroup config with the specified options
+func (ic *ContainerEngine) ContainerMigrate(ctx context.Context, nameOrID string, destination string, options entities.ContainerMigrateOptions) (*entities.ContainerMigrateReport, error) {
+	return nil, errors.New("migrating a container is not supported on the remote client")
+}
+
+// ContainerUpdate finds and updates the given container's cgroup config and configuration with the specified options
 func (ic *ContainerEngine) ContainerUpdate(ctx context.Context, updateOptions *entities.ContainerUpdateOptions) (string, error) {
-	err := specgen.WeightDevices(updateOptions.Specgen)
-	if err != nil {
-		return "", err
-	}
-	err = specgen.FinishThrottleDevices(updateOptions.Specgen)
-	if err != nil {
-		return "", err
+	if updateOptions.Specgen != nil {
+		err := specgen.WeightDevices(updateOptions.Specgen)
+		if err != nil {
+			return "", err
+		}
+		err = specgen.FinishThrottleDevices(updateOptions.Specgen)
+		if err != nil {
+			return "", err
+		}
 	}
 	return containers.Update(ic.ClientCtx, updateOptions)
 }
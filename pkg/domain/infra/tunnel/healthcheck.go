@@ -8,6 +8,27 @@ import (
 	"github.com/containers/podman/v4/pkg/domain/entities"
 )
 
-func (ic *ContainerEngine) HealthCheckRun(ctx context.Context, nameOrID string, options entities.HealthCheckOptions) (*define.HealthCheckResults, error) {
-	return containers.RunHealthCheck(ic.ClientCtx, nameOrID, nil)
+func (ic *ContainerEngine) HealthCheckRun(ctx context.Context, nameOrID string, opts entities.HealthCheckOptions) (*define.HealthCheckResults, error) {
+	options := new(containers.HealthCheckOptions)
+	if opts.Readiness {
+		options.WithReadiness(true)
+	}
+	return containers.RunHealthCheck(ic.ClientCtx, nameOrID, options)
+}
+
+func (ic *ContainerEngine) HealthCheckLogs(ctx context.Context, nameOrID string, opts entities.HealthCheckLogsOptions) error {
+	options := new(containers.HealthCheckLogsOptions).WithSince(opts.Since).WithFollow(opts.Follow)
+	logChan := make(chan define.HealthCheckLog)
+	go func() {
+		for hcl := range logChan {
+			hcl := hcl
+			opts.LogChan <- &hcl
+		}
+		close(opts.LogChan)
+	}()
+	return containers.HealthCheckLogs(ic.ClientCtx, nameOrID, logChan, options)
+}
+
+func (ic *ContainerEngine) HealthCheckList(ctx context.Context) ([]*entities.HealthCheckListReport, error) {
+	return containers.ListHealthChecks(ic.ClientCtx)
 }
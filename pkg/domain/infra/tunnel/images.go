@@ -11,7 +11,6 @@ import (
 
 	"github.com/containers/common/libimage"
 	"github.com/containers/common/pkg/config"
-	"github.com/containers/common/pkg/ssh"
 	"github.com/containers/image/v5/docker/reference"
 	"github.com/containers/image/v5/types"
 	"github.com/containers/podman/v4/pkg/bindings/images"
@@ -389,7 +388,7 @@ func (ir *ImageEngine) Sign(ctx context.Context, names []string, options entitie
 	return nil, errors.New("not implemented yet")
 }
 
-func (ir *ImageEngine) Scp(ctx context.Context, src, dst string, parentFlags []string, quiet bool, sshMode ssh.EngineMode) error {
+func (ir *ImageEngine) Scp(ctx context.Context, src, dst string, quiet bool) (*entities.ImageLoadReport, error) {
 	options := new(images.ScpOptions)
 
 	var destination *string
@@ -401,10 +400,7 @@ func (ir *ImageEngine) Scp(ctx context.Context, src, dst string, parentFlags []s
 
 	rep, err := images.Scp(ir.ClientCtx, &src, destination, *options)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	fmt.Println("Loaded Image(s):", rep.Id)
-
-	return nil
+	return &entities.ImageLoadReport{Names: []string{rep.Id}}, nil
 }
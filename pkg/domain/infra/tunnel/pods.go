@@ -9,6 +9,7 @@ import (
 	"github.com/containers/podman/v4/pkg/bindings/pods"
 	"github.com/containers/podman/v4/pkg/domain/entities"
 	"github.com/containers/podman/v4/pkg/errorhandling"
+	"github.com/containers/podman/v4/pkg/specgen"
 	"github.com/containers/podman/v4/pkg/util"
 )
 
@@ -201,6 +202,25 @@ func (ic *ContainerEngine) PodCreate(ctx context.Context, specg entities.PodSpec
 	return pods.CreatePodFromSpec(ic.ClientCtx, &specg)
 }
 
+// PodUpdate updates the resource limits and the restart policy of the given pod
+func (ic *ContainerEngine) PodUpdate(ctx context.Context, options *entities.PodUpdateOptions) (*entities.PodUpdateReport, error) {
+	updateOptions := new(pods.UpdateOptions)
+	if options.Specgen != nil {
+		if err := specgen.WeightDevices(options.Specgen); err != nil {
+			return nil, err
+		}
+		if err := specgen.FinishThrottleDevices(options.Specgen); err != nil {
+			return nil, err
+		}
+		updateOptions.Resources = options.Specgen.ResourceLimits
+	}
+	if options.RestartPolicy != "" {
+		updateOptions.WithRestartPolicy(options.RestartPolicy)
+	}
+	updateOptions.RestartRetries = options.RestartRetries
+	return pods.Update(ic.ClientCtx, options.NameOrID, updateOptions)
+}
+
 func (ic *ContainerEngine) PodClone(ctx context.Context, podClone entities.PodCloneOptions) (*entities.PodCloneReport, error) {
 	return nil, nil
 }
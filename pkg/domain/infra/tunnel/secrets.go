@@ -95,3 +95,8 @@ func (ic *ContainerEngine) SecretExists(ctx context.Context, nameOrID string) (*
 	}
 	return &entities.BoolReport{Value: exists}, nil
 }
+
+func (ic *ContainerEngine) SecretUpdate(ctx context.Context, nameOrID string, reader io.Reader, options entities.SecretUpdateOptions) (*entities.SecretUpdateReport, error) {
+	opts := new(secrets.UpdateOptions).WithRestartContainers(options.RestartContainers)
+	return secrets.Update(ic.ClientCtx, nameOrID, reader, opts)
+}
@@ -19,6 +19,14 @@ func (ic *ContainerEngine) VolumeCreate(ctx context.Context, opts entities.Volum
 	return &entities.IDOrNameResponse{IDOrName: response.Name}, nil
 }
 
+func (ic *ContainerEngine) VolumeClone(ctx context.Context, nameOrID string, opts entities.VolumeCloneOptions) (*entities.IDOrNameResponse, error) {
+	response, err := volumes.Clone(ic.ClientCtx, nameOrID, opts.Name, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &entities.IDOrNameResponse{IDOrName: response.Name}, nil
+}
+
 func (ic *ContainerEngine) VolumeRm(ctx context.Context, namesOrIds []string, opts entities.VolumeRmOptions) ([]*entities.VolumeRmReport, error) {
 	if opts.All {
 		vols, err := volumes.List(ic.ClientCtx, nil)
@@ -113,3 +121,46 @@ func (ic *ContainerEngine) VolumeUnmount(ctx context.Context, nameOrIDs []string
 func (ic *ContainerEngine) VolumeReload(ctx context.Context) (*entities.VolumeReloadReport, error) {
 	return nil, errors.New("volume reload is not supported for remote clients")
 }
+
+func (ic *ContainerEngine) VolumePluginList(ctx context.Context) ([]*entities.VolumePluginReport, error) {
+	return nil, errors.New("listing volume plugins is not supported for remote clients")
+}
+
+func (ic *ContainerEngine) VolumePluginInspect(ctx context.Context, names []string) ([]*entities.VolumePluginReport, []error, error) {
+	return nil, nil, errors.New("inspecting volume plugins is not supported for remote clients")
+}
+
+func (ic *ContainerEngine) VolumePluginEnable(ctx context.Context, name string) error {
+	return errors.New("enabling volume plugins is not supported for remote clients")
+}
+
+func (ic *ContainerEngine) VolumePluginDisable(ctx context.Context, name string) error {
+	return errors.New("disabling volume plugins is not supported for remote clients")
+}
+
+func (ic *ContainerEngine) VolumeSnapshotCreate(ctx context.Context, nameOrID string, opts entities.VolumeSnapshotCreateOptions) (*entities.VolumeSnapshotReport, error) {
+	options := new(volumes.SnapshotCreateOptions)
+	if opts.Name != "" {
+		options = options.WithName(opts.Name)
+	}
+	return volumes.SnapshotCreate(ic.ClientCtx, nameOrID, options)
+}
+
+func (ic *ContainerEngine) VolumeSnapshotList(ctx context.Context, nameOrID string) ([]*entities.VolumeSnapshotReport, error) {
+	return volumes.SnapshotList(ic.ClientCtx, nameOrID, nil)
+}
+
+func (ic *ContainerEngine) VolumeSnapshotRestore(ctx context.Context, nameOrID string, snapshot string) error {
+	return volumes.SnapshotRestore(ic.ClientCtx, nameOrID, snapshot, nil)
+}
+
+func (ic *ContainerEngine) VolumeSnapshotRm(ctx context.Context, nameOrID string, snapshots []string) ([]*entities.VolumeSnapshotRmReport, error) {
+	reports := make([]*entities.VolumeSnapshotRmReport, 0, len(snapshots))
+	for _, name := range snapshots {
+		reports = append(reports, &entities.VolumeSnapshotRmReport{
+			Err:  volumes.SnapshotRemove(ic.ClientCtx, nameOrID, name, nil),
+			Name: name,
+		})
+	}
+	return reports, nil
+}
@@ -1,325 +1,286 @@
 package utils
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/url"
 	"os"
-	"os/exec"
 	"os/user"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/containers/common/pkg/config"
-	"github.com/containers/common/pkg/ssh"
+	"github.com/containers/image/v5/docker/reference"
 	"github.com/containers/image/v5/transports/alltransports"
 	"github.com/containers/podman/v4/libpod/define"
+	"github.com/containers/podman/v4/pkg/bindings"
+	"github.com/containers/podman/v4/pkg/bindings/images"
 	"github.com/containers/podman/v4/pkg/domain/entities"
+	"github.com/containers/podman/v4/pkg/rootless"
+	"github.com/docker/go-units"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/term"
 )
 
-func ExecuteTransfer(src, dst string, parentFlags []string, quiet bool, sshMode ssh.EngineMode) (*entities.ImageLoadReport, *entities.ImageScpOptions, *entities.ImageScpOptions, []string, error) {
-	source := entities.ImageScpOptions{}
-	dest := entities.ImageScpOptions{}
-	sshInfo := entities.ImageScpConnections{}
-	report := entities.ImageLoadReport{Names: []string{}}
-
-	podman, err := os.Executable()
-	if err != nil {
-		return nil, nil, nil, nil, err
-	}
-
-	f, err := os.CreateTemp("", "podman") // open temp file for load/save output
-	if err != nil {
-		return nil, nil, nil, nil, err
-	}
-
-	confR, err := config.NewConfig("") // create a hand made config for the remote engine since we might use remote and native at once
-	if err != nil {
-		return nil, nil, nil, nil, fmt.Errorf("could not make config: %w", err)
-	}
+// ScpEndpoint is one side of an image transfer.  Images are transferred as
+// docker archives, which are streamed from the source to the destination.
+type ScpEndpoint interface {
+	// Save writes the image as a docker archive to w.
+	Save(ctx context.Context, image string, w io.Writer) error
+	// Load loads the docker archive read from r and returns the name or
+	// ID of the loaded image.
+	Load(ctx context.Context, r io.Reader) (string, error)
+	// Tag adds the tag to the image.
+	Tag(ctx context.Context, image, tag string) error
+}
 
-	locations := []*entities.ImageScpOptions{}
-	cliConnections := []string{}
+// ExecuteTransfer transfers an image between the local storage and the storage
+// of a Podman service, another user on this host or between two of those.  The
+// local storage is accessed through local.  Connections given as CONNECTION::
+// are looked up in the service destinations of containers.conf, and
+// USER@localhost:: refers to the storage of USER, which is accessed through the
+// Podman service on the default socket of USER unless it is the current user.
+// Unless progress is nil, the number of transferred bytes is reported to it.
+func ExecuteTransfer(ctx context.Context, src, dst string, local ScpEndpoint, progress io.Writer) (*entities.ImageLoadReport, error) {
 	args := []string{src}
 	if len(dst) > 0 {
 		args = append(args, dst)
 	}
+	locations := []*entities.ImageScpOptions{}
+	connections := []string{}
 	for _, arg := range args {
 		loc, connect, err := ParseImageSCPArg(arg)
 		if err != nil {
-			return nil, nil, nil, nil, err
+			return nil, err
+		}
+		connection := ""
+		if len(connect) > 0 {
+			connection = strings.SplitN(connect[0], "::", 2)[0]
 		}
 		locations = append(locations, loc)
-		cliConnections = append(cliConnections, connect...)
+		connections = append(connections, connection)
 	}
-	source = *locations[0]
+
+	source := locations[0]
+	dest := &entities.ImageScpOptions{}
+	destConnection := ""
 	switch {
 	case len(locations) > 1:
-		if err = ValidateSCPArgs(locations); err != nil {
-			return nil, nil, nil, nil, err
-		}
-		dest = *locations[1]
-	case len(locations) == 1:
-		switch {
-		case len(locations[0].Image) == 0:
-			return nil, nil, nil, nil, fmt.Errorf("no source image specified: %w", define.ErrInvalidArg)
-		case len(locations[0].Image) > 0 && !locations[0].Remote && len(locations[0].User) == 0: // if we have podman image scp $IMAGE
-			return nil, nil, nil, nil, fmt.Errorf("must specify a destination: %w", define.ErrInvalidArg)
+		if err := ValidateSCPArgs(locations); err != nil {
+			return nil, err
 		}
+		dest = locations[1]
+		destConnection = connections[1]
+	case len(source.Image) == 0:
+		return nil, fmt.Errorf("no source image specified: %w", define.ErrInvalidArg)
+	case !source.Remote && len(source.User) == 0: // if we have podman image scp $IMAGE
+		return nil, fmt.Errorf("must specify a destination: %w", define.ErrInvalidArg)
 	}
 
-	source.Quiet = quiet
-	source.File = f.Name() // after parsing the arguments, set the file for the save/load
-	dest.File = source.File
-	defer os.Remove(source.File)
-
-	allLocal := true // if we are all localhost, do not validate connections but if we are using one localhost and one non we need to use sshd
-	for _, val := range cliConnections {
-		if !strings.Contains(val, "@localhost::") {
-			allLocal = false
-			break
+	cfg, err := config.ReadCustomConfig()
+	if err != nil {
+		return nil, err
+	}
+	srcEndpoint, srcURI, err := scpConnect(ctx, source, connections[0], local, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if len(locations) == 1 && len(srcURI) == 0 {
+		// podman image scp USER@localhost::IMAGE run by USER itself, which
+		// is root when using sudo, loads into the storage of the sudo user.
+		dest.User = os.Getenv("SUDO_USER")
+		if len(dest.User) == 0 {
+			return nil, errors.New("$SUDO_USER must be defined to find the default rootless user")
 		}
 	}
-	if allLocal {
-		cliConnections = []string{}
+	dstEndpoint, dstURI, err := scpConnect(ctx, dest, destConnection, local, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if srcURI == dstURI {
+		return nil, fmt.Errorf("the source and destination of the transfer are the same storage, use podman tag to name an image: %w", define.ErrInvalidArg)
 	}
 
-	cfg, err := config.ReadCustomConfig() // get ready to set ssh destination if necessary
+	name, err := transferImage(ctx, srcEndpoint, dstEndpoint, source.Image, progress)
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, err
 	}
-	var serv map[string]config.Destination
-	serv, err = GetServiceInformation(&sshInfo, cliConnections, cfg)
-	if err != nil {
-		return nil, nil, nil, nil, err
+	if len(dest.Tag) > 0 {
+		if err := dstEndpoint.Tag(ctx, name, dest.Tag); err != nil {
+			return nil, err
+		}
+		name = dest.Tag
 	}
+	return &entities.ImageLoadReport{Names: []string{name}}, nil
+}
 
-	confR.Engine = config.EngineConfig{Remote: true, CgroupManager: "cgroupfs", ServiceDestinations: serv} // pass the service dest (either remote or something else) to engine
-	saveCmd, loadCmd := CreateCommands(source, dest, parentFlags, podman)
-
+// scpConnect returns the endpoint of the location of a transfer and a URI
+// identifying its storage, which is empty for the local storage.
+func scpConnect(ctx context.Context, location *entities.ImageScpOptions, connection string, local ScpEndpoint, cfg *config.Config) (ScpEndpoint, string, error) {
+	var (
+		uri      string
+		identity string
+		machine  bool
+	)
 	switch {
-	case source.Remote: // if we want to load FROM the remote, dest can either be local or remote in this case
-		err = SaveToRemote(source.Image, source.File, "", sshInfo.URI[0], sshInfo.Identities[0], sshMode)
+	case location.Remote:
+		dest, found := cfg.Engine.ServiceDestinations[connection]
+		if !found { // no match, warn user and do a manual connection.
+			logrus.Warnf("Unknown connection name given. Please use system connection add to specify the default remote socket location")
+			dest = config.Destination{URI: "ssh://" + connection}
+		}
+		connURL, err := url.Parse(dest.URI)
 		if err != nil {
-			return nil, nil, nil, nil, err
+			return nil, "", err
 		}
-		if dest.Remote { // we want to load remote -> remote, both source and dest are remote
-			rep, id, err := LoadToRemote(dest, dest.File, "", sshInfo.URI[1], sshInfo.Identities[1], sshMode)
-			if err != nil {
-				return nil, nil, nil, nil, err
-			}
-			if len(rep) > 0 {
-				fmt.Println(rep)
+		if connURL.Scheme == "ssh" && connURL.User.Username() == "" {
+			if connURL.User, err = GetUserInfo(connURL); err != nil {
+				return nil, "", err
 			}
-			if len(id) > 0 {
-				report.Names = append(report.Names, id)
-			}
-			break
 		}
-		id, err := ExecPodman(dest, podman, loadCmd)
+		uri, identity, machine = connURL.String(), dest.Identity, dest.IsMachine
+	case len(location.User) > 0:
+		u, err := LookupUser(strings.Split(location.User, ":")[0]) // split in case provided with uid:gid
 		if err != nil {
-			return nil, nil, nil, nil, err
-		}
-		if len(id) > 0 {
-			report.Names = append(report.Names, id)
+			return nil, "", err
 		}
-	case dest.Remote: // remote host load, implies source is local
-		_, err = ExecPodman(dest, podman, saveCmd)
-		if err != nil {
-			return nil, nil, nil, nil, err
+		if u.Uid == strconv.Itoa(rootless.GetRootlessUID()) {
+			return local, "", nil
 		}
-
-		rep, id, err := LoadToRemote(dest, source.File, "", sshInfo.URI[0], sshInfo.Identities[0], sshMode)
+		uri = userServiceURI(u)
+		conn, err := bindings.NewConnection(ctx, uri)
 		if err != nil {
-			return nil, nil, nil, nil, err
-		}
-		if len(rep) > 0 {
-			fmt.Println(rep)
-		}
-		if len(id) > 0 {
-			report.Names = append(report.Names, id)
+			return nil, "", fmt.Errorf("connecting to the Podman service of user %s, its podman.socket must be enabled or podman system service running on %s: %w", u.Username, uri, err)
 		}
-		if err = os.Remove(source.File); err != nil {
-			return nil, nil, nil, nil, err
-		}
-	default: // else native load, both source and dest are local and transferring between users
-		if source.User == "" { // source user has to be set, destination does not
-			source.User = os.Getenv("USER")
-			if source.User == "" {
-				u, err := user.Current()
-				if err != nil {
-					return nil, nil, nil, nil, fmt.Errorf("could not obtain user, make sure the environmental variable $USER is set: %w", err)
-				}
-				source.User = u.Username
-			}
-		}
-		return nil, &source, &dest, parentFlags, nil // transfer needs to be done in ABI due to cross issues
+		return &scpService{conn: conn}, uri, nil
+	default:
+		return local, "", nil
 	}
 
-	return &report, nil, nil, nil, nil
-}
-
-// CreateSCPCommand takes an existing command, appends the given arguments and returns a configured podman command for image scp
-func CreateSCPCommand(cmd *exec.Cmd, command []string) *exec.Cmd {
-	cmd.Args = append(cmd.Args, command...)
-	cmd.Env = os.Environ()
-	cmd.Stderr = os.Stderr
-	cmd.Stdout = os.Stdout
-	return cmd
-}
-
-// ScpTag is a helper function for native podman to tag an image after a local load from image SCP
-func ScpTag(cmd *exec.Cmd, podman string, dest entities.ImageScpOptions) error {
-	cmd.Stdout = nil
-	out, err := cmd.Output() // this function captures the output temporarily in order to execute the next command
+	conn, err := bindings.NewConnectionWithIdentity(ctx, uri, identity, machine)
 	if err != nil {
-		return err
-	}
-	image := ExtractImage(out)
-	if cmd.Args[0] == "sudo" { // transferRootless will need the sudo since we are loading to sudo from a user acct
-		cmd = exec.Command("sudo", podman, "tag", image, dest.Tag)
-	} else {
-		cmd = exec.Command(podman, "tag", image, dest.Tag)
+		return nil, "", err
 	}
-	cmd.Stdout = os.Stdout
-	return cmd.Run()
+	return &scpService{conn: conn}, uri, nil
 }
 
-// ExtractImage pulls out the last line of output from save/load (image id)
-func ExtractImage(out []byte) string {
-	fmt.Println(strings.TrimSuffix(string(out), "\n"))         // print output
-	stringOut := string(out)                                   // get all output
-	arrOut := strings.Split(stringOut, " ")                    // split it into an array
-	return strings.ReplaceAll(arrOut[len(arrOut)-1], "\n", "") // replace the trailing \n
-}
-
-// LoginUser starts the user process on the host so that image scp can use systemd-run
-func LoginUser(user string) (*exec.Cmd, error) {
-	sleep, err := exec.LookPath("sleep")
-	if err != nil {
-		return nil, err
-	}
-	machinectl, err := exec.LookPath("machinectl")
-	if err != nil {
-		return nil, err
+// userServiceURI returns the URI of the default socket of the Podman service of
+// the user, which is used by socket activation through podman.socket.
+func userServiceURI(u *user.User) string {
+	if u.Uid == "0" {
+		return "unix:///run/podman/podman.sock"
 	}
-	cmd := exec.Command(machinectl, "shell", "-q", user+"@.host", sleep, "inf")
-	err = cmd.Start()
-	return cmd, err
+	return fmt.Sprintf("unix:///run/user/%s/podman/podman.sock", u.Uid)
 }
 
-// loadToRemote takes image and remote connection information. it connects to the specified client
-// and copies the saved image dir over to the remote host and then loads it onto the machine
-// returns a string containing output or an error
-func LoadToRemote(dest entities.ImageScpOptions, localFile string, tag string, url *url.URL, iden string, sshEngine ssh.EngineMode) (string, string, error) {
-	port, err := strconv.Atoi(url.Port())
-	if err != nil {
-		return "", "", err
-	}
-
-	remoteFile, err := ssh.Exec(&ssh.ConnectionExecOptions{Host: url.String(), Identity: iden, Port: port, User: url.User, Args: []string{"mktemp"}}, sshEngine)
-	if err != nil {
-		return "", "", err
-	}
-
-	opts := ssh.ConnectionScpOptions{User: url.User, Identity: iden, Port: port, Source: localFile, Destination: "ssh://" + url.User.String() + "@" + url.Hostname() + ":" + remoteFile}
-	scpRep, err := ssh.Scp(&opts, sshEngine)
-	if err != nil {
-		return "", "", err
-	}
-	out, err := ssh.Exec(&ssh.ConnectionExecOptions{Host: url.String(), Identity: iden, Port: port, User: url.User, Args: []string{"podman", "image", "load", "--input=" + scpRep + ";", "rm", scpRep}}, sshEngine)
-	if err != nil {
-		return "", "", err
-	}
-	if tag != "" {
-		return "", "", fmt.Errorf("renaming of an image is currently not supported: %w", define.ErrInvalidArg)
-	}
-	rep := strings.TrimSuffix(out, "\n")
-	outArr := strings.Split(rep, " ")
-	id := outArr[len(outArr)-1]
-	if len(dest.Tag) > 0 { // tag the remote image using the output ID
-		_, err := ssh.Exec(&ssh.ConnectionExecOptions{Host: url.Hostname(), Identity: iden, Port: port, User: url.User, Args: []string{"podman", "image", "tag", id, dest.Tag}}, sshEngine)
-		if err != nil {
-			return "", "", err
+// transferImage streams the image from the source to the destination and
+// returns the name or ID of the loaded image.
+func transferImage(ctx context.Context, src, dst ScpEndpoint, image string, progress io.Writer) (string, error) {
+	pr, pw := io.Pipe()
+	saveErr := make(chan error, 1)
+	go func() {
+		var p *scpProgress
+		var w io.Writer = pw
+		if progress != nil {
+			p = &scpProgress{w: pw, out: progress}
+			w = p
 		}
-		if err != nil {
-			return "", "", err
+		err := src.Save(ctx, image, w)
+		if p != nil {
+			p.done(err == nil)
 		}
-	}
-	return rep, id, nil
+		// A nil error makes the destination read EOF.
+		pw.CloseWithError(err)
+		saveErr <- err
+	}()
+
+	name, loadErr := dst.Load(ctx, pr)
+	// Stop the source if the destination stopped reading early.
+	pr.Close()
+	err := <-saveErr
+	switch {
+	case err != nil && !errors.Is(err, io.ErrClosedPipe):
+		// The destination fails as well, but the source has the cause.
+		return "", err
+	case loadErr != nil:
+		return "", loadErr
+	case err != nil:
+		return "", err
+	}
+	return name, nil
 }
 
-// saveToRemote takes image information and remote connection information. it connects to the specified client
-// and saves the specified image on the remote machine and then copies it to the specified local location
-// returns an error if one occurs.
-func SaveToRemote(image, localFile string, tag string, uri *url.URL, iden string, sshEngine ssh.EngineMode) error {
-	if tag != "" {
-		return fmt.Errorf("renaming of an image is currently not supported: %w", define.ErrInvalidArg)
-	}
+// scpService is a Podman service on one side of an image transfer.  It is
+// reached through the bindings and uses the image export and load endpoints.
+type scpService struct {
+	conn context.Context
+}
 
-	port, err := strconv.Atoi(uri.Port())
-	if err != nil {
-		return err
-	}
+func (s *scpService) Save(_ context.Context, image string, w io.Writer) error {
+	options := new(images.ExportOptions).WithFormat(define.V2s2Archive)
+	return images.Export(s.conn, []string{image}, w, options)
+}
 
-	remoteFile, err := ssh.Exec(&ssh.ConnectionExecOptions{Host: uri.String(), Identity: iden, Port: port, User: uri.User, Args: []string{"mktemp"}}, sshEngine)
+func (s *scpService) Load(_ context.Context, r io.Reader) (string, error) {
+	report, err := images.Load(s.conn, r)
 	if err != nil {
-		return err
+		return "", err
 	}
-
-	_, err = ssh.Exec(&ssh.ConnectionExecOptions{Host: uri.String(), Identity: iden, Port: port, User: uri.User, Args: []string{"podman", "image", "save", image, "--format", "oci-archive", "--output", remoteFile}}, sshEngine)
-	if err != nil {
-		return err
+	if len(report.Names) == 0 {
+		return "", errors.New("no image was loaded")
 	}
+	return report.Names[0], nil
+}
 
-	opts := ssh.ConnectionScpOptions{User: uri.User, Identity: iden, Port: port, Source: "ssh://" + uri.User.String() + "@" + uri.Hostname() + ":" + remoteFile, Destination: localFile}
-	scpRep, err := ssh.Scp(&opts, sshEngine)
+func (s *scpService) Tag(_ context.Context, image, tag string) error {
+	ref, err := reference.Parse(tag)
 	if err != nil {
-		return err
+		return fmt.Errorf("parsing reference %q: %w", tag, err)
 	}
-	_, err = ssh.Exec(&ssh.ConnectionExecOptions{Host: uri.String(), Identity: iden, Port: port, User: uri.User, Args: []string{"rm", scpRep}}, sshEngine)
-	if err != nil {
-		logrus.Errorf("Removing file on endpoint: %v", err)
+	var repo string
+	if r, ok := ref.(reference.Named); ok {
+		repo = r.Name()
+	}
+	if len(repo) < 1 {
+		return fmt.Errorf("invalid image name %q", tag)
 	}
+	tag = ""
+	if t, ok := ref.(reference.Tagged); ok {
+		tag = t.Tag()
+	}
+	return images.Tag(s.conn, image, tag, repo, nil)
+}
 
-	return nil
+// scpProgress reports the number of bytes written through it.  On a terminal
+// the count is updated every second, otherwise only the total is reported.
+type scpProgress struct {
+	w        io.Writer
+	out      io.Writer
+	written  int64
+	reported time.Time
 }
 
-// execPodman executes the podman save/load command given the podman binary
-func ExecPodman(dest entities.ImageScpOptions, podman string, command []string) (string, error) {
-	cmd := exec.Command(podman)
-	CreateSCPCommand(cmd, command[1:])
-	logrus.Debugf("Executing podman command: %q", cmd)
-	if strings.Contains(strings.Join(command, " "), "load") { // need to tag
-		if len(dest.Tag) > 0 {
-			return "", ScpTag(cmd, podman, dest)
-		}
-		cmd.Stdout = nil
-		out, err := cmd.Output()
-		if err != nil {
-			return "", err
-		}
-		img := ExtractImage(out)
-		return img, nil
+func (p *scpProgress) Write(b []byte) (int, error) {
+	n, err := p.w.Write(b)
+	p.written += int64(n)
+	if f, ok := p.out.(*os.File); ok && term.IsTerminal(int(f.Fd())) && time.Since(p.reported) >= time.Second {
+		fmt.Fprintf(p.out, "\rCopying image archive: %s", units.HumanSize(float64(p.written)))
+		p.reported = time.Now()
 	}
-	return "", cmd.Run()
+	return n, err
 }
 
-// createCommands forms the podman save and load commands used by SCP
-func CreateCommands(source entities.ImageScpOptions, dest entities.ImageScpOptions, parentFlags []string, podman string) ([]string, []string) {
-	var parentString string
-	quiet := ""
-	if source.Quiet {
-		quiet = "-q "
-	}
-	if len(parentFlags) > 0 {
-		parentString = strings.Join(parentFlags, " ") + " " // if there are parent args, an extra space needs to be added
-	} else {
-		parentString = strings.Join(parentFlags, " ")
+// done ends the progress line and reports the total if the copy succeeded.
+func (p *scpProgress) done(succeeded bool) {
+	if !p.reported.IsZero() {
+		fmt.Fprintln(p.out)
+	}
+	if succeeded {
+		fmt.Fprintf(p.out, "Copied image archive: %s\n", units.HumanSize(float64(p.written)))
 	}
-	loadCmd := strings.Split(fmt.Sprintf("%s %sload %s--input %s", podman, parentString, quiet, dest.File), " ")
-	saveCmd := strings.Split(fmt.Sprintf("%s %vsave %s--output %s %s", podman, parentString, quiet, source.File, source.Image), " ")
-	return saveCmd, loadCmd
 }
 
 // parseImageSCPArg returns the valid connection, and source/destination data based off of the information provided by the user
@@ -400,36 +361,12 @@ func RemoteArgLength(input string, side int) int {
 	return -1
 }
 
-// GetServiceInformation takes the parsed list of hosts to connect to and validates the information
-func GetServiceInformation(sshInfo *entities.ImageScpConnections, cliConnections []string, cfg *config.Config) (map[string]config.Destination, error) {
-	var serv map[string]config.Destination
-	var urlS string
-	var iden string
-	for i, val := range cliConnections {
-		splitEnv := strings.SplitN(val, "::", 2)
-		sshInfo.Connections = append(sshInfo.Connections, splitEnv[0])
-		conn, found := cfg.Engine.ServiceDestinations[sshInfo.Connections[i]]
-		if found {
-			urlS = conn.URI
-			iden = conn.Identity
-		} else { // no match, warn user and do a manual connection.
-			urlS = "ssh://" + sshInfo.Connections[i]
-			iden = ""
-			logrus.Warnf("Unknown connection name given. Please use system connection add to specify the default remote socket location")
-		}
-		urlFinal, err := url.Parse(urlS) // create an actual url to pass to exec command
-		if err != nil {
-			return nil, err
-		}
-		if urlFinal.User.Username() == "" {
-			if urlFinal.User, err = GetUserInfo(urlFinal); err != nil {
-				return nil, err
-			}
-		}
-		sshInfo.URI = append(sshInfo.URI, urlFinal)
-		sshInfo.Identities = append(sshInfo.Identities, iden)
+// LookupUser looks up a user by UID or name.
+func LookupUser(u string) (*user.User, error) {
+	if u, err := user.LookupId(u); err == nil {
+		return u, nil
 	}
-	return serv, nil
+	return user.Lookup(u)
 }
 
 func GetUserInfo(uri *url.URL) (*url.Userinfo, error) {
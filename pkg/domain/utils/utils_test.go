@@ -1,8 +1,12 @@
 package utils
 
 import (
+	"context"
+	"errors"
+	"io"
 	"net/url"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/containers/podman/v4/pkg/domain/entities"
@@ -113,3 +117,60 @@ func TestParseSCPArgs(t *testing.T) {
 	assert.True(t, source.Remote)
 	assert.Equal(t, source.Image, "alpine")
 }
+
+type fakeScpEndpoint struct {
+	archive string
+	saveErr error
+	loadErr error
+	loaded  string
+}
+
+func (f *fakeScpEndpoint) Save(_ context.Context, image string, w io.Writer) error {
+	if _, err := io.WriteString(w, f.archive); err != nil {
+		return err
+	}
+	return f.saveErr
+}
+
+func (f *fakeScpEndpoint) Load(_ context.Context, r io.Reader) (string, error) {
+	if f.loadErr != nil {
+		return "", f.loadErr
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	f.loaded = string(data)
+	return "loaded", nil
+}
+
+func (f *fakeScpEndpoint) Tag(_ context.Context, image, tag string) error {
+	return nil
+}
+
+func TestTransferImage(t *testing.T) {
+	archive := strings.Repeat("layer", 100000)
+	src := &fakeScpEndpoint{archive: archive}
+	dst := &fakeScpEndpoint{}
+	progress := &strings.Builder{}
+	name, err := transferImage(context.Background(), src, dst, "alpine", progress)
+	assert.NoError(t, err)
+	assert.Equal(t, "loaded", name)
+	assert.Equal(t, archive, dst.loaded)
+	assert.Equal(t, "Copied image archive: 500kB\n", progress.String())
+
+	// The error of the source is reported, even if the destination fails
+	// because of the truncated archive.
+	saveErr := errors.New("save failed")
+	src = &fakeScpEndpoint{archive: archive, saveErr: saveErr}
+	progress.Reset()
+	_, err = transferImage(context.Background(), src, &fakeScpEndpoint{}, "alpine", progress)
+	assert.ErrorIs(t, err, saveErr)
+	assert.Empty(t, progress.String())
+
+	// A destination failing before reading the archive must not block the
+	// source.
+	loadErr := errors.New("load failed")
+	_, err = transferImage(context.Background(), &fakeScpEndpoint{archive: archive}, &fakeScpEndpoint{loadErr: loadErr}, "alpine", nil)
+	assert.ErrorIs(t, err, loadErr)
+}
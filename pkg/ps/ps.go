@@ -145,6 +145,7 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 		portMappings                            []libnetworkTypes.PortMapping
 		networks                                []string
 		healthStatus                            string
+		readiness                               string
 		restartCount                            uint
 	)
 
@@ -194,6 +195,11 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 			return err
 		}
 
+		readiness, err = c.ReadinessStatus()
+		if err != nil {
+			return err
+		}
+
 		restartCount, err = c.RestartCount()
 		if err != nil {
 			return err
@@ -253,6 +259,7 @@ func ListContainerBatch(rt *libpod.Runtime, ctr *libpod.Container, opts entities
 		Pid:        pid,
 		Pod:        conConfig.Pod,
 		Ports:      portMappings,
+		Readiness:  readiness,
 		Size:       size,
 		StartedAt:  startedTime.Unix(),
 		State:      conState.String(),
@@ -33,3 +33,15 @@ type Config struct {
 	ContainerID string
 	RootlessCNI bool
 }
+
+// PortUpdate is sent to the socket of a running rootlessport process to add
+// and remove port mappings.  The socket also accepts a plain JSON string with
+// the new child IP to reload all ports.
+type PortUpdate struct {
+	// ChildIP is the IP the added ports are forwarded to.
+	ChildIP string
+	// Add are the port mappings to add.
+	Add []types.PortMapping
+	// Remove are the port mappings to remove.
+	Remove []types.PortMapping
+}
@@ -573,6 +573,9 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 		restartPolicy = s.RestartPolicy
 	}
 	options = append(options, libpod.WithRestartRetries(retries), libpod.WithRestartPolicy(restartPolicy))
+	if s.RestartDependents {
+		options = append(options, libpod.WithRestartDependents())
+	}
 
 	if s.ContainerHealthCheckConfig.HealthConfig != nil {
 		options = append(options, libpod.WithHealthCheck(s.ContainerHealthCheckConfig.HealthConfig))
@@ -581,6 +584,12 @@ func createContainerOptions(rt *libpod.Runtime, s *specgen.SpecGenerator, pod *l
 	if s.ContainerHealthCheckConfig.StartupHealthConfig != nil {
 		options = append(options, libpod.WithStartupHealthcheck(s.ContainerHealthCheckConfig.StartupHealthConfig))
 	}
+	if s.ContainerHealthCheckConfig.ReadinessConfig != nil {
+		options = append(options, libpod.WithReadinessCheck(s.ContainerHealthCheckConfig.ReadinessConfig))
+	}
+	if len(s.PostStart) > 0 || len(s.PreStop) > 0 {
+		options = append(options, libpod.WithLifecycleHooks(s.PostStart, s.PreStop))
+	}
 
 	if s.ContainerHealthCheckConfig.HealthCheckOnFailureAction != define.HealthCheckOnFailureActionNone {
 		options = append(options, libpod.WithHealthCheckOnFailureAction(s.ContainerHealthCheckConfig.HealthCheckOnFailureAction))
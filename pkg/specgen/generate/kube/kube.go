@@ -245,6 +245,14 @@ func ToSpecGen(ctx context.Context, opts *CtrSpecGenOptions) (*specgen.SpecGener
 	if err != nil {
 		return nil, fmt.Errorf("failed to configure startupProbe: %w", err)
 	}
+	err = setupReadinessProbe(s, opts.Container)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure readinessProbe: %w", err)
+	}
+	err = setupLifecycleHooks(s, opts.Container)
+	if err != nil {
+		return nil, fmt.Errorf("failed to configure lifecycle: %w", err)
+	}
 
 	// Since we prefix the container name with pod name to work-around the uniqueness requirement,
 	// the seccomp profile should reference the actual container name from the YAML
@@ -578,26 +586,31 @@ func probeToHealthConfig(probe *v1.Probe) (*manifest.Schema2HealthConfig, error)
 		}
 		commandString = string(cmd)
 	case probeHandler.HTTPGet != nil:
-		// set defaults as in https://kubernetes.io/docs/tasks/configure-pod-container/configure-liveness-readiness-startup-probes/#http-probes
-		uriScheme := v1.URISchemeHTTP
-		if probeHandler.HTTPGet.Scheme != "" {
-			uriScheme = probeHandler.HTTPGet.Scheme
-		}
-		host := "localhost" // Kubernetes default is host IP, but with Podman there is only one node
-		if probeHandler.HTTPGet.Host != "" {
-			host = probeHandler.HTTPGet.Host
-		}
-		path := "/"
-		if probeHandler.HTTPGet.Path != "" {
-			path = probeHandler.HTTPGet.Path
-		}
-		commandString = fmt.Sprintf("curl -f %s://%s:%d%s || %s", uriScheme, host, probeHandler.HTTPGet.Port.IntValue(), path, failureCmd)
+		commandString = fmt.Sprintf("%s || %s", httpGetCommand(probeHandler.HTTPGet), failureCmd)
 	case probeHandler.TCPSocket != nil:
 		commandString = fmt.Sprintf("nc -z -v %s %d || %s", probeHandler.TCPSocket.Host, probeHandler.TCPSocket.Port.IntValue(), failureCmd)
 	}
 	return makeHealthCheck(commandString, probe.PeriodSeconds, probe.FailureThreshold, probe.TimeoutSeconds, probe.InitialDelaySeconds)
 }
 
+// httpGetCommand returns a curl command line performing the given HTTP request
+func httpGetCommand(action *v1.HTTPGetAction) string {
+	// set defaults as in https://kubernetes.io/docs/tasks/configure-pod-container/configure-liveness-readiness-startup-probes/#http-probes
+	uriScheme := v1.URISchemeHTTP
+	if action.Scheme != "" {
+		uriScheme = action.Scheme
+	}
+	host := "localhost" // Kubernetes default is host IP, but with Podman there is only one node
+	if action.Host != "" {
+		host = action.Host
+	}
+	path := "/"
+	if action.Path != "" {
+		path = action.Path
+	}
+	return fmt.Sprintf("curl -f %s://%s:%d%s", uriScheme, host, action.Port.IntValue(), path)
+}
+
 func setupLivenessProbe(s *specgen.SpecGenerator, containerYAML v1.Container, restartPolicy string) error {
 	var err error
 	if containerYAML.LivenessProbe == nil {
@@ -650,6 +663,61 @@ func setupStartupProbe(s *specgen.SpecGenerator, containerYAML v1.Container, res
 	return nil
 }
 
+func setupReadinessProbe(s *specgen.SpecGenerator, containerYAML v1.Container) error {
+	if containerYAML.ReadinessProbe == nil {
+		return nil
+	}
+	emptyHandler := v1.Handler{}
+	if containerYAML.ReadinessProbe.Handler == emptyHandler {
+		return nil
+	}
+	healthConfig, err := probeToHealthConfig(containerYAML.ReadinessProbe)
+	if err != nil {
+		return err
+	}
+	s.ReadinessConfig = &define.ReadinessCheck{
+		Schema2HealthConfig: *healthConfig,
+		Successes:           int(containerYAML.ReadinessProbe.SuccessThreshold),
+	}
+	return nil
+}
+
+func setupLifecycleHooks(s *specgen.SpecGenerator, containerYAML v1.Container) error {
+	if containerYAML.Lifecycle == nil {
+		return nil
+	}
+	var err error
+	if containerYAML.Lifecycle.PostStart != nil {
+		s.PostStart, err = lifecycleHandlerToCommand(containerYAML.Lifecycle.PostStart)
+		if err != nil {
+			return fmt.Errorf("postStart: %w", err)
+		}
+	}
+	if containerYAML.Lifecycle.PreStop != nil {
+		s.PreStop, err = lifecycleHandlerToCommand(containerYAML.Lifecycle.PreStop)
+		if err != nil {
+			return fmt.Errorf("preStop: %w", err)
+		}
+	}
+	return nil
+}
+
+// lifecycleHandlerToCommand returns the command executed inside the container
+// for the given lifecycle hook handler
+func lifecycleHandlerToCommand(handler *v1.Handler) ([]string, error) {
+	switch {
+	case handler.Exec != nil:
+		if len(handler.Exec.Command) == 0 {
+			return nil, errors.New("exec handler requires a command")
+		}
+		return handler.Exec.Command, nil
+	case handler.HTTPGet != nil:
+		return []string{"/bin/sh", "-c", httpGetCommand(handler.HTTPGet)}, nil
+	default:
+		return nil, errors.New("only exec and httpGet handlers are supported")
+	}
+}
+
 func makeHealthCheck(inCmd string, interval int32, retries int32, timeout int32, startPeriod int32) (*manifest.Schema2HealthConfig, error) {
 	// Every healthcheck requires a command
 	if len(inCmd) == 0 {
@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/containers/common/pkg/secrets"
 	v1 "github.com/containers/podman/v4/pkg/k8s.io/api/core/v1"
@@ -1258,3 +1259,76 @@ func TestHttpLivenessProbe(t *testing.T) {
 		})
 	}
 }
+
+func TestReadinessProbe(t *testing.T) {
+	s := specgen.SpecGenerator{}
+	err := setupReadinessProbe(&s, v1.Container{
+		ReadinessProbe: &v1.Probe{
+			Handler: v1.Handler{
+				HTTPGet: &v1.HTTPGetAction{
+					Port: intstr.FromInt(8080),
+					Path: "/ready",
+				},
+			},
+			PeriodSeconds:    5,
+			SuccessThreshold: 2,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, s.HealthConfig)
+	assert.NotNil(t, s.ReadinessConfig)
+	assert.Contains(t, s.ReadinessConfig.Test, "http://localhost:8080/ready")
+	assert.Equal(t, 5*time.Second, s.ReadinessConfig.Interval)
+	assert.Equal(t, 2, s.ReadinessConfig.Successes)
+	assert.Equal(t, 3, s.ReadinessConfig.Retries)
+}
+
+func TestLifecycleHooks(t *testing.T) {
+	tests := []struct {
+		name      string
+		lifecycle *v1.Lifecycle
+		succeed   bool
+		postStart []string
+		preStop   []string
+	}{
+		{
+			"ExecHooks",
+			&v1.Lifecycle{
+				PostStart: &v1.Handler{Exec: &v1.ExecAction{Command: []string{"touch", "/started"}}},
+				PreStop:   &v1.Handler{Exec: &v1.ExecAction{Command: []string{"/bin/sh", "-c", "nginx -s quit"}}},
+			},
+			true,
+			[]string{"touch", "/started"},
+			[]string{"/bin/sh", "-c", "nginx -s quit"},
+		},
+		{
+			"HttpGetHook",
+			&v1.Lifecycle{
+				PreStop: &v1.Handler{HTTPGet: &v1.HTTPGetAction{Port: intstr.FromInt(8080), Path: "/shutdown"}},
+			},
+			true,
+			nil,
+			[]string{"/bin/sh", "-c", "curl -f http://localhost:8080/shutdown"},
+		},
+		{
+			"TCPSocketHookUnsupported",
+			&v1.Lifecycle{
+				PostStart: &v1.Handler{TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt(8080)}},
+			},
+			false,
+			nil,
+			nil,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			s := specgen.SpecGenerator{}
+			err := setupLifecycleHooks(&s, v1.Container{Lifecycle: test.lifecycle})
+			assert.Equal(t, test.succeed, err == nil)
+			assert.Equal(t, test.postStart, s.PostStart)
+			assert.Equal(t, test.preStop, s.PreStop)
+		})
+	}
+}
@@ -121,6 +121,10 @@ type ContainerBasicConfig struct {
 	// Only available when RestartPolicy is set to "on-failure".
 	// Optional.
 	RestartRetries *uint `json:"restart_tries,omitempty"`
+	// RestartDependents restarts the containers depending on this
+	// container, in dependency order, whenever it is restarted.
+	// Optional.
+	RestartDependents bool `json:"restart_dependents,omitempty"`
 	// OCIRuntime is the name of the OCI runtime that will be used to create
 	// the container.
 	// If not specified, the default will be used.
@@ -200,6 +204,14 @@ type ContainerBasicConfig struct {
 	// InitContainerType describes if this container is an init container
 	// and if so, what type: always or once
 	InitContainerType string `json:"init_container_type"`
+	// PostStart is a command executed inside the container right after it
+	// was started. The container is killed if the command fails.
+	// Optional.
+	PostStart []string `json:"post_start,omitempty"`
+	// PreStop is a command executed inside the container before the stop
+	// signal is sent to it.
+	// Optional.
+	PreStop []string `json:"pre_stop,omitempty"`
 	// Personality allows users to configure different execution domains.
 	// Execution domains tell Linux how to map signal numbers into signal actions.
 	// The execution domain system allows Linux to provide limited support
@@ -556,6 +568,10 @@ type ContainerHealthCheckConfig struct {
 	// Requires that HealthConfig be set.
 	// Optional.
 	StartupHealthConfig *define.StartupHealthCheck `json:"startupHealthConfig,omitempty"`
+	// Readiness check for a container. It only tracks whether the
+	// container is ready and never acts on the container.
+	// Optional.
+	ReadinessConfig *define.ReadinessCheck `json:"readinessConfig,omitempty"`
 }
 
 // SpecGenerator creates an OCI spec and Libpod configuration options to create
@@ -273,10 +273,33 @@ func CreateExitCommandArgs(storageConfig storageTypes.StoreOptions, config *conf
 		return nil, err
 	}
 
-	command := []string{podmanPath,
+	command := []string{podmanPath, "--log-level", logrus.GetLevel().String()}
+	command = append(command, CreateGlobalArgs(storageConfig, config)...)
+
+	if syslog {
+		command = append(command, "--syslog")
+	}
+	command = append(command, []string{"container", "cleanup"}...)
+
+	if rm {
+		command = append(command, "--rm")
+	}
+
+	// This has to be absolutely last, to ensure that the exec session ID
+	// will be added after it by Libpod.
+	if exec {
+		command = append(command, "--exec")
+	}
+
+	return command, nil
+}
+
+// CreateGlobalArgs returns the global options which make another Podman
+// process use the same storage, database, network and runtime configuration.
+func CreateGlobalArgs(storageConfig storageTypes.StoreOptions, config *config.Config) []string {
+	command := []string{
 		"--root", storageConfig.GraphRoot,
 		"--runroot", storageConfig.RunRoot,
-		"--log-level", logrus.GetLevel().String(),
 		"--cgroup-manager", config.Engine.CgroupManager,
 		"--tmpdir", config.Engine.TmpDir,
 		"--network-config-dir", config.Network.NetworkConfigDir,
@@ -297,21 +320,5 @@ func CreateExitCommandArgs(storageConfig storageTypes.StoreOptions, config *conf
 	if config.Engine.EventsLogger != "" {
 		command = append(command, []string{"--events-backend", config.Engine.EventsLogger}...)
 	}
-
-	if syslog {
-		command = append(command, "--syslog")
-	}
-	command = append(command, []string{"container", "cleanup"}...)
-
-	if rm {
-		command = append(command, "--rm")
-	}
-
-	// This has to be absolutely last, to ensure that the exec session ID
-	// will be added after it by Libpod.
-	if exec {
-		command = append(command, "--exec")
-	}
-
-	return command, nil
+	return command
 }
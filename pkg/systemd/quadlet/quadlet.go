@@ -19,15 +19,19 @@ const (
 
 	// Names of commonly used systemd/quadlet group names
 	ContainerGroup  = "Container"
+	ImageGroup      = "Image"
 	InstallGroup    = "Install"
 	KubeGroup       = "Kube"
 	NetworkGroup    = "Network"
+	PodGroup        = "Pod"
 	ServiceGroup    = "Service"
 	UnitGroup       = "Unit"
 	VolumeGroup     = "Volume"
 	XContainerGroup = "X-Container"
+	XImageGroup     = "X-Image"
 	XKubeGroup      = "X-Kube"
 	XNetworkGroup   = "X-Network"
+	XPodGroup       = "X-Pod"
 	XVolumeGroup    = "X-Volume"
 )
 
@@ -35,7 +39,11 @@ const (
 const (
 	KeyAddCapability         = "AddCapability"
 	KeyAddDevice             = "AddDevice"
+	KeyAllTags               = "AllTags"
 	KeyAnnotation            = "Annotation"
+	KeyArch                  = "Arch"
+	KeyAuthFile              = "AuthFile"
+	KeyCertDir               = "CertDir"
 	KeyConfigMap             = "ConfigMap"
 	KeyContainerName         = "ContainerName"
 	KeyCopy                  = "Copy"
@@ -60,6 +68,7 @@ const (
 	KeyHealthTimeout         = "HealthTimeout"
 	KeyHostName              = "HostName"
 	KeyImage                 = "Image"
+	KeyInfraImage            = "InfraImage"
 	KeyIP                    = "IP"
 	KeyIP6                   = "IP6"
 	KeyExitCodePropagation   = "ExitCodePropagation"
@@ -78,7 +87,10 @@ const (
 	KeyNetworkSubnet         = "Subnet"
 	KeyNoNewPrivileges       = "NoNewPrivileges"
 	KeyNotify                = "Notify"
+	KeyOS                    = "OS"
 	KeyOptions               = "Options"
+	KeyPod                   = "Pod"
+	KeyPodName               = "PodName"
 	KeyPodmanArgs            = "PodmanArgs"
 	KeyPublishPort           = "PublishPort"
 	KeyPull                  = "Pull"
@@ -87,6 +99,7 @@ const (
 	KeyRemapUID              = "RemapUid"
 	KeyRemapUIDSize          = "RemapUidSize"
 	KeyRemapUsers            = "RemapUsers"
+	KeyRestartDependents     = "RestartDependents"
 	KeyRootfs                = "Rootfs"
 	KeyRunInit               = "RunInit"
 	KeySeccompProfile        = "SeccompProfile"
@@ -98,10 +111,12 @@ const (
 	KeySecret                = "Secret"
 	KeySysctl                = "Sysctl"
 	KeyTimezone              = "Timezone"
+	KeyTLSVerify             = "TLSVerify"
 	KeyTmpfs                 = "Tmpfs"
 	KeyType                  = "Type"
 	KeyUser                  = "User"
 	KeyUserNS                = "UserNS"
+	KeyVariant               = "Variant"
 	KeyVolatileTmp           = "VolatileTmp"
 	KeyVolume                = "Volume"
 	KeyWorkingDir            = "WorkingDir"
@@ -145,6 +160,7 @@ var (
 		KeyNetwork:               true,
 		KeyNoNewPrivileges:       true,
 		KeyNotify:                true,
+		KeyPod:                   true,
 		KeyPodmanArgs:            true,
 		KeyPublishPort:           true,
 		KeyPull:                  true,
@@ -153,6 +169,7 @@ var (
 		KeyRemapUID:              true,
 		KeyRemapUIDSize:          true,
 		KeyRemapUsers:            true,
+		KeyRestartDependents:     true,
 		KeyRootfs:                true,
 		KeyRunInit:               true,
 		KeySeccompProfile:        true,
@@ -214,8 +231,72 @@ var (
 		KeyUserNS:              true,
 		KeyYaml:                true,
 	}
+
+	// Supported keys in "Image" group
+	supportedImageKeys = map[string]bool{
+		KeyAllTags:    true,
+		KeyArch:       true,
+		KeyAuthFile:   true,
+		KeyCertDir:    true,
+		KeyImage:      true,
+		KeyOS:         true,
+		KeyPodmanArgs: true,
+		KeyTLSVerify:  true,
+		KeyVariant:    true,
+	}
+
+	// Supported keys in "Pod" group
+	supportedPodKeys = map[string]bool{
+		KeyInfraImage:        true,
+		KeyIP:                true,
+		KeyIP6:               true,
+		KeyNetwork:           true,
+		KeyPodName:           true,
+		KeyPodmanArgs:        true,
+		KeyPublishPort:       true,
+		KeyRemapGID:          true,
+		KeyRemapUID:          true,
+		KeyRemapUIDSize:      true,
+		KeyRemapUsers:        true,
+		KeyRestartDependents: true,
+		KeyUserNS:            true,
+		KeyVolume:            true,
+	}
 )
 
+// ImageInfo holds what is known about a quadlet image, so that containers
+// referencing it via the Image key can depend on it.
+type ImageInfo struct {
+	// Name of the generated systemd service without the .service suffix
+	ServiceName string
+	// Image reference pulled by the service, set once the image unit is converted
+	ImageName string
+}
+
+// NewImageInfo returns the ImageInfo for the quadlet image file with the given name.
+func NewImageInfo(name string) *ImageInfo {
+	return &ImageInfo{
+		ServiceName: replaceExtension(name, "", "", "-image"),
+	}
+}
+
+// PodInfo holds what is known about a quadlet pod before the pod unit itself
+// is converted, so that containers referencing it via the Pod key can be
+// registered with it.
+type PodInfo struct {
+	// Name of the generated systemd service without the .service suffix
+	ServiceName string
+	// Filenames of the generated services of the containers in the pod
+	Containers []string
+}
+
+// NewPodInfo returns the PodInfo for the quadlet pod file with the given name.
+func NewPodInfo(name string) *PodInfo {
+	return &PodInfo{
+		ServiceName: replaceExtension(name, "", "", "-pod"),
+	}
+}
+
 func replaceExtension(name string, extension string, extraPrefix string, extraSuffix string) string {
 	baseName := name
 
@@ -289,7 +370,7 @@ func usernsOpts(kind string, opts []string) string {
 // service file (unit file with Service group) based on the options in the
 // Container group.
 // The original Container group is kept around as X-Container.
-func ConvertContainer(container *parser.UnitFile, isUser bool) (*parser.UnitFile, error) {
+func ConvertContainer(container *parser.UnitFile, isUser bool, imagesInfoMap map[string]*ImageInfo, podsInfoMap map[string]*PodInfo) (*parser.UnitFile, error) {
 	service := container.Dup()
 	service.Filename = replaceExtension(container.Filename, ".service", "", "")
 
@@ -314,6 +395,14 @@ func ConvertContainer(container *parser.UnitFile, isUser bool) (*parser.UnitFile
 		return nil, fmt.Errorf("the Image And Rootfs keys conflict can not be specified together")
 	}
 
+	if len(image) > 0 {
+		var err error
+		image, err = handleImageSource(image, service, imagesInfoMap)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	containerName, ok := container.Lookup(ContainerGroup, KeyContainerName)
 	if !ok || len(containerName) == 0 {
 		// By default, We want to name the container by the service name
@@ -481,6 +570,10 @@ func ConvertContainer(container *parser.UnitFile, isUser bool) (*parser.UnitFile
 		podman.addBool("--read-only", readOnly)
 	}
 
+	if restartDependents, ok := container.LookupBoolean(ContainerGroup, KeyRestartDependents); ok {
+		podman.addBool("--restart-dependents", restartDependents)
+	}
+
 	volatileTmp := container.LookupBooleanWithDefault(ContainerGroup, KeyVolatileTmp, false)
 	if volatileTmp {
 		/* Read only mode already has a tmpfs by default */
@@ -525,37 +618,8 @@ func ConvertContainer(container *parser.UnitFile, isUser bool) (*parser.UnitFile
 		podman.add("--tmpfs", tmpfs)
 	}
 
-	volumes := container.LookupAll(ContainerGroup, KeyVolume)
-	for _, volume := range volumes {
-		parts := strings.SplitN(volume, ":", 3)
-
-		source := ""
-		var dest string
-		options := ""
-		if len(parts) >= 2 {
-			source = parts[0]
-			dest = parts[1]
-		} else {
-			dest = parts[0]
-		}
-		if len(parts) >= 3 {
-			options = ":" + parts[2]
-		}
-
-		if source != "" {
-			var err error
-			source, err = handleStorageSource(container, service, source)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		podman.add("-v")
-		if source == "" {
-			podman.add(dest)
-		} else {
-			podman.addf("%s:%s%s", source, dest, options)
-		}
+	if err := addVolumes(container, service, ContainerGroup, podman); err != nil {
+		return nil, err
 	}
 
 	exposedPorts := container.LookupAll(ContainerGroup, KeyExposeHostPort)
@@ -643,6 +707,10 @@ func ConvertContainer(container *parser.UnitFile, isUser bool) (*parser.UnitFile
 
 	handleHealth(container, ContainerGroup, podman)
 
+	if err := handlePod(container, service, ContainerGroup, podsInfoMap, podman); err != nil {
+		return nil, err
+	}
+
 	if hostname, ok := container.Lookup(ContainerGroup, KeyHostName); ok {
 		podman.add("--hostname", hostname)
 	}
@@ -966,6 +1034,196 @@ func ConvertKube(kube *parser.UnitFile, isUser bool) (*parser.UnitFile, error) {
 	return service, nil
 }
 
+// Convert a quadlet image file (unit file with an Image group) to a systemd
+// service file (unit file with Service group) based on the options in the
+// Image group.
+// The original Image group is kept around as X-Image.
+func ConvertImage(image *parser.UnitFile, imagesInfoMap map[string]*ImageInfo) (*parser.UnitFile, error) {
+	imageInfo, ok := imagesInfoMap[image.Filename]
+	if !ok {
+		return nil, fmt.Errorf("internal error while processing image %s", image.Filename)
+	}
+
+	service := image.Dup()
+	service.Filename = imageInfo.ServiceName + ".service"
+
+	if image.Path != "" {
+		service.Add(UnitGroup, "SourcePath", image.Path)
+	}
+
+	if err := checkForUnknownKeys(image, ImageGroup, supportedImageKeys); err != nil {
+		return nil, err
+	}
+
+	imageName, ok := image.Lookup(ImageGroup, KeyImage)
+	if !ok || len(imageName) == 0 {
+		return nil, fmt.Errorf("no Image key specified")
+	}
+
+	/* Rename old Image group to x-Image so that systemd ignores it */
+	service.RenameGroup(ImageGroup, XImageGroup)
+
+	// Need the containers filesystem mounted to start podman
+	service.Add(UnitGroup, "RequiresMountsFor", "%t/containers")
+
+	podman := NewPodmanCmdline("image", "pull")
+
+	stringKeys := [][2]string{
+		{KeyArch, "--arch"},
+		{KeyAuthFile, "--authfile"},
+		{KeyCertDir, "--cert-dir"},
+		{KeyOS, "--os"},
+		{KeyVariant, "--variant"},
+	}
+	for _, keyArg := range stringKeys {
+		if val, ok := image.Lookup(ImageGroup, keyArg[0]); ok && len(val) > 0 {
+			podman.addf("%s=%s", keyArg[1], val)
+		}
+	}
+
+	if allTags, ok := image.LookupBoolean(ImageGroup, KeyAllTags); ok {
+		podman.addBool("--all-tags", allTags)
+	}
+
+	if tlsVerify, ok := image.LookupBoolean(ImageGroup, KeyTLSVerify); ok {
+		podman.addBool("--tls-verify", tlsVerify)
+	}
+
+	handlePodmanArgs(image, ImageGroup, podman)
+
+	podman.add(imageName)
+
+	service.AddCmdline(ServiceGroup, "ExecStart", podman.Args)
+
+	service.Setv(ServiceGroup,
+		"Type", "oneshot",
+		"RemainAfterExit", "yes",
+
+		// The default syslog identifier is the exec basename (podman) which isn't very useful here
+		"SyslogIdentifier", "%N")
+
+	imageInfo.ImageName = imageName
+
+	return service, nil
+}
+
+// Convert a quadlet pod file (unit file with a Pod group) to a systemd
+// service file (unit file with Service group) based on the options in the
+// Pod group.
+// The original Pod group is kept around as X-Pod.
+func ConvertPod(podUnit *parser.UnitFile, name string, podsInfoMap map[string]*PodInfo, isUser bool) (*parser.UnitFile, error) {
+	podInfo, ok := podsInfoMap[podUnit.Filename]
+	if !ok {
+		return nil, fmt.Errorf("internal error while processing pod %s", podUnit.Filename)
+	}
+
+	service := podUnit.Dup()
+	service.Filename = podInfo.ServiceName + ".service"
+
+	if podUnit.Path != "" {
+		service.Add(UnitGroup, "SourcePath", podUnit.Path)
+	}
+
+	if err := checkForUnknownKeys(podUnit, PodGroup, supportedPodKeys); err != nil {
+		return nil, err
+	}
+
+	// By default, we want to name the pod by the unit name
+	podName, ok := podUnit.Lookup(PodGroup, KeyPodName)
+	if !ok || len(podName) == 0 {
+		podName = replaceExtension(name, "", "systemd-", "")
+	}
+
+	/* Rename old Pod group to x-Pod so that systemd ignores it */
+	service.RenameGroup(PodGroup, XPodGroup)
+
+	// Need the containers filesystem mounted to start podman
+	service.Add(UnitGroup, "RequiresMountsFor", "%t/containers")
+
+	// Starting the pod starts all the containers in it
+	for _, containerService := range podInfo.Containers {
+		service.Add(UnitGroup, "Wants", containerService)
+		service.Add(UnitGroup, "Before", containerService)
+	}
+
+	if !podUnit.HasKey(ServiceGroup, "SyslogIdentifier") {
+		service.Set(ServiceGroup, "SyslogIdentifier", "%N")
+	}
+
+	execStartPre := NewPodmanCmdline("pod", "create")
+	execStartPre.add(
+		// The infra conmon is the main process of the service
+		"--infra-conmon-pidfile=%t/%N.pid",
+
+		// We store the pod id so that containers can join it and we can clean it up
+		"--pod-id-file=%t/%N.pod-id",
+
+		// Stop the pod once the last container in it exited
+		"--exit-policy=stop",
+
+		// And replace any previous pod with the same name, not fail
+		"--replace",
+	)
+
+	if infraImage, ok := podUnit.Lookup(PodGroup, KeyInfraImage); ok && len(infraImage) > 0 {
+		execStartPre.addf("--infra-image=%s", infraImage)
+	}
+
+	if err := handleUserRemap(podUnit, PodGroup, execStartPre, isUser, true); err != nil {
+		return nil, err
+	}
+
+	handleUserNS(podUnit, PodGroup, execStartPre)
+
+	addNetworks(podUnit, PodGroup, service, execStartPre)
+
+	if ip, ok := podUnit.Lookup(PodGroup, KeyIP); ok && len(ip) > 0 {
+		execStartPre.add("--ip", ip)
+	}
+
+	if ip6, ok := podUnit.Lookup(PodGroup, KeyIP6); ok && len(ip6) > 0 {
+		execStartPre.add("--ip6", ip6)
+	}
+
+	if restartDependents, ok := podUnit.LookupBoolean(PodGroup, KeyRestartDependents); ok {
+		execStartPre.addBool("--restart-dependents", restartDependents)
+	}
+
+	if err := handlePublishPorts(podUnit, PodGroup, execStartPre); err != nil {
+		return nil, err
+	}
+
+	if err := addVolumes(podUnit, service, PodGroup, execStartPre); err != nil {
+		return nil, err
+	}
+
+	execStartPre.addf("--name=%s", podName)
+
+	handlePodmanArgs(podUnit, PodGroup, execStartPre)
+
+	service.AddCmdline(ServiceGroup, "ExecStartPre", execStartPre.Args)
+
+	execStart := NewPodmanCmdline("pod", "start", "--pod-id-file=%t/%N.pod-id")
+	service.AddCmdline(ServiceGroup, "ExecStart", execStart.Args)
+
+	execStop := NewPodmanCmdline("pod", "stop", "--pod-id-file=%t/%N.pod-id", "--ignore", "--time=10")
+	service.AddCmdline(ServiceGroup, "ExecStop", execStop.Args)
+
+	// Use `ExecStopPost` to make sure cleanup happens even in case of
+	// errors; otherwise pods and containers would be left behind.
+	execStopPost := NewPodmanCmdline("pod", "rm", "--pod-id-file=%t/%N.pod-id", "--ignore", "--force")
+	service.AddCmdline(ServiceGroup, "ExecStopPost", execStopPost.Args)
+
+	service.Setv(ServiceGroup,
+		// Set PODMAN_SYSTEMD_UNIT so that podman auto-update can restart the service.
+		"Environment", "PODMAN_SYSTEMD_UNIT=%n",
+		"Type", "forking",
+		"Restart", "on-failure",
+		"PIDFile", "%t/%N.pid")
+
+	return service, nil
+}
+
 func handleUserRemap(unitFile *parser.UnitFile, groupName string, podman *PodmanCmdline, isUser, supportManual bool) error {
 	// ignore Remap keys if UserNS is set
 	if userns, ok := unitFile.Lookup(groupName, KeyUserNS); ok && len(userns) > 0 {
@@ -1196,6 +1454,91 @@ func handleStorageSource(quadletUnitFile, serviceUnitFile *parser.UnitFile, sour
 	return source, nil
 }
 
+func addVolumes(quadletUnitFile, serviceUnitFile *parser.UnitFile, groupName string, podman *PodmanCmdline) error {
+	volumes := quadletUnitFile.LookupAll(groupName, KeyVolume)
+	for _, volume := range volumes {
+		parts := strings.SplitN(volume, ":", 3)
+
+		source := ""
+		var dest string
+		options := ""
+		if len(parts) >= 2 {
+			source = parts[0]
+			dest = parts[1]
+		} else {
+			dest = parts[0]
+		}
+		if len(parts) >= 3 {
+			options = ":" + parts[2]
+		}
+
+		if source != "" {
+			var err error
+			source, err = handleStorageSource(quadletUnitFile, serviceUnitFile, source)
+			if err != nil {
+				return err
+			}
+		}
+
+		podman.add("-v")
+		if source == "" {
+			podman.add(dest)
+		} else {
+			podman.addf("%s:%s%s", source, dest, options)
+		}
+	}
+	return nil
+}
+
+func handleImageSource(image string, serviceUnitFile *parser.UnitFile, imagesInfoMap map[string]*ImageInfo) (string, error) {
+	if !strings.HasSuffix(image, ".image") {
+		return image, nil
+	}
+
+	imageInfo, ok := imagesInfoMap[image]
+	if !ok {
+		return "", fmt.Errorf("quadlet image unit %s does not exist", image)
+	}
+	if len(imageInfo.ImageName) == 0 {
+		return "", fmt.Errorf("quadlet image unit %s could not be converted", image)
+	}
+
+	// the systemd unit name is $name-image.service
+	imageServiceName := imageInfo.ServiceName + ".service"
+	serviceUnitFile.Add(UnitGroup, "Requires", imageServiceName)
+	serviceUnitFile.Add(UnitGroup, "After", imageServiceName)
+
+	return imageInfo.ImageName, nil
+}
+
+func handlePod(quadletUnitFile, serviceUnitFile *parser.UnitFile, groupName string, podsInfoMap map[string]*PodInfo, podman *PodmanCmdline) error {
+	pod, ok := quadletUnitFile.Lookup(groupName, KeyPod)
+	if !ok || len(pod) == 0 {
+		return nil
+	}
+
+	if !strings.HasSuffix(pod, ".pod") {
+		return fmt.Errorf("pod %s is not Quadlet based", pod)
+	}
+
+	podInfo, ok := podsInfoMap[pod]
+	if !ok {
+		return fmt.Errorf("quadlet pod unit %s does not exist", pod)
+	}
+
+	// The pod id is written by the pod service, see ConvertPod
+	podman.addf("--pod-id-file=%%t/%s.pod-id", podInfo.ServiceName)
+
+	// the systemd unit name is $name-pod.service
+	podServiceName := podInfo.ServiceName + ".service"
+	serviceUnitFile.Add(UnitGroup, "Requires", podServiceName)
+	serviceUnitFile.Add(UnitGroup, "BindsTo", podServiceName)
+	serviceUnitFile.Add(UnitGroup, "After", podServiceName)
+
+	podInfo.Containers = append(podInfo.Containers, serviceUnitFile.Filename)
+	return nil
+}
+
 func handleHealth(unitFile *parser.UnitFile, groupName string, podman *PodmanCmdline) {
 	keyArgMap := [][2]string{
 		{KeyHealthCmd, "cmd"},
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -1042,6 +1043,109 @@ var _ = Describe("Podman checkpoint", func() {
 		os.Remove(preCheckpointFileName)
 	})
 
+	It("podman checkpoint container with chained --pre-checkpoint and export", func() {
+		SkipIfRemote("--import-previous is not yet supported on the remote client")
+		if !criu.MemTrack() {
+			Skip("system (architecture/kernel/CRIU) does not support memory tracking")
+		}
+		localRunString := getRunString([]string{ALPINE, "top"})
+		session := podmanTest.Podman(localRunString)
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		cid := session.OutputToString()
+		preCheckpointFileName1 := filepath.Join(podmanTest.TempDir, "/pre-checkpoint-1-"+cid+".tar.gz")
+		preCheckpointFileName2 := filepath.Join(podmanTest.TempDir, "/pre-checkpoint-2-"+cid+".tar.gz")
+		checkpointFileName := filepath.Join(podmanTest.TempDir, "/checkpoint-"+cid+".tar.gz")
+
+		result := podmanTest.Podman([]string{"container", "checkpoint", "-P", "-e", preCheckpointFileName1, cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(Exit(0))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+
+		result = podmanTest.Podman([]string{"container", "checkpoint", "-P", "--with-previous", "-e", preCheckpointFileName2, cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(Exit(0))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+
+		result = podmanTest.Podman([]string{"container", "checkpoint", "--with-previous", "-e", checkpointFileName, cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(Exit(0))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(0))
+
+		result = podmanTest.Podman([]string{"rm", "-t", "0", "-f", cid})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(Exit(0))
+
+		result = podmanTest.Podman([]string{"container", "restore", "-i", checkpointFileName, "--import-previous", preCheckpointFileName1, "--import-previous", preCheckpointFileName2})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(Exit(0))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+		Expect(podmanTest.GetContainerStatus()).To(ContainSubstring("Up"))
+
+		result = podmanTest.Podman([]string{"rm", "-t", "0", "-fa"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(Exit(0))
+	})
+
+	It("podman container migrate to a second service", func() {
+		SkipIfRemote("migrate is not supported on the remote client")
+		if !criu.MemTrack() {
+			Skip("system (architecture/kernel/CRIU) does not support memory tracking")
+		}
+		setupEmptyContainersConf()
+		defer os.Unsetenv("CONTAINERS_CONF")
+
+		// The destination is a second service with its own storage.
+		destDir := filepath.Join(podmanTest.TempDir, "migrate-destination")
+		address := url.URL{
+			Scheme: "unix",
+			Path:   filepath.Join(destDir, "podman.sock"),
+		}
+		service := podmanTest.Podman([]string{
+			"--root", filepath.Join(destDir, "root"),
+			"--runroot", filepath.Join(destDir, "runroot"),
+			"--tmpdir", filepath.Join(destDir, "tmp"),
+			"system", "service", "--time=0", address.String(),
+		})
+		defer service.Kill()
+		WaitForService(address)
+
+		session := podmanTest.Podman([]string{"system", "connection", "add", "destination", address.String()})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		localRunString := getRunString([]string{ALPINE, "top"})
+		session = podmanTest.Podman(localRunString)
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		cid := session.OutputToString()
+
+		session = podmanTest.Podman([]string{"container", "migrate", "--pre-checkpoints", "2", cid, "bogus"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring(`system connection "bogus" not found`))
+		Expect(podmanTest.NumberOfContainersRunning()).To(Equal(1))
+
+		session = podmanTest.Podman([]string{"container", "migrate", "--pre-checkpoints", "2", cid, "destination"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToString()).To(Equal(cid))
+
+		// The container is removed locally and running on the destination.
+		session = podmanTest.Podman([]string{"container", "exists", cid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(1))
+
+		session = podmanTest.Podman([]string{"--connection", "destination", "ps", "-q", "--no-trunc"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToString()).To(Equal(cid))
+
+		session = podmanTest.Podman([]string{"--connection", "destination", "rm", "-t", "0", "-f", cid})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+	})
+
 	It("podman checkpoint and restore container with different port mappings", func() {
 		randomPort, err := utils.GetRandomPort()
 		Expect(err).ShouldNot(HaveOccurred())
@@ -9,6 +9,8 @@ import (
 
 	"github.com/containers/podman/v4/libpod/define"
 
+	v1apps "github.com/containers/podman/v4/pkg/k8s.io/api/apps/v1"
+	v1batch "github.com/containers/podman/v4/pkg/k8s.io/api/batch/v1"
 	v1 "github.com/containers/podman/v4/pkg/k8s.io/api/core/v1"
 	"github.com/containers/podman/v4/pkg/util"
 	. "github.com/containers/podman/v4/test/utils"
@@ -1541,6 +1543,84 @@ USER test1`
 		Expect(kube).Should(Exit(125))
 	})
 
+	It("podman generate kube on pod with --type=daemonset", func() {
+		podName := "test-pod"
+		session := podmanTest.Podman([]string{"pod", "create", podName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"create", "--pod", podName, ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		kube := podmanTest.Podman([]string{"generate", "kube", "--type", "daemonset", podName})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(Exit(0))
+
+		ds := new(v1apps.DaemonSet)
+		err := yaml.Unmarshal(kube.Out.Contents(), ds)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ds.Kind).To(Equal("DaemonSet"))
+		Expect(ds.APIVersion).To(Equal("apps/v1"))
+		Expect(ds.Name).To(Equal(podName + "-daemonset"))
+		Expect(ds.Spec.Selector.MatchLabels).To(HaveKeyWithValue("app", podName))
+		Expect(ds.Spec.Template.Name).To(Equal(podName))
+		Expect(ds.Spec.Template.Spec.Containers).To(HaveLen(1))
+	})
+
+	It("podman generate kube on pod with --type=daemonset and --restart=no should fail", func() {
+		podName := "test-pod"
+		session := podmanTest.Podman([]string{"pod", "create", "--restart", "no", podName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"create", "--pod", podName, ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		kube := podmanTest.Podman([]string{"generate", "kube", "--type", "daemonset", podName})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(Exit(125))
+		Expect(kube.ErrorToString()).To(ContainSubstring("k8s DaemonSets can only have restartPolicy set to Always"))
+	})
+
+	It("podman generate kube on ctr with --type=job", func() {
+		ctrName := "test-ctr"
+		session := podmanTest.Podman([]string{"create", "--name", ctrName, ALPINE, "true"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		kube := podmanTest.Podman([]string{"generate", "kube", "--type", "job", ctrName})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(Exit(0))
+
+		job := new(v1batch.Job)
+		err := yaml.Unmarshal(kube.Out.Contents(), job)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(job.Kind).To(Equal("Job"))
+		Expect(job.APIVersion).To(Equal("batch/v1"))
+		Expect(job.Name).To(Equal(ctrName + "-pod-job"))
+		Expect(job.Spec.Template.Name).To(Equal(ctrName + "-pod"))
+		Expect(job.Spec.Template.Spec.RestartPolicy).To(Equal(v1.RestartPolicyNever))
+		Expect(job.Spec.Template.Spec.Containers).To(HaveLen(1))
+	})
+
+	It("podman generate kube on pod with --type=job and --restart=always should fail", func() {
+		podName := "test-pod"
+		session := podmanTest.Podman([]string{"pod", "create", "--restart", "always", podName})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"create", "--pod", podName, ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		kube := podmanTest.Podman([]string{"generate", "kube", "--type", "job", podName})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(Exit(125))
+		Expect(kube.ErrorToString()).To(ContainSubstring("k8s Jobs can only have restartPolicy set to OnFailure or Never"))
+	})
+
 	It("podman generate kube on pod with invalid name", func() {
 		podName := "test_pod"
 		session := podmanTest.Podman([]string{"pod", "create", podName})
@@ -348,4 +348,47 @@ HEALTHCHECK CMD ls -l / 2>&1`, ALPINE)
 		Expect(ps.OutputToStringArray()).To(HaveLen(2))
 		Expect(ps.OutputToString()).To(ContainSubstring("hc"))
 	})
+
+	It("podman healthcheck logs and ls", func() {
+		session := podmanTest.Podman([]string{"run", "-d", "--name", "hc", "--health-cmd", "echo hello", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		hc := podmanTest.Podman([]string{"healthcheck", "run", "hc"})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc).Should(Exit(0))
+
+		logs := podmanTest.Podman([]string{"healthcheck", "logs", "--format", "{{.ExitCode}} {{.Output}}", "hc"})
+		logs.WaitWithDefaultTimeout()
+		Expect(logs).Should(Exit(0))
+		Expect(logs.OutputToString()).To(ContainSubstring("0 hello"))
+
+		// The history survives a restart of the container.
+		restart := podmanTest.Podman([]string{"restart", "-t0", "hc"})
+		restart.WaitWithDefaultTimeout()
+		Expect(restart).Should(Exit(0))
+
+		hc = podmanTest.Podman([]string{"healthcheck", "run", "hc"})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc).Should(Exit(0))
+
+		logs = podmanTest.Podman([]string{"healthcheck", "logs", "--format", "json", "hc"})
+		logs.WaitWithDefaultTimeout()
+		Expect(logs).Should(Exit(0))
+		Expect(len(logs.OutputToStringArray())).To(BeNumerically(">=", 2))
+
+		ls := podmanTest.Podman([]string{"healthcheck", "ls", "--format", "{{.Name}} {{.Status}} {{.FailingStreak}}"})
+		ls.WaitWithDefaultTimeout()
+		Expect(ls).Should(Exit(0))
+		Expect(ls.OutputToString()).To(ContainSubstring("hc healthy 0"))
+
+		session = podmanTest.Podman([]string{"run", "-d", "--name", "nohc", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		logs = podmanTest.Podman([]string{"healthcheck", "logs", "nohc"})
+		logs.WaitWithDefaultTimeout()
+		Expect(logs).Should(Exit(125))
+		Expect(logs.ErrorToString()).To(ContainSubstring("has no defined healthcheck"))
+	})
 })
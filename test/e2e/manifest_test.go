@@ -189,6 +189,71 @@ var _ = Describe("Podman manifest", func() {
 		Expect(session.OutputToString()).To(ContainSubstring(`"os": "bar"`))
 	})
 
+	It("add --artifact", func() {
+		session := podmanTest.Podman([]string{"manifest", "create", "foo"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		sbom := filepath.Join(podmanTest.TempDir, "sbom.spdx.json")
+		err := os.WriteFile(sbom, []byte(`{"spdxVersion":"SPDX-2.3"}`), 0644)
+		Expect(err).ToNot(HaveOccurred())
+
+		session = podmanTest.Podman([]string{"manifest", "add", "--artifact-type", "application/spdx+json", "foo", sbom})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("--artifact-type requires --artifact"))
+
+		session = podmanTest.Podman([]string{"manifest", "add", "--artifact", "--artifact-type", "application/spdx+json", "--annotation", "hoge=fuga", "foo", sbom})
+		session.WaitWithDefaultTimeout()
+		if IsRemote() {
+			Expect(session).Should(Exit(125))
+			Expect(session.ErrorToString()).To(ContainSubstring("not supported on the remote client"))
+			return
+		}
+		Expect(session).Should(Exit(0))
+		session = podmanTest.Podman([]string{"manifest", "inspect", "foo"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		var inspect struct {
+			Manifests []struct {
+				Digest       string            `json:"digest"`
+				MediaType    string            `json:"mediaType"`
+				ArtifactType string            `json:"artifactType"`
+				Annotations  map[string]string `json:"annotations"`
+			} `json:"manifests"`
+		}
+		err = json.Unmarshal(session.Out.Contents(), &inspect)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inspect.Manifests).To(HaveLen(1))
+		Expect(inspect.Manifests[0].MediaType).To(Equal("application/vnd.oci.image.manifest.v1+json"))
+		Expect(inspect.Manifests[0].ArtifactType).To(Equal("application/spdx+json"))
+		Expect(inspect.Manifests[0].Annotations).To(Equal(map[string]string{"hoge": "fuga", "org.opencontainers.image.title": "sbom.spdx.json"}))
+		sbomDigest := inspect.Manifests[0].Digest
+
+		signature := filepath.Join(podmanTest.TempDir, "sbom.sig")
+		err = os.WriteFile(signature, []byte("signature"), 0644)
+		Expect(err).ToNot(HaveOccurred())
+		session = podmanTest.Podman([]string{"manifest", "add", "--artifact", "--artifact-subject", sbomDigest, "foo", signature})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		dest := filepath.Join(podmanTest.TempDir, "pushed")
+		session = podmanTest.Podman([]string{"manifest", "push", "foo", "dir:" + dest})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		manifests, err := filepath.Glob(filepath.Join(dest, "*.manifest.json"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifests).To(HaveLen(2))
+		subjects := 0
+		for _, m := range manifests {
+			content, err := os.ReadFile(m)
+			Expect(err).ToNot(HaveOccurred())
+			if strings.Contains(string(content), `"subject":{"mediaType":"application/vnd.oci.image.manifest.v1+json","digest":"`+sbomDigest+`"`) {
+				subjects++
+			}
+		}
+		Expect(subjects).To(Equal(1))
+	})
+
 	It("annotate", func() {
 		session := podmanTest.Podman([]string{"manifest", "create", "foo"})
 		session.WaitWithDefaultTimeout()
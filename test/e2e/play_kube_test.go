@@ -447,6 +447,87 @@ spec:
           periodSeconds: 1
 `
 
+var readinessProbePodYaml = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: readiness-probe
+spec:
+  restartPolicy: Never
+  containers:
+  - command:
+    - top
+    - -d
+    - "1.5"
+    name: alpine
+    image: quay.io/libpod/alpine:latest
+    readinessProbe:
+      exec:
+        command:
+        - cat
+        - /ready
+      periodSeconds: 1
+      failureThreshold: 1
+`
+
+var lifecycleHooksPodYaml = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: lifecycle-hooks
+spec:
+  restartPolicy: Never
+  volumes:
+  - name: hooks
+    hostPath:
+      path: %s
+      type: Directory
+  containers:
+  - command:
+    - top
+    - -d
+    - "1.5"
+    name: alpine
+    image: quay.io/libpod/alpine:latest
+    volumeMounts:
+    - name: hooks
+      mountPath: /hooks
+    lifecycle:
+      postStart:
+        exec:
+          command:
+          - /bin/sh
+          - -c
+          - echo started > /hooks/poststart
+      preStop:
+        exec:
+          command:
+          - /bin/sh
+          - -c
+          - echo stopping > /hooks/prestop
+`
+
+var slowPreStopHookPodYaml = `
+apiVersion: v1
+kind: Pod
+metadata:
+  name: slow-prestop-hook
+spec:
+  restartPolicy: Never
+  containers:
+  - command:
+    - sleep
+    - "1000"
+    name: alpine
+    image: quay.io/libpod/alpine:latest
+    lifecycle:
+      preStop:
+        exec:
+          command:
+          - sleep
+          - "100"
+`
+
 var selinuxLabelPodYaml = `
 apiVersion: v1
 kind: Pod
@@ -902,6 +983,54 @@ spec:
     - containerPort: 80
 `
 
+var serviceNodePortYaml = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: nginx
+spec:
+  type: NodePort
+  selector:
+    app: nginx
+  ports:
+  - port: 8080
+    targetPort: http
+    nodePort: 19010
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: nginx
+  labels:
+    app: nginx
+spec:
+  containers:
+  - name: nginx
+    image: quay.io/libpod/alpine_nginx:latest
+    ports:
+    - name: http
+      containerPort: 80
+`
+
+var jobYaml = `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: testjob
+spec:
+  backoffLimit: 3
+  template:
+    spec:
+      restartPolicy: Never
+      containers:
+      - name: job
+        image: ` + ALPINE + `
+        command:
+        - sh
+        - -c
+        - exit 1
+`
+
 var publishPortsPodWithContainerHostPort = `
 apiVersion: v1
 kind: Pod
@@ -2108,6 +2237,95 @@ var _ = Describe("Podman play kube", func() {
 		Expect(inspect[0].State.Health).To(HaveField("Status", define.HealthCheckHealthy))
 	})
 
+	It("podman play kube support container readiness probe", func() {
+		ctrName := "readiness-probe-alpine"
+		err := writeYaml(readinessProbePodYaml, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"play", "kube", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(Exit(0))
+
+		inspect := podmanTest.InspectContainer(ctrName)
+		Expect(inspect[0].State).To(HaveField("Readiness", define.ReadinessCheckNotReady))
+		// A readiness probe is not a health check
+		Expect(inspect[0].Config.Healthcheck).To(BeNil())
+		Expect(inspect[0].Config.Readinesscheck.Test).To(Equal([]string{"CMD", "cat", "/ready"}))
+
+		hc := podmanTest.Podman([]string{"healthcheck", "run", "--readiness", ctrName})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc).Should(Exit(1))
+		Expect(hc.OutputToString()).To(Equal(define.ReadinessCheckNotReady))
+
+		exec := podmanTest.Podman([]string{"exec", ctrName, "touch", "/ready"})
+		exec.WaitWithDefaultTimeout()
+		Expect(exec).Should(Exit(0))
+
+		hc = podmanTest.Podman([]string{"healthcheck", "run", "--readiness", ctrName})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc).Should(Exit(0))
+
+		inspect = podmanTest.InspectContainer(ctrName)
+		Expect(inspect[0].State).To(HaveField("Readiness", define.ReadinessCheckReady))
+
+		ps := podmanTest.Podman([]string{"ps", "--filter", "name=" + ctrName, "--format", "{{.Status}}"})
+		ps.WaitWithDefaultTimeout()
+		Expect(ps).Should(Exit(0))
+		Expect(ps.OutputToString()).To(HaveSuffix("(ready)"))
+	})
+
+	It("podman play kube support container lifecycle hooks", func() {
+		ctrName := "lifecycle-hooks-alpine"
+		hooksDir := filepath.Join(podmanTest.TempDir, "hooks")
+		err := os.Mkdir(hooksDir, 0755)
+		Expect(err).ToNot(HaveOccurred())
+		err = writeYaml(fmt.Sprintf(lifecycleHooksPodYaml, hooksDir), kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"play", "kube", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(Exit(0))
+
+		content, err := os.ReadFile(filepath.Join(hooksDir, "poststart"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("started\n"))
+		Expect(filepath.Join(hooksDir, "prestop")).ToNot(BeAnExistingFile())
+
+		stop := podmanTest.Podman([]string{"stop", ctrName})
+		stop.WaitWithDefaultTimeout()
+		Expect(stop).Should(Exit(0))
+
+		content, err = os.ReadFile(filepath.Join(hooksDir, "prestop"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(content)).To(Equal("stopping\n"))
+	})
+
+	It("podman play kube preStop hook counts against the stop timeout", func() {
+		ctrName := "slow-prestop-hook-alpine"
+		err := writeYaml(slowPreStopHookPodYaml, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"play", "kube", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(Exit(0))
+
+		// sleep as PID 1 ignores SIGTERM, so the container is only
+		// killed once the timeout, shared with the hook, has passed.
+		start := time.Now()
+		stop := podmanTest.Podman([]string{"stop", "-t", "5", ctrName})
+
+		// The container is not locked while the hook runs.
+		Eventually(func() string {
+			inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.State.Status}}", ctrName})
+			inspect.WaitWithDefaultTimeout()
+			return inspect.OutputToString()
+		}, 4*time.Second, 200*time.Millisecond).Should(Equal("stopping"))
+
+		stop.WaitWithDefaultTimeout()
+		Expect(stop).Should(Exit(0))
+		Expect(time.Since(start)).To(BeNumerically("<", 9*time.Second))
+	})
+
 	It("podman play kube fail with nonexistent authfile", func() {
 		err := generateKubeYaml("pod", getPod(), kubeYaml)
 		Expect(err).ToNot(HaveOccurred())
@@ -5172,6 +5390,40 @@ spec:
 		testHTTPServer("19005", false, "podman rulez")
 	})
 
+	It("podman play kube with NodePort service - curl should succeed", func() {
+		err := writeYaml(serviceNodePortYaml, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"play", "kube", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(Exit(0))
+
+		testHTTPServer("19010", false, "podman rulez")
+	})
+
+	It("podman play kube job retries failed containers up to backoffLimit", func() {
+		err := writeYaml(jobYaml, kubeYaml)
+		Expect(err).ToNot(HaveOccurred())
+
+		kube := podmanTest.Podman([]string{"play", "kube", kubeYaml})
+		kube.WaitWithDefaultTimeout()
+		Expect(kube).Should(Exit(0))
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{ .HostConfig.RestartPolicy.Name }} {{ .HostConfig.RestartPolicy.MaximumRetryCount }}", "testjob-pod-job"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(Exit(0))
+		Expect(inspect.OutputToString()).To(Equal("on-failure 3"))
+
+		wait := podmanTest.Podman([]string{"wait", "--condition", "exited", "testjob-pod-job"})
+		wait.WaitWithDefaultTimeout()
+		Expect(wait).Should(Exit(0))
+
+		down := podmanTest.Podman([]string{"kube", "down", kubeYaml})
+		down.WaitWithDefaultTimeout()
+		Expect(down).Should(Exit(0))
+		Expect(down.OutputToString()).To(ContainSubstring("testjob-pod"))
+	})
+
 	It("podman play kube multiple publish ports", func() {
 		err := writeYaml(publishPortsPodWithoutPorts, kubeYaml)
 		Expect(err).ToNot(HaveOccurred())
@@ -0,0 +1,68 @@
+package integration
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Podman pod update", func() {
+
+	It("podman pod update resource limits", func() {
+		SkipIfCgroupV1("testing flags that only work in cgroup v2")
+		SkipIfRootless("many of these handlers are not enabled while rootless in CI")
+		session := podmanTest.Podman([]string{"pod", "create", "--name", "updpod", "--cpus", "1"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"pod", "update", "--cpus", "2", "--memory", "1g", "updpod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		podInspect := podmanTest.Podman([]string{"pod", "inspect", "updpod"})
+		podInspect.WaitWithDefaultTimeout()
+		Expect(podInspect).Should(Exit(0))
+		podJSON := podInspect.InspectPodToJSON()
+		Expect(podJSON).To(HaveField("CPUPeriod", uint64(100000)))
+		Expect(podJSON).To(HaveField("CPUQuota", int64(200000)))
+		Expect(podJSON).To(HaveField("MemoryLimit", uint64(1073741824)))
+	})
+
+	It("podman pod update restart policy", func() {
+		session := podmanTest.Podman([]string{"pod", "create", "--name", "updpod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		ctr := podmanTest.Podman([]string{"create", "--pod", "updpod", ALPINE, "top"})
+		ctr.WaitWithDefaultTimeout()
+		Expect(ctr).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"pod", "update", "--restart", "on-failure:3", "updpod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		podInspect := podmanTest.Podman([]string{"pod", "inspect", "updpod"})
+		podInspect.WaitWithDefaultTimeout()
+		Expect(podInspect).Should(Exit(0))
+		Expect(podInspect.InspectPodToJSON()).To(HaveField("RestartPolicy", "on-failure"))
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.HostConfig.RestartPolicy.Name}}:{{.HostConfig.RestartPolicy.MaximumRetryCount}}", ctr.OutputToString()})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(Exit(0))
+		Expect(inspect.OutputToString()).To(Equal("on-failure:3"))
+	})
+
+	It("podman pod update without options", func() {
+		session := podmanTest.Podman([]string{"pod", "create", "--name", "updpod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"pod", "update", "updpod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+
+		session = podmanTest.Podman([]string{"pod", "update", "--restart", "bogus", "updpod"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+	})
+})
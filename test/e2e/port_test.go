@@ -128,4 +128,61 @@ var _ = Describe("Podman port", func() {
 		Expect(result2).Should(Exit(0))
 		Expect(result2.OutputToStringArray()).To(ContainElement(HavePrefix("0.0.0.0:5001")))
 	})
+
+	It("podman container port add and rm", func() {
+		lock1 := GetPortLock("5002")
+		defer lock1.Unlock()
+		lock2 := GetPortLock("5003")
+		defer lock2.Unlock()
+
+		setup := podmanTest.Podman([]string{"run", "--name", "test", "-dt", "-p", "5002:5002", ALPINE, "top"})
+		setup.WaitWithDefaultTimeout()
+		Expect(setup).Should(Exit(0))
+
+		add := podmanTest.Podman([]string{"container", "port", "add", "test", "5003:5003"})
+		add.WaitWithDefaultTimeout()
+		Expect(add).Should(Exit(0))
+		Expect(add.OutputToString()).To(Equal("5003/tcp -> 0.0.0.0:5003"))
+
+		// The port conflicts with the published one.
+		add = podmanTest.Podman([]string{"container", "port", "add", "test", "5003:5004"})
+		add.WaitWithDefaultTimeout()
+		Expect(add).Should(Exit(125))
+		Expect(add.ErrorToString()).To(ContainSubstring("conflicts with published port"))
+
+		rm := podmanTest.Podman([]string{"container", "port", "rm", "test", "5002:5002"})
+		rm.WaitWithDefaultTimeout()
+		Expect(rm).Should(Exit(0))
+
+		rm = podmanTest.Podman([]string{"container", "port", "rm", "test", "5002:5002"})
+		rm.WaitWithDefaultTimeout()
+		Expect(rm).Should(Exit(125))
+		Expect(rm.ErrorToString()).To(ContainSubstring("is not published"))
+
+		result := podmanTest.Podman([]string{"port", "test"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(Exit(0))
+		Expect(result.OutputToStringArray()).To(Equal([]string{"5003/tcp -> 0.0.0.0:5003"}))
+
+		// The ports are kept in the container config.
+		restart := podmanTest.Podman([]string{"restart", "-t0", "test"})
+		restart.WaitWithDefaultTimeout()
+		Expect(restart).Should(Exit(0))
+
+		result = podmanTest.Podman([]string{"port", "test"})
+		result.WaitWithDefaultTimeout()
+		Expect(result).Should(Exit(0))
+		Expect(result.OutputToStringArray()).To(Equal([]string{"5003/tcp -> 0.0.0.0:5003"}))
+	})
+
+	It("podman container port add with network host", func() {
+		setup := podmanTest.Podman([]string{"create", "--name", "test", "--network", "host", ALPINE, "top"})
+		setup.WaitWithDefaultTimeout()
+		Expect(setup).Should(Exit(0))
+
+		add := podmanTest.Podman([]string{"container", "port", "add", "test", "5004:5004"})
+		add.WaitWithDefaultTimeout()
+		Expect(add).Should(Exit(125))
+		Expect(add.ErrorToString()).To(ContainSubstring("ports cannot be published with network mode"))
+	})
 })
@@ -35,6 +35,10 @@ func loadQuadletTestcase(path string) *quadletTestcase {
 		service += "-volume"
 	case ".network":
 		service += "-network"
+	case ".image":
+		service += "-image"
+	case ".pod":
+		service += "-pod"
 	}
 	service += ".service"
 
@@ -274,6 +278,18 @@ func (t *quadletTestcase) assertStartPodmanFinalArgsRegex(args []string, unit *p
 	return t.assertPodmanFinalArgsRegex(args, unit, "ExecStart")
 }
 
+func (t *quadletTestcase) assertStartPrePodmanArgs(args []string, unit *parser.UnitFile) bool {
+	return t.assertPodmanArgs(args, unit, "ExecStartPre")
+}
+
+func (t *quadletTestcase) assertStartPrePodmanArgsRegex(args []string, unit *parser.UnitFile) bool {
+	return t.assertPodmanArgsRegex(args, unit, "ExecStartPre")
+}
+
+func (t *quadletTestcase) assertStartPrePodmanFinalArgs(args []string, unit *parser.UnitFile) bool {
+	return t.assertPodmanFinalArgs(args, unit, "ExecStartPre")
+}
+
 func (t *quadletTestcase) assertStopPodmanArgs(args []string, unit *parser.UnitFile) bool {
 	return t.assertPodmanArgs(args, unit, "ExecStop")
 }
@@ -351,6 +367,12 @@ func (t *quadletTestcase) doAssert(check []string, unit *parser.UnitFile, sessio
 		ok = t.assertStartPodmanFinalArgs(args, unit)
 	case "assert-podman-final-args-regex":
 		ok = t.assertStartPodmanFinalArgsRegex(args, unit)
+	case "assert-podman-pre-start-args":
+		ok = t.assertStartPrePodmanArgs(args, unit)
+	case "assert-podman-pre-start-args-regex":
+		ok = t.assertStartPrePodmanArgsRegex(args, unit)
+	case "assert-podman-pre-start-final-args":
+		ok = t.assertStartPrePodmanFinalArgs(args, unit)
 	case "assert-symlink":
 		ok = t.assertSymlink(args, unit)
 	case "assert-podman-stop-args":
@@ -511,13 +533,21 @@ var _ = Describe("quadlet system generator", func() {
 	})
 
 	DescribeTable("Running quadlet test case",
-		func(fileName string) {
+		func(fileName string, dependencyFiles ...string) {
 			testcase := loadQuadletTestcase(filepath.Join("quadlet", fileName))
 
 			// Write the tested file to the quadlet dir
 			err = os.WriteFile(filepath.Join(quadletDir, fileName), testcase.data, 0644)
 			Expect(err).ToNot(HaveOccurred())
 
+			// Also write any units the tested file depends on
+			for _, dependencyFile := range dependencyFiles {
+				data, err := os.ReadFile(filepath.Join("quadlet", dependencyFile))
+				Expect(err).ToNot(HaveOccurred())
+				err = os.WriteFile(filepath.Join(quadletDir, dependencyFile), data, 0644)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
 			// Run quadlet to convert the file
 			session := podmanTest.Quadlet([]string{"--user", "-no-kmsg-log", generatedDir}, quadletDir)
 			session.WaitWithDefaultTimeout()
@@ -547,6 +577,8 @@ var _ = Describe("quadlet system generator", func() {
 		Entry("health.container", "health.container"),
 		Entry("hostname.container", "hostname.container"),
 		Entry("image.container", "image.container"),
+		Entry("image.quadlet.container", "image.quadlet.container", "basic.image"),
+		Entry("image.not-found.container", "image.not-found.container"),
 		Entry("install.container", "install.container"),
 		Entry("ip.container", "ip.container"),
 		Entry("label.container", "label.container"),
@@ -560,6 +592,9 @@ var _ = Describe("quadlet system generator", func() {
 		Entry("notify.container", "notify.container"),
 		Entry("oneshot.container", "oneshot.container"),
 		Entry("other-sections.container", "other-sections.container"),
+		Entry("pod.container", "pod.container", "basic.pod"),
+		Entry("pod.non-quadlet.container", "pod.non-quadlet.container"),
+		Entry("pod.not-found.container", "pod.not-found.container"),
 		Entry("podmanargs.container", "podmanargs.container"),
 		Entry("ports.container", "ports.container"),
 		Entry("ports_ipv6.container", "ports_ipv6.container"),
@@ -572,6 +607,7 @@ var _ = Describe("quadlet system generator", func() {
 		Entry("remap-keep-id.container", "remap-keep-id.container"),
 		Entry("remap-keep-id2.container", "remap-keep-id2.container"),
 		Entry("remap-manual.container", "remap-manual.container"),
+		Entry("restart-dependents.container", "restart-dependents.container"),
 		Entry("rootfs.container", "rootfs.container"),
 		Entry("seccomp.container", "seccomp.container"),
 		Entry("secrets.container", "secrets.container"),
@@ -624,6 +660,22 @@ var _ = Describe("quadlet system generator", func() {
 		Entry("Network - Options", "options.network"),
 		Entry("Network - Multiple Options", "options.multiple.network"),
 		Entry("Network - PodmanArgs", "podmanargs.network"),
+
+		Entry("Image - Basic", "basic.image"),
+		Entry("Image - No Image", "noimage.image"),
+		Entry("Image - All Tags", "all-tags.image"),
+		Entry("Image - Arch, OS and Variant", "arch-os-variant.image"),
+		Entry("Image - AuthFile, CertDir and TLSVerify", "auth-certs.image"),
+		Entry("Image - PodmanArgs", "podmanargs.image"),
+
+		Entry("Pod - Basic", "basic.pod"),
+		Entry("Pod - Name", "name.pod"),
+		Entry("Pod - Network", "network.pod"),
+		Entry("Pod - Quadlet Network", "network.quadlet.pod"),
+		Entry("Pod - Publish ports", "ports.pod"),
+		Entry("Pod - Volume", "volume.pod"),
+		Entry("Pod - PodmanArgs", "podmanargs.pod"),
+		Entry("Pod - Restart dependents", "restart-dependents.pod"),
 	)
 
 })
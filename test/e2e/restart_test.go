@@ -341,4 +341,46 @@ var _ = Describe("Podman restart", func() {
 		Expect(session1).Should(Exit(0))
 		Expect(session1.OutputToString()).To(BeEquivalentTo(cid2))
 	})
+
+	It("podman restart --restart-dependents restarts dependents in order", func() {
+		session := podmanTest.RunTopContainerWithArgs("db", []string{"--restart-dependents"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		session = podmanTest.RunTopContainerWithArgs("app", []string{"--requires", "db"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		session = podmanTest.RunTopContainerWithArgs("proxy", []string{"--requires", "app"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		session = podmanTest.RunTopContainer("unrelated")
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		inspect := podmanTest.Podman([]string{"inspect", "--format", "{{.Config.RestartDependents}}", "db"})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(Exit(0))
+		Expect(inspect.OutputToString()).To(Equal("true"))
+
+		startTime := podmanTest.Podman([]string{"inspect", "--format", "{{.State.StartedAt}}", "app", "proxy", "unrelated"})
+		startTime.WaitWithDefaultTimeout()
+		Expect(startTime).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"restart", "db"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		restartTime := podmanTest.Podman([]string{"inspect", "--format", "{{.State.StartedAt}}", "app", "proxy", "unrelated"})
+		restartTime.WaitWithDefaultTimeout()
+		Expect(restartTime).Should(Exit(0))
+		before, after := startTime.OutputToStringArray(), restartTime.OutputToStringArray()
+		Expect(after[0]).To(Not(Equal(before[0])), "app was restarted")
+		Expect(after[1]).To(Not(Equal(before[1])), "proxy was restarted")
+		Expect(after[2]).To(Equal(before[2]), "unrelated was not restarted")
+
+		// The events are emitted in the restart order.
+		events := podmanTest.Podman([]string{"events", "--stream=false", "--since", "30s", "--filter", "event=restart", "--format", "{{.Name}}"})
+		events.WaitWithDefaultTimeout()
+		Expect(events).Should(Exit(0))
+		Expect(events.OutputToStringArray()).To(Equal([]string{"db", "app", "proxy"}))
+	})
 })
@@ -384,4 +384,37 @@ var _ = Describe("Podman secret", func() {
 		exists.WaitWithDefaultTimeout()
 		Expect(exists).Should(Exit(1))
 	})
+
+	It("podman secret update", func() {
+		secretFilePath := filepath.Join(podmanTest.TempDir, "secret")
+		err := os.WriteFile(secretFilePath, []byte("mysecret"), 0755)
+		Expect(err).ToNot(HaveOccurred())
+
+		session := podmanTest.Podman([]string{"secret", "create", "a", secretFilePath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		secrID := session.OutputToString()
+
+		session = podmanTest.Podman([]string{"create", "--secret", "a", "--secret", "source=a,type=env,target=MYSECRET", ALPINE, "sh", "-c", "cat /run/secrets/a; echo; printenv MYSECRET"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		ctrID := session.OutputToString()
+
+		err = os.WriteFile(secretFilePath, []byte("newsecret"), 0755)
+		Expect(err).ToNot(HaveOccurred())
+		session = podmanTest.Podman([]string{"secret", "update", "a", secretFilePath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToString()).To(Equal(secrID))
+		Expect(session.ErrorToString()).To(ContainSubstring(ctrID))
+
+		session = podmanTest.Podman([]string{"start", "--attach", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToStringArray()).To(Equal([]string{"newsecret", "newsecret"}))
+
+		session = podmanTest.Podman([]string{"secret", "update", "bogus", secretFilePath})
+		session.WaitWithDefaultTimeout()
+		Expect(session).To(ExitWithError())
+	})
 })
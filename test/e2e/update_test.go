@@ -2,6 +2,7 @@ package integration
 
 import (
 	"github.com/containers/common/pkg/cgroupv2"
+	. "github.com/containers/podman/v4/test/utils"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gexec"
@@ -218,4 +219,80 @@ var _ = Describe("Podman update", func() {
 		Expect(session).Should(Exit(0))
 		Expect(session.OutputToString()).Should(ContainSubstring("500000"))
 	})
+
+	It("podman update restart policy, labels and auto-update policy", func() {
+		session := podmanTest.Podman([]string{"create", "--label", "foo=bar", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		ctrID := session.OutputToString()
+
+		session = podmanTest.Podman([]string{"update", "--restart", "on-failure:5", "--label", "app=web", "--auto-update", "local", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		inspect := podmanTest.InspectContainer(ctrID)
+		Expect(inspect[0].HostConfig.RestartPolicy.Name).To(Equal("on-failure"))
+		Expect(inspect[0].HostConfig.RestartPolicy.MaximumRetryCount).To(Equal(uint(5)))
+		Expect(inspect[0].Config.Labels).To(HaveKeyWithValue("foo", "bar"))
+		Expect(inspect[0].Config.Labels).To(HaveKeyWithValue("app", "web"))
+		Expect(inspect[0].Config.Labels).To(HaveKeyWithValue("io.containers.autoupdate", "local"))
+
+		// on-failure without a count retries indefinitely, as with podman create
+		session = podmanTest.Podman([]string{"update", "--restart", "on-failure", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		inspect = podmanTest.InspectContainer(ctrID)
+		Expect(inspect[0].HostConfig.RestartPolicy.Name).To(Equal("on-failure"))
+		Expect(inspect[0].HostConfig.RestartPolicy.MaximumRetryCount).To(Equal(uint(0)))
+
+		session = podmanTest.Podman([]string{"update", "--restart", "always", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		inspect = podmanTest.InspectContainer(ctrID)
+		Expect(inspect[0].HostConfig.RestartPolicy.Name).To(Equal("always"))
+		Expect(inspect[0].HostConfig.RestartPolicy.MaximumRetryCount).To(Equal(uint(0)))
+
+		session = podmanTest.Podman([]string{"update", "--auto-update", "bogus", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("invalid auto-update policy"))
+	})
+
+	It("podman update health check of running container", func() {
+		session := podmanTest.Podman([]string{"run", "-d", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		ctrID := session.OutputToString()
+
+		hc := podmanTest.Podman([]string{"healthcheck", "run", ctrID})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc).Should(Exit(125))
+
+		session = podmanTest.Podman([]string{"update", "--health-cmd", "ls /", "--health-interval", "disable", "--health-on-failure", "kill", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		inspect := podmanTest.InspectContainer(ctrID)
+		Expect(inspect[0].Config.Healthcheck.Test).To(Equal([]string{"CMD-SHELL", "ls /"}))
+		Expect(inspect[0].Config.HealthcheckOnFailureAction).To(Equal("kill"))
+
+		hc = podmanTest.Podman([]string{"healthcheck", "run", ctrID})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"update", "--no-healthcheck", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		hc = podmanTest.Podman([]string{"healthcheck", "run", ctrID})
+		hc.WaitWithDefaultTimeout()
+		Expect(hc).Should(Exit(125))
+
+		session = podmanTest.Podman([]string{"update", "--health-interval", "10s", ctrID})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+		Expect(session.ErrorToString()).To(ContainSubstring("requires --health-cmd"))
+	})
 })
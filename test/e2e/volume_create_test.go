@@ -3,6 +3,8 @@ package integration
 import (
 	"fmt"
 	"path/filepath"
+	"strconv"
+	"strings"
 
 	. "github.com/containers/podman/v4/test/utils"
 	. "github.com/onsi/ginkgo/v2"
@@ -180,6 +182,54 @@ var _ = Describe("Podman volume create", func() {
 		Expect(inspectOpts.OutputToString()).To(Equal(optionStrFormatExpect))
 	})
 
+	It("podman create volume with quota_warning", func() {
+		session := podmanTest.Podman([]string{"volume", "create", "--opt", "quota_warning=80", "warnvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("volume option quota_warning requires the size option"))
+
+		session = podmanTest.Podman([]string{"volume", "create", "--opt", "size=10M", "--opt", "quota_warning=120", "warnvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("quota warning must be a percentage between 1 and 100"))
+	})
+
+	It("podman create volume with size", func() {
+		SkipIfRootless("size limits require project quotas or loopback images")
+		volName := "sizevol"
+		session := podmanTest.Podman([]string{"volume", "create", "--opt", "size=16M", "--opt", "quota_warning=50", volName})
+		session.WaitWithDefaultTimeout()
+		if session.ExitCode() != 0 && strings.Contains(session.ErrorToString(), "creating a loopback image failed") {
+			Skip("Filesystem does not support project quotas and loopback images are not available")
+		}
+		Expect(session).Should(Exit(0))
+
+		inspect := podmanTest.Podman([]string{"volume", "inspect", "--format", "{{.SizeLimit}} {{.QuotaWarning}}", volName})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(Exit(0))
+		Expect(inspect.OutputToString()).To(Equal("16777216 50"))
+
+		session = podmanTest.Podman([]string{"run", "--rm", "-v", volName + ":/data", ALPINE, "dd", "if=/dev/zero", "of=/data/file", "bs=1M", "count=32"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(ExitWithError())
+
+		session = podmanTest.Podman([]string{"run", "--rm", "-v", volName + ":/data", ALPINE, "sh", "-c", "rm /data/file; dd if=/dev/zero of=/data/file bs=1M count=10"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		ls := podmanTest.Podman([]string{"volume", "ls", "--filter", "name=" + volName, "--format", "{{.UsedBytes}}"})
+		ls.WaitWithDefaultTimeout()
+		Expect(ls).Should(Exit(0))
+		used, err := strconv.ParseUint(ls.OutputToString(), 10, 64)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(used).To(BeNumerically(">=", 10*1024*1024))
+
+		events := podmanTest.Podman([]string{"events", "--stream=false", "--filter", "event=quota_warning", "--filter", "volume=" + volName, "--format", "{{.Name}}"})
+		events.WaitWithDefaultTimeout()
+		Expect(events).Should(Exit(0))
+		Expect(events.OutputToStringArray()).To(Equal([]string{volName}))
+	})
+
 	It("image-backed volume basic functionality", func() {
 		podmanTest.AddImageToRWStore(fedoraMinimal)
 		volName := "testvol"
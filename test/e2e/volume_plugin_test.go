@@ -306,4 +306,64 @@ Removed:
 		Expect(volInspect2).Should(Exit(0))
 		Expect(volInspect2.OutputToString()).To(ContainSubstring("3"))
 	})
+
+	It("discovered volume plugin must be enabled", func() {
+		podmanTest.AddImageToRWStore(volumeTest)
+
+		pluginStatePath := filepath.Join(podmanTest.TempDir, "volumes")
+		err := os.Mkdir(pluginStatePath, 0755)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Not set in containers.conf, so it is only discovered.
+		pluginName := "discovered" + stringid.GenerateRandomID()[:8]
+		plugin := podmanTest.Podman([]string{"run", "--security-opt", "label=disable", "-v", "/run/docker/plugins:/run/docker/plugins", "-v", fmt.Sprintf("%v:%v", pluginStatePath, pluginStatePath), "-d", volumeTest, "--sock-name", pluginName, "--path", pluginStatePath})
+		plugin.WaitWithDefaultTimeout()
+		Expect(plugin).Should(Exit(0))
+
+		// Make sure the socket is available (see #17956)
+		err = WaitForFile(fmt.Sprintf("/run/docker/plugins/%s.sock", pluginName))
+		Expect(err).ToNot(HaveOccurred())
+
+		ls := podmanTest.Podman([]string{"volume", "plugin", "ls", "--format", "{{.Name}} {{.Source}} {{.Enabled}}"})
+		ls.WaitWithDefaultTimeout()
+		Expect(ls).Should(Exit(0))
+		Expect(ls.OutputToStringArray()).To(ContainElement(pluginName + " discovered false"))
+		Expect(ls.OutputToStringArray()).To(ContainElement("testvol0 config true"))
+
+		volName := "testVolume1"
+		create := podmanTest.Podman([]string{"volume", "create", "--driver", pluginName, volName})
+		create.WaitWithDefaultTimeout()
+		Expect(create).Should(ExitWithError())
+
+		enable := podmanTest.Podman([]string{"volume", "plugin", "enable", pluginName})
+		enable.WaitWithDefaultTimeout()
+		Expect(enable).Should(Exit(0))
+
+		inspect := podmanTest.Podman([]string{"volume", "plugin", "inspect", "--format", "{{.Enabled}} {{.Scope}}", pluginName})
+		inspect.WaitWithDefaultTimeout()
+		Expect(inspect).Should(Exit(0))
+		Expect(inspect.OutputToString()).To(Equal("true local"))
+
+		create = podmanTest.Podman([]string{"volume", "create", "--driver", pluginName, volName})
+		create.WaitWithDefaultTimeout()
+		Expect(create).Should(Exit(0))
+
+		disable := podmanTest.Podman([]string{"volume", "plugin", "disable", pluginName})
+		disable.WaitWithDefaultTimeout()
+		Expect(disable).Should(ExitWithError())
+		Expect(disable.ErrorToString()).To(ContainSubstring("is used by volume " + volName))
+
+		remove := podmanTest.Podman([]string{"volume", "rm", volName})
+		remove.WaitWithDefaultTimeout()
+		Expect(remove).Should(Exit(0))
+
+		disable = podmanTest.Podman([]string{"volume", "plugin", "disable", pluginName})
+		disable.WaitWithDefaultTimeout()
+		Expect(disable).Should(Exit(0))
+
+		disable = podmanTest.Podman([]string{"volume", "plugin", "disable", "testvol0"})
+		disable.WaitWithDefaultTimeout()
+		Expect(disable).Should(ExitWithError())
+		Expect(disable.ErrorToString()).To(ContainSubstring("set in containers.conf"))
+	})
 })
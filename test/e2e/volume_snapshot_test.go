@@ -0,0 +1,141 @@
+package integration
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("Podman volume clone and snapshot", func() {
+
+	AfterEach(func() {
+		podmanTest.CleanupVolume()
+	})
+
+	It("podman volume clone", func() {
+		session := podmanTest.Podman([]string{"volume", "create", "--label", "app=db", "srcvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"run", "--rm", "-v", "srcvol:/data", ALPINE, "sh", "-c", "echo hello > /data/test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"volume", "clone", "srcvol", "dstvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToString()).To(Equal("dstvol"))
+
+		session = podmanTest.Podman([]string{"volume", "inspect", "--format", "{{.Labels.app}}", "dstvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToString()).To(Equal("db"))
+
+		session = podmanTest.Podman([]string{"run", "--rm", "-v", "dstvol:/data", ALPINE, "cat", "/data/test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToString()).To(Equal("hello"))
+
+		session = podmanTest.Podman([]string{"volume", "clone", "srcvol", "dstvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("volume already exists"))
+
+		session = podmanTest.Podman([]string{"volume", "create", "--opt", "type=tmpfs", "--opt", "device=tmpfs", "tmpfsvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"volume", "clone", "tmpfsvol", "tmpfsclone"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("only volumes of the local driver without mount options"))
+	})
+
+	It("podman volume snapshot create, ls, restore and rm", func() {
+		session := podmanTest.Podman([]string{"volume", "create", "snapvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"run", "--rm", "-v", "snapvol:/data", ALPINE, "sh", "-c", "echo seeded > /data/test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "create", "--name", "seeded", "snapvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToString()).To(Equal("seeded"))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "create", "--name", "seeded", "snapvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("volume snapshot already exists"))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "create", "snapvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		generated := session.OutputToString()
+		Expect(generated).To(HaveLen(12))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "ls", "--quiet", "snapvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToStringArray()).To(Equal([]string{"seeded", generated}))
+
+		session = podmanTest.Podman([]string{"volume", "inspect", "--format", "{{range .Snapshots}}{{.Name}} {{end}}", "snapvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToString()).To(Equal("seeded " + generated))
+
+		session = podmanTest.Podman([]string{"run", "--rm", "-v", "snapvol:/data", ALPINE, "sh", "-c", "echo changed > /data/test; touch /data/new"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		// Restoring is refused while a running container uses the volume.
+		session = podmanTest.Podman([]string{"run", "-d", "--name", "user", "-v", "snapvol:/data", ALPINE, "top"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "restore", "snapvol", "seeded"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("volume is being used"))
+
+		session = podmanTest.Podman([]string{"rm", "-f", "-t0", "user"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "restore", "snapvol", "seeded"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+
+		session = podmanTest.Podman([]string{"run", "--rm", "-v", "snapvol:/data", ALPINE, "ls", "/data"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToString()).To(Equal("test"))
+
+		session = podmanTest.Podman([]string{"run", "--rm", "-v", "snapvol:/data", ALPINE, "cat", "/data/test"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToString()).To(Equal("seeded"))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "restore", "snapvol", "nonesuch"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("no such volume snapshot"))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "rm", "snapvol", "seeded", generated})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToStringArray()).To(Equal([]string{"seeded", generated}))
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "ls", "snapvol"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(0))
+		Expect(session.OutputToString()).To(BeEmpty())
+
+		session = podmanTest.Podman([]string{"volume", "snapshot", "rm", "snapvol", "seeded"})
+		session.WaitWithDefaultTimeout()
+		Expect(session).Should(Exit(125))
+		Expect(session.ErrorToString()).To(ContainSubstring("no such volume snapshot"))
+	})
+})
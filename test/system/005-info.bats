@@ -183,6 +183,44 @@ host.slirp4netns.executable | $expr_path
     is "$output" "Error: unsupported database backend: \"bogus\""
 }
 
+@test "podman system migrate --database-backend" {
+    skip_if_remote "system migrate does not work on a remote client"
+
+    # Use a separate store so the migration does not touch other tests
+    local opts="--root $PODMAN_TMPDIR/root --runroot $PODMAN_TMPDIR/runroot"
+    local cname=c-$(random_string 10)
+    local pname=p-$(random_string 10)
+    local vname=v-$(random_string 10)
+
+    run_podman $opts --db-backend=boltdb volume create $vname
+    run_podman $opts --db-backend=boltdb pod create --infra=false --name $pname
+    run_podman $opts --db-backend=boltdb create --name $cname --pod $pname \
+               -v $vname:/data --rootfs $PODMAN_TMPDIR true
+    cid="$output"
+
+    run_podman 125 $opts --db-backend=boltdb system migrate --database-backend=bogus
+    run_podman $opts --db-backend=boltdb system migrate --database-backend=sqlite
+    assert "$output" =~ "migrated database from boltdb to sqlite"
+
+    run_podman $opts --db-backend=sqlite ps -a --pod --format "{{.ID}} {{.Names}} {{.PodName}}"
+    is "$output" "${cid:0:12} $cname $pname" "container migrated to sqlite"
+    run_podman $opts --db-backend=sqlite volume ls --format "{{.Name}}"
+    is "$output" "$vname" "volume migrated to sqlite"
+
+    # The old database was moved away
+    run_podman $opts --db-backend=boltdb ps -a --format "{{.ID}}"
+    is "$output" "" "no containers left in boltdb"
+
+    # And back again
+    run_podman $opts --db-backend=sqlite system migrate --database-backend=boltdb
+    run_podman $opts --db-backend=boltdb ps -a --pod --format "{{.ID}} {{.Names}} {{.PodName}}"
+    is "$output" "${cid:0:12} $cname $pname" "container migrated back to boltdb"
+
+    run_podman $opts --db-backend=boltdb rm -f $cname
+    run_podman $opts --db-backend=boltdb pod rm $pname
+    run_podman $opts --db-backend=boltdb volume rm $vname
+}
+
 @test "CONTAINERS_CONF_OVERRIDE" {
     skip_if_remote "remote does not support CONTAINERS_CONF*"
 
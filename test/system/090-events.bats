@@ -204,25 +204,32 @@ events_logfile_max_size=4750
 EOF
 
     # Create events *with* a limit and make sure that it has been
-    # rotated/truncated.  Once rotated, the events file should only contain the
-    # second half of its previous events plus the new ones.
-    expectedContentAfterTruncation=$PODMAN_TMPDIR/truncated.txt
+    # rotated.  Once rotated, the previous events are archived and the events
+    # file should only contain the rotation event plus the new ones.
+    cp $eventsFile $PODMAN_TMPDIR/archived.txt
 
     run_podman create $IMAGE
     ctrID=$output
     CONTAINERS_CONF_OVERRIDE=$containersConf run_podman rm $ctrID
-    tail -n52 $eventsFile >> $expectedContentAfterTruncation
 
-    # Make sure the events file looks as expected.
-    is "$(cat $eventsFile)" "$(cat $expectedContentAfterTruncation)" "events file has been rotated"
+    # Make sure the events file and the archive look as expected.
+    run cat $eventsFile
+    assert "${#lines[@]}" = 2 "Number of events in $eventsFile"
+    is "${lines[0]}" "{\"Name\":\"$eventsFile\",\"Status\":\"log-rotation\",\"Time\":\".*\",\"Type\":\"system\",\"Attributes\":{\"io.podman.event.rotate\":\"archive\",\"io.podman.event.rotate.archive\":\"$eventsFile.1.gz\"}}"
+    is "$(zcat $eventsFile.1.gz)" "$(cat $PODMAN_TMPDIR/archived.txt)" "events file has been archived"
+    test -e $eventsFile.index || die "events log index has not been written"
 
-    # Make sure that `podman events` can read the file, and that it returns the
-    # same amount of events.  We checked the contents before.
+    # Make sure that `podman events` reads the archive and the file, and that it
+    # returns all events.  We checked the contents before.
     CONTAINERS_CONF_OVERRIDE=$containersConf run_podman events --stream=false --since="2022-03-06T11:26:42.723667984+02:00" --format=json
-    assert "${#lines[@]}" = 52 "Number of events returned"
-    is "${lines[0]}" "{\"Name\":\"$eventsFile\",\"Status\":\"log-rotation\",\"Time\":\".*\",\"Type\":\"system\",\"Attributes\":{\"io.podman.event.rotate\":\"begin\"}}"
-    is "${lines[-2]}" "{\"Name\":\"$eventsFile\",\"Status\":\"log-rotation\",\"Time\":\".*\",\"Type\":\"system\",\"Attributes\":{\"io.podman.event.rotate\":\"end\"}}"
+    assert "${#lines[@]}" = 102 "Number of events returned"
+    is "${lines[0]}" "{\"Name\":\"busybox\",\"Status\":\"pull\",\"Time\":\"2022-04-06T11:26:42.7236679+02:00\",\"Type\":\"image\",\"Attributes\":null}"
+    is "${lines[99]}" "{\"Name\":\"busybox\",\"Status\":\"pull\",\"Time\":\"2022-04-06T11:26:42.723667999+02:00\",\"Type\":\"image\",\"Attributes\":null}"
     is "${lines[-1]}" "{\"ID\":\"$ctrID\",\"Image\":\"$IMAGE\",\"Name\":\".*\",\"Status\":\"remove\",\"Time\":\".*\",\"Type\":\"container\",\"Attributes\":{.*}}"
+
+    # Events after the archived ones do not need the archive.
+    CONTAINERS_CONF_OVERRIDE=$containersConf run_podman events --stream=false --since="2022-04-07T00:00:00+02:00" --format=json
+    assert "${#lines[@]}" = 2 "Number of events returned since the rotation"
 }
 
 @test "events log-file no duplicates" {
@@ -278,12 +285,11 @@ EOF
 
     # Make sure that the log file has been rotated as expected.
     run cat $eventsFile
-    assert "${#lines[@]}" = 54 "Number of events in $eventsFile" # 49 previous + 2 rotation + pull/create/rm
-    is "${lines[0]}" "{\"Name\":\"$eventsFile\",\"Status\":\"log-rotation\",\"Time\":\".*\",\"Type\":\"system\",\"Attributes\":{\"io.podman.event.rotate\":\"begin\"}}"
-    is "${lines[1]}" "{\"Name\":\"busybox\",\"Status\":\"pull\",\"Time\":\"2022-04-06T11:26:42.723667951+02:00\",\"Type\":\"image\",\"Attributes\":null}"
-    is "${lines[49]}" "{\"Name\":\"busybox\",\"Status\":\"pull\",\"Time\":\"2022-04-06T11:26:42.723667999+02:00\",\"Type\":\"image\",\"Attributes\":null}"
-    is "${lines[50]}" "{\"Name\":\"$eventsFile\",\"Status\":\"log-rotation\",\"Time\":\".*\",\"Type\":\"system\",\"Attributes\":{\"io.podman.event.rotate\":\"end\"}}"
-    is "${lines[53]}" "{\"ID\":\"$ctrID\",\"Image\":\"$IMAGE\",\"Name\":\".*\",\"Status\":\"remove\",\"Time\":\".*\",\"Type\":\"container\",\"Attributes\":{.*}}"
+    assert "${#lines[@]}" = 4 "Number of events in $eventsFile" # rotation + pull/create/rm
+    is "${lines[0]}" "{\"Name\":\"$eventsFile\",\"Status\":\"log-rotation\",\"Time\":\".*\",\"Type\":\"system\",\"Attributes\":{\"io.podman.event.rotate\":\"archive\",\"io.podman.event.rotate.archive\":\"$eventsFile.1.gz\"}}"
+    is "${lines[3]}" "{\"ID\":\"$ctrID\",\"Image\":\"$IMAGE\",\"Name\":\".*\",\"Status\":\"remove\",\"Time\":\".*\",\"Type\":\"container\",\"Attributes\":{.*}}"
+    run zcat $eventsFile.1.gz
+    assert "${#lines[@]}" = 100 "Number of events in the archive"
 
 
     # Make sure that the JSON stream looks as expected. That means it has all
@@ -291,7 +297,7 @@ EOF
     run cat $eventsJSON
     is "${lines[0]}" "{\"Name\":\"busybox\",\"Status\":\"pull\",\"Time\":\"2022-04-06T11:26:42.7236679+02:00\",\"Type\":\"image\",\"Attributes\":null}"
     is "${lines[99]}" "{\"Name\":\"busybox\",\"Status\":\"pull\",\"Time\":\"2022-04-06T11:26:42.723667999+02:00\",\"Type\":\"image\",\"Attributes\":null}"
-    is "${lines[100]}" "{\"Name\":\"$eventsFile\",\"Status\":\"log-rotation\",\"Time\":\".*\",\"Type\":\"system\",\"Attributes\":{\"io.podman.event.rotate\":\"end\"}}"
+    is "${lines[100]}" "{\"Name\":\"$eventsFile\",\"Status\":\"log-rotation\",\"Time\":\".*\",\"Type\":\"system\",\"Attributes\":{\"io.podman.event.rotate\":\"archive\",\"io.podman.event.rotate.archive\":\"$eventsFile.1.gz\"}}"
     is "${lines[103]}" "{\"ID\":\"$ctrID\",\"Image\":\"$IMAGE\",\"Name\":\".*\",\"Status\":\"remove\",\"Time\":\".*\",\"Type\":\"container\",\"Attributes\":{.*}}"
 }
 
@@ -397,3 +403,20 @@ EOF
     run_podman events --since=1m --stream=false --filter volume=${vname:0:5}
     assert "$output" = "$notrunc_results"
 }
+
+@test "events - secret events" {
+    local sname=s$(random_string 10)
+    local secret_file=$PODMAN_TMPDIR/secret.txt
+    echo "mysecret" > $secret_file
+    run_podman secret create $sname $secret_file
+    sid="$output"
+    run_podman secret rm $sname
+
+    run_podman events --since=1m --stream=false --filter secret=$sname
+    assert "${lines[0]}" =~ ".* secret create ${sid:0:12} \(name=$sname\)"
+    assert "${lines[1]}" =~ ".* secret remove ${sid:0:12} \(name=$sname\)"
+
+    run_podman events --since=1m --stream=false --filter type=secret --filter secret=${sid:0:8} --format "{{.Status}}"
+    assert "$output" = "create
+remove"
+}
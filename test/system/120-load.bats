@@ -93,6 +93,34 @@ verify_iid_and_name() {
     # If we can't sudo, we can't test.
     _sudo true || skip "cannot sudo to $notme"
 
+    # The storage of the other user is reached through its Podman service;
+    # start it unless its socket is already enabled.
+    notme_uid=$(id -u $notme)
+    if [[ $notme_uid -eq 0 ]]; then
+        socket=/run/podman/podman.sock
+    else
+        socket=/run/user/$notme_uid/podman/podman.sock
+        _sudo test -d /run/user/$notme_uid || skip "$notme has no /run/user directory"
+    fi
+    service_pid=
+    if ! _sudo test -S $socket; then
+        _sudo env XDG_RUNTIME_DIR=/run/user/$notme_uid $PODMAN system service -t 0 unix://$socket &
+        service_pid=$!
+        for i in {1..10}; do
+            _sudo test -S $socket && break
+            sleep 0.5
+        done
+        if is_rootless; then
+            _sudo chown $(id -u) $socket
+        fi
+    fi
+    if [[ ! -w $socket ]]; then
+        if [[ -n "$service_pid" ]]; then
+            kill $service_pid
+        fi
+        skip "cannot access the Podman service of $notme at $socket"
+    fi
+
     # Preserve digest of original image; we will compare against it later
     run_podman image inspect --format '{{.Digest}}' $IMAGE
     src_digest=$output
@@ -103,7 +131,7 @@ verify_iid_and_name() {
 
     # Copy it there.
     run_podman image scp $newname ${notme}@localhost::
-    is "$output" "Copying blob .*Copying config.*Writing manifest.*Storing signatures"
+    is "$output" "Copied image archive: .*Loaded image: $newname"
 
     # confirm that image was copied. FIXME: also try $PODMAN image inspect?
     _sudo $PODMAN image exists $newname
@@ -150,6 +178,53 @@ verify_iid_and_name() {
     is "$output" "Error: $nope: image not known.*" "Pushing nonexistent image"
 
     run_podman rmi foobar:123
+    if [[ -n "$service_pid" ]]; then
+        kill $service_pid
+        wait $service_pid || true
+    fi
+}
+
+@test "podman image scp transfer through a connection" {
+    skip_if_remote "only applicable under local podman"
+
+    # A service with its own storage, reached through a unix socket
+    socket=$PODMAN_TMPDIR/scp.sock
+    $PODMAN --root $PODMAN_TMPDIR/scp-root --runroot $PODMAN_TMPDIR/scp-runroot \
+            system service -t 0 unix://$socket &
+    service_pid=$!
+    wait_for_file $socket
+
+    conn=scp_$(random_string 10)
+    run_podman system connection add $conn unix://$socket
+
+    run_podman image inspect --format '{{.Digest}}' $IMAGE
+    src_digest=$output
+
+    newname=foo.bar/nonesuch/c_$(random_string 10 | tr A-Z a-z):mytag
+    run_podman tag $IMAGE $newname
+
+    run_podman image scp $newname $conn::
+    is "$output" "Copied image archive: .*Loaded image: $newname"
+
+    run_podman --connection $conn image inspect --format '{{.Digest}}' $newname
+    is "$output" "$src_digest" "Digest of transferred image matches original"
+
+    # Copy it back under another name
+    run_podman image scp -q $conn::$newname ${newname}-back
+    is "$output" "Loaded image: ${newname}-back" "-q silences output"
+    run_podman image inspect --format '{{.Digest}}' ${newname}-back
+    is "$output" "$src_digest" "Digest of re-fetched image matches original"
+
+    run_podman 125 image scp $conn::$newname $conn::
+    is "$output" "Error: the source and destination of the transfer are the same storage.*"
+
+    run_podman 125 image scp $conn::nope.nope/nonesuch:notag
+    is "$output" "Error: nope.nope/nonesuch:notag: image not known.*"
+
+    run_podman untag $IMAGE $newname ${newname}-back
+    run_podman system connection rm $conn
+    kill $service_pid
+    wait $service_pid || true
 }
 
 
@@ -70,6 +70,71 @@ function teardown() {
     systemctl stop $SERVICE_NAME
 }
 
+@test "podman system service - tls and mutual tls" {
+    skip_if_remote "podman system service unavailable over remote"
+
+    tlsdir=$PODMAN_TMPDIR/tls
+    mkdir -p $tlsdir
+    openssl req -x509 -newkey rsa:2048 -nodes -days 1 -subj "/CN=podman-test-ca" \
+            -keyout $tlsdir/ca-key.pem -out $tlsdir/ca.pem
+    for name in server client; do
+        openssl req -newkey rsa:2048 -nodes -subj "/CN=127.0.0.1" \
+                -keyout $tlsdir/$name-key.pem -out $tlsdir/$name.csr
+        openssl x509 -req -days 1 -in $tlsdir/$name.csr -CA $tlsdir/ca.pem -CAkey $tlsdir/ca-key.pem \
+                -CAcreateserial -extfile <(echo "subjectAltName=IP:127.0.0.1") -out $tlsdir/$name.pem
+    done
+
+    run_podman 125 system service --tls-cert $tlsdir/server.pem tcp://127.0.0.1:9292
+    is "$output" "Error: --tls-cert and --tls-key must be set together"
+    run_podman 125 system service --tls-cert $tlsdir/server.pem --tls-key $tlsdir/server-key.pem unix:///tmp/tls.sock
+    is "$output" "Error: TLS is only supported for tcp:// endpoints"
+
+    port=$(random_free_port)
+    systemd-run --unit=$SERVICE_NAME $PODMAN system service --time=0 \
+                --tls-cert $tlsdir/server.pem --tls-key $tlsdir/server-key.pem \
+                --tls-client-ca $tlsdir/ca.pem tcp://127.0.0.1:$port
+    wait_for_port 127.0.0.1 $port
+
+    URL="tcp+tls://127.0.0.1:$port?tlsca=$tlsdir/ca.pem"
+    run_podman 125 --url "$URL" info
+    assert "$output" =~ "certificate required" "service rejects clients without certificate"
+
+    run_podman --url "$URL&tlscert=$tlsdir/client.pem&tlskey=$tlsdir/client-key.pem" info --format '{{.Host.RemoteSocket.Path}}'
+    is "$output" "tcp://127.0.0.1:$port" "RemoteSocket.Path over mutual tls"
+
+    systemctl stop $SERVICE_NAME
+}
+
+@test "podman system service - metrics endpoint" {
+    skip_if_remote "podman system service unavailable over remote"
+
+    port=$(random_free_port)
+    URL=tcp://127.0.0.1:$port
+
+    systemd-run --unit=$SERVICE_NAME $PODMAN system service --time=0 --metrics $URL
+    wait_for_port 127.0.0.1 $port
+
+    cname=c-$(random_string)
+    run_podman --url $URL run -d --name $cname $IMAGE top -d 2
+
+    run curl -s --max-time 10 http://127.0.0.1:$port/metrics
+    assert "$status" -eq 0 "curl /metrics"
+    assert "$output" =~ "podman_containers\{state=\"running\"\} [1-9]" "running containers are counted"
+    assert "$output" =~ "podman_container_pids\{id=\"[0-9a-f]+\",name=\"$cname\"\} [1-9]" "per-container stats"
+    assert "$output" =~ "podman_images [1-9]" "images are counted"
+    assert "$output" =~ "podman_api_requests_total\{method=\"POST\",route=\"/v\{version:.*\}/libpod/containers/create\",code=\"201\"\} 1" "API requests are recorded by route"
+
+    run_podman --url $URL rm -f -t 0 $cname
+    systemctl stop $SERVICE_NAME
+
+    # Without --metrics the endpoint does not exist
+    systemd-run --unit=$SERVICE_NAME $PODMAN system service --time=0 $URL
+    wait_for_port 127.0.0.1 $port
+    run curl -s -o /dev/null -w '%{http_code}' --max-time 10 http://127.0.0.1:$port/metrics
+    is "$output" "404" "/metrics is opt-in"
+    systemctl stop $SERVICE_NAME
+}
+
 # Regression test for https://github.com/containers/podman/issues/17749
 @test "podman-system-service --log-level=trace should be able to hijack" {
     skip_if_remote "podman system service unavailable over remote"
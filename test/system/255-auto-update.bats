@@ -237,6 +237,18 @@ function _confirm_update() {
     is "$output" "$ori_image" "Image ID should not change"
 }
 
+@test "podman auto-update - label io.containers.autoupdate=semver requires a version tag" {
+    generate_service alpine semver
+
+    _wait_service_ready container-$cname.service
+    run_podman 125 auto-update --dry-run --format "{{.Unit}},{{.Updated}}"
+    is "$output" ".*container-$cname.service,failed.*" "update fails without a version tag"
+    is "$output" ".*semver policy requires a version tag.*" "semver policy rejects latest"
+
+    run_podman inspect --format "{{.Image}}" $cname
+    is "$output" "$ori_image" "Image ID should not change"
+}
+
 @test "podman auto-update - label io.containers.autoupdate=local" {
     generate_service localtest local
     _wait_service_ready container-$cname.service
@@ -248,6 +260,11 @@ function _confirm_update() {
     run_podman auto-update --dry-run --format "{{.Unit}},{{.Image}},{{.Updated}},{{.Policy}}"
     is "$output" ".*container-$cname.service,quay.io/libpod/localtest:latest,pending,local.*" "Image update is pending."
 
+    run_podman image inspect --format "{{.Digest}}" $image
+    digest="$output"
+    run_podman auto-update --dry-run --format "{{.Unit}},{{.Updated}},{{.Digest}}"
+    is "$output" ".*container-$cname.service,pending,$digest.*" "Digest of the target image is reported."
+
     run_podman auto-update --rollback=false --format "{{.Unit}},{{.Image}},{{.Updated}},{{.Policy}}"
     is "$output" ".*container-$cname.service,quay.io/libpod/localtest:latest,true,local.*" "Image is updated."
 
@@ -309,6 +326,37 @@ EOF
     _confirm_update $cname $newID
 }
 
+@test "podman auto-update - health-gated rollback and staged rollout" {
+    # No spaces, the arguments are word-split by generate_service
+    local health_args='--health-cmd=["test","!","-e","/broken"] --health-interval=1s'
+
+    generate_service localtest local "" "$health_args --label io.containers.autoupdate.order=1"
+    local cname1=$cname
+    generate_service localtest local "" "$health_args --label io.containers.autoupdate.order=2" noTag
+    local cname2=$cname
+    _wait_service_ready container-$cname1.service
+    _wait_service_ready container-$cname2.service
+
+    # Build an image that starts fine but fails its health check.
+    run_podman exec $cname1 touch /broken
+    run_podman commit $cname1 quay.io/libpod/localtest:latest
+    run_podman exec $cname1 rm /broken
+
+    run_podman 125 auto-update --health-grace-period 1x
+    is "$output" "Error: invalid health grace period: .*" "invalid grace period"
+
+    run_podman auto-update --health-grace-period 20s --format "{{.Unit}},{{.Updated}}"
+    assert "${lines[0]}" == "container-$cname1.service,rolled back" \
+           "first unit of the rollout is rolled back"
+    assert "${lines[1]}" == "container-$cname2.service,skipped" \
+           "second unit of the rollout is skipped"
+
+    run_podman inspect --format "{{.Image}}" $cname1
+    is "$output" "$ori_image" "container rolled back to previous image"
+    run_podman inspect --format "{{.Image}}" $cname2
+    is "$output" "$ori_image" "skipped container still uses previous image"
+}
+
 @test "podman auto-update with multiple services" {
     # Preserve original image ID, to confirm that it changes (or not)
     run_podman inspect --format "{{.Id}}" $IMAGE